@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/logger"
 	"github.com/palemoky/fight-the-landlord/internal/ui"
 	"github.com/palemoky/fight-the-landlord/internal/update"
@@ -53,6 +56,16 @@ func main() {
 		return
 	}
 
+	// ddz verify [记录文件]：核对最近一局的发牌
+	if flag.Arg(0) == "verify" {
+		os.Exit(runVerify(flag.Arg(1)))
+	}
+
+	// ddz replay <回放文件>：逐步查看一局的完整过程
+	if flag.Arg(0) == "replay" {
+		os.Exit(runReplay(flag.Arg(1)))
+	}
+
 	// 支持完整 URL (wss://...) 或仅 host:port
 	var serverURL string
 	if strings.HasPrefix(*serverAddr, "ws://") || strings.HasPrefix(*serverAddr, "wss://") {
@@ -77,6 +90,67 @@ func main() {
 	}
 }
 
+// runVerify 读取发牌记录（默认为最近一局），用服务端揭示的种子重算洗牌并核对发牌，返回进程退出码
+func runVerify(path string) int {
+	if path == "" {
+		var err error
+		if path, err = client.DefaultDealRecordPath(); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			return 1
+		}
+	}
+
+	rec, err := client.LoadDealRecord(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ 读取发牌记录失败：%v\n", err)
+		return 1
+	}
+	if err := rec.Verify(); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ 发牌验证失败：%v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ 发牌验证通过（%s）\n", path)
+	fmt.Printf("   承诺值：%s\n", rec.SeedCommit)
+	fmt.Printf("   服务端种子：%s\n", rec.Proof.ServerSeed)
+	fmt.Printf("   自己的种子：%s（座位 %d）\n", rec.ClientSeed, rec.Seat)
+	if rec.WildRank != 0 {
+		fmt.Printf("   癞子点数：%s\n", rec.WildRank)
+	}
+	if unseeded := rec.UnseededSeats(); len(unseeded) > 0 {
+		if slices.Contains(unseeded, rec.Seat) {
+			fmt.Println("⚠️ 自己的种子未参与本局洗牌：牌序只由服务端种子决定，无法排除服务端事先挑选种子")
+		}
+		fmt.Printf("⚠️ 未带客户端种子的座位：%v（机器人座位不上报种子）\n", unseeded)
+	}
+	return 0
+}
+
+// runReplay 读取回放文件并在终端中逐步回放，返回进程退出码
+func runReplay(path string) int {
+	if path == "" {
+		fmt.Fprintln(os.Stderr, "用法: ddz replay <回放文件>")
+		return 2
+	}
+
+	l, err := gamelog.LoadReplay(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ 读取回放文件失败：%v\n", err)
+		return 1
+	}
+	if len(l.Events) == 0 {
+		fmt.Fprintln(os.Stderr, "❌ 回放文件中没有对局事件")
+		return 1
+	}
+
+	if _, err := tea.NewProgram(ui.NewReplayModel(l)).Run(); err != nil {
+		logger.LogError("Replay error: %v", err)
+		fmt.Fprintf(os.Stderr, "❌ 回放出错：%v\n", err)
+		return 1
+	}
+	return 0
+}
+
 // checkForUpdate 由服务端驱动版本检测：向服务端查询其要求的最低客户端版本，仅当本地版本低于该最低版本时才强制升级。
 // 这样升级策略由服务端集中控制——服务端只在确有不兼容变更时抬高最低版本，避免每次发版都打扰所有用户。开发版本（未注入版本号）跳过检测；查询失败（如无网络或服务端不支持该接口）仅记录日志，不阻断启动。
 func checkForUpdate(serverURL string) {
@@ -25,13 +25,21 @@ func newGameError(code int) *GameError {
 
 // 预定义错误
 var (
-	ErrRoomNotFound = newGameError(protocol.ErrCodeRoomNotFound)
-	ErrRoomFull     = newGameError(protocol.ErrCodeRoomFull)
-	ErrNotInRoom    = newGameError(protocol.ErrCodeNotInRoom)
-	ErrGameStarted  = newGameError(protocol.ErrCodeGameStarted)
-	ErrGameNotStart = newGameError(protocol.ErrCodeGameNotStart)
-	ErrNotYourTurn  = newGameError(protocol.ErrCodeNotYourTurn)
-	ErrInvalidCards = newGameError(protocol.ErrCodeInvalidCards)
-	ErrCannotBeat   = newGameError(protocol.ErrCodeCannotBeat)
-	ErrMustPlay     = newGameError(protocol.ErrCodeMustPlay)
+	ErrInvalidSeed        = newGameError(protocol.ErrCodeInvalidSeed)
+	ErrRoomNotFound       = newGameError(protocol.ErrCodeRoomNotFound)
+	ErrRoomFull           = newGameError(protocol.ErrCodeRoomFull)
+	ErrNotInRoom          = newGameError(protocol.ErrCodeNotInRoom)
+	ErrGameStarted        = newGameError(protocol.ErrCodeGameStarted)
+	ErrRematchClosed      = newGameError(protocol.ErrCodeRematchClosed)
+	ErrInOtherRoom        = newGameError(protocol.ErrCodeInOtherRoom)
+	ErrTournamentNotFound = newGameError(protocol.ErrCodeTournamentNotFound)
+	ErrTournamentStarted  = newGameError(protocol.ErrCodeTournamentStarted)
+	ErrGameNotStart       = newGameError(protocol.ErrCodeGameNotStart)
+	ErrNotYourTurn        = newGameError(protocol.ErrCodeNotYourTurn)
+	ErrInvalidCards       = newGameError(protocol.ErrCodeInvalidCards)
+	ErrCannotBeat         = newGameError(protocol.ErrCodeCannotBeat)
+	ErrMustPlay           = newGameError(protocol.ErrCodeMustPlay)
+	ErrInvalidBid         = newGameError(protocol.ErrCodeInvalidBid)
+	ErrInvalidDouble      = newGameError(protocol.ErrCodeInvalidDouble)
+	ErrCannotShowHand     = newGameError(protocol.ErrCodeCannotShowHand)
 )
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"math/rand/v2"
+	"slices"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/palemoky/fight-the-landlord/internal/client"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
@@ -43,8 +45,8 @@ type BotClient struct {
 
 type botState struct {
 	mu            sync.RWMutex
-	seat          int       // 本机器人的座位号（0-2）
-	seatPlayerIDs [3]string // seatPlayerIDs[i] = 座位 i 的 playerID
+	seat          int      // 本机器人的座位号
+	seatPlayerIDs []string // seatPlayerIDs[i] = 座位 i 的 playerID
 	hand          []card.Card
 	isLandlord    bool
 	landlordID    string         // 地主的 playerID
@@ -54,6 +56,7 @@ type botState struct {
 	recentPlays   [2]PlayRecord // [0]=最近一次出牌, [1]=上上次出牌
 	prevBid       *bool         // 叫地主阶段上一个玩家的决策（nil=尚无）
 	cardCounter   *client.CardCounter
+	rules         rule.RuleSet // 本局房规（来自 MsgGameStart，癞子点数来自 MsgDealCards）
 
 	// DouZero 专用
 	douzeroPos  string         // "landlord"|"landlord_down"|"landlord_up"
@@ -129,6 +132,8 @@ func (b *BotClient) SendMessage(msg *protocol.Message) {
 		b.handleCardPlayed(msg)
 	case protocol.MsgBidTurn:
 		go b.handleBidTurn(msg)
+	case protocol.MsgDoubleTurn:
+		go b.handleDoubleTurn(msg)
 	case protocol.MsgPlayerPass:
 		b.handlePlayerPass(msg)
 	case protocol.MsgPlayTurn:
@@ -149,18 +154,27 @@ func (b *BotClient) handleGameStart(msg *protocol.Message) {
 	defer b.state.mu.Unlock()
 
 	b.state.cardCounts = make(map[string]int)
+	rules, _ := rule.RuleSetByName(payload.RuleSet)
+	layout, _ := room.LayoutByMode(payload.Mode)
+	b.state.rules = layout.ApplyTo(rules)
 
+	n := len(payload.Players)
+	b.state.seatPlayerIDs = make([]string, n)
 	for _, p := range payload.Players {
-		b.state.cardCounts[p.ID] = 17
-		b.state.seatPlayerIDs[p.Seat] = p.ID
+		b.state.cardCounts[p.ID] = layout.HandSize
+		if p.Seat >= 0 && p.Seat < n {
+			b.state.seatPlayerIDs[p.Seat] = p.ID
+		}
 		if p.ID == b.id {
 			b.state.seat = p.Seat
 		}
 	}
 	// 上家 = 前一座位（循环），下家 = 后一座位
-	b.state.orderedOthers[0] = b.state.seatPlayerIDs[(b.state.seat+2)%3]
-	b.state.orderedOthers[1] = b.state.seatPlayerIDs[(b.state.seat+1)%3]
-	b.state.cardCounter.Reset()
+	if n > 0 {
+		b.state.orderedOthers[0] = b.state.seatPlayerIDs[(b.state.seat+n-1)%n]
+		b.state.orderedOthers[1] = b.state.seatPlayerIDs[(b.state.seat+1)%n]
+	}
+	b.state.cardCounter.SetDeck(layout.NewDeck())
 	b.state.recentPlays = [2]PlayRecord{}
 	b.state.prevBid = nil
 	b.state.isLandlord = false
@@ -181,6 +195,7 @@ func (b *BotClient) handleDealCards(msg *protocol.Message) {
 	b.state.mu.Lock()
 	defer b.state.mu.Unlock()
 	b.state.hand = convert.InfosToCards(payload.Cards)
+	b.state.rules = b.state.rules.WithWild(card.Rank(payload.WildRank))
 	log.Printf("🤖 %s 收到手牌 %d 张", b.name, len(b.state.hand))
 }
 
@@ -213,13 +228,16 @@ func (b *BotClient) handleLandlord(msg *protocol.Message) {
 		b.state.isLandlord = true
 	}
 	b.state.landlordID = payload.PlayerID
-	// 更新地主的牌数（+3 底牌）
+	// 更新地主的牌数（加上底牌）
 	if _, ok := b.state.cardCounts[payload.PlayerID]; ok {
-		b.state.cardCounts[payload.PlayerID] += 3
+		b.state.cardCounts[payload.PlayerID] += len(payload.BottomCards)
 	}
 	b.state.bottomCards = convert.InfosToCards(payload.BottomCards)
 
-	// 确定本机器人的 DouZero 位置
+	// 确定本机器人的 DouZero 位置（DouZero 只支持三人局）
+	if len(b.state.seatPlayerIDs) != 3 {
+		return
+	}
 	landlordSeat := -1
 	for seat, pid := range b.state.seatPlayerIDs {
 		if pid == payload.PlayerID {
@@ -228,13 +246,13 @@ func (b *BotClient) handleLandlord(msg *protocol.Message) {
 		}
 	}
 	if landlordSeat >= 0 {
-		b.state.douzeroPos = seatToDouZeroPos(b.state.seat, landlordSeat)
+		b.state.douzeroPos = SeatToDouZeroPos(b.state.seat, landlordSeat)
 	}
 }
 
 // playerIDToDouZeroPos 将 playerID 映射到 DouZero 位置（需持有 state.mu 锁）
 func (b *BotClient) playerIDToDouZeroPos(playerID string) string {
-	if b.state.landlordID == "" {
+	if b.state.landlordID == "" || len(b.state.seatPlayerIDs) != 3 {
 		return ""
 	}
 	landlordSeat := -1
@@ -249,14 +267,14 @@ func (b *BotClient) playerIDToDouZeroPos(playerID string) string {
 	}
 	for seat, pid := range b.state.seatPlayerIDs {
 		if pid == playerID {
-			return seatToDouZeroPos(seat, landlordSeat)
+			return SeatToDouZeroPos(seat, landlordSeat)
 		}
 	}
 	return ""
 }
 
-// seatToDouZeroPos 根据座位号计算 DouZero 位置名称
-func seatToDouZeroPos(seat, landlordSeat int) string {
+// SeatToDouZeroPos 根据座位号与地主座位计算 DouZero 位置名称（仅三人局）
+func SeatToDouZeroPos(seat, landlordSeat int) string {
 	switch seat {
 	case landlordSeat:
 		return DouZeroPosLandlord
@@ -302,8 +320,8 @@ func (b *BotClient) handleCardPlayed(msg *protocol.Message) {
 	b.state.cardCounter.DeductCards(played)
 
 	// 更新最近两次出牌（shift：旧的[0]→[1]，新的→[0]）
-	parsed, parseErr := rule.ParseHand(played)
-	if parseErr == nil && parsed.Type != rule.Invalid {
+	parsed, ok := b.state.rules.PlayedHand(played, convert.InfoToReading(payload.Reading))
+	if ok {
 		b.state.recentPlays[1] = b.state.recentPlays[0]
 		b.state.recentPlays[0] = PlayRecord{
 			Played:     parsed,
@@ -358,10 +376,10 @@ func (b *BotClient) handleBidTurn(msg *protocol.Message) {
 	b.state.mu.RLock()
 	hand := make([]card.Card, len(b.state.hand))
 	copy(hand, b.state.hand)
-	prevBid := b.state.prevBid
+	req := BidRequest{IsGrab: payload.IsGrab, Options: payload.Options, PrevBid: b.state.prevBid}
 	b.state.mu.RUnlock()
 
-	bid := b.engine.DecideBid(context.Background(), b.name, hand, prevBid)
+	bid := b.engine.DecideBid(context.Background(), b.name, hand, req)
 
 	b.sessionMu.RLock()
 	sess := b.session
@@ -372,17 +390,59 @@ func (b *BotClient) handleBidTurn(msg *protocol.Message) {
 		return
 	}
 
+	if req.ScoreMode() {
+		log.Printf("🤖 %s 决定叫分: %d（可叫 %v）", b.name, bid, req.Options)
+		if err := sess.HandleBidScore(b.id, bid); err != nil {
+			log.Printf("🤖 %s HandleBidScore 失败: %v", b.name, err)
+		}
+		return
+	}
+
 	action := "叫地主"
 	if payload.IsGrab {
 		action = "抢地主"
 	}
-	log.Printf("🤖 %s 决定%s: %v（当前倍数 %d）", b.name, action, bid, payload.Multiplier)
+	log.Printf("🤖 %s 决定%s: %v（当前倍数 %d）", b.name, action, bid > 0, payload.Multiplier)
 
-	if err := sess.HandleBid(b.id, bid); err != nil {
+	if err := sess.HandleBid(b.id, bid > 0); err != nil {
 		log.Printf("🤖 %s HandleBid 失败: %v", b.name, err)
 	}
 }
 
+func (b *BotClient) handleDoubleTurn(msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.DoubleTurnPayload](msg)
+	if err != nil {
+		log.Printf("🤖 handleDoubleTurn decode error: %v", err)
+		return
+	}
+	if !slices.Contains(payload.Pending, b.id) {
+		return
+	}
+
+	time.Sleep(thinkDelay())
+
+	b.state.mu.RLock()
+	hand := slices.Clone(b.state.hand)
+	isLandlord := b.state.isLandlord
+	b.state.mu.RUnlock()
+
+	level := b.engine.DecideDouble(context.Background(), b.name, hand, isLandlord)
+
+	b.sessionMu.RLock()
+	sess := b.session
+	b.sessionMu.RUnlock()
+
+	if sess == nil {
+		log.Printf("🤖 %s: session 未就绪，跳过加倍", b.name)
+		return
+	}
+
+	log.Printf("🤖 %s 决定加倍: %d（当前倍数 %d）", b.name, level, payload.Multiplier)
+	if err := sess.HandleDouble(b.id, level); err != nil {
+		log.Printf("🤖 %s HandleDouble 失败: %v", b.name, err)
+	}
+}
+
 func (b *BotClient) handlePlayTurn(msg *protocol.Message) {
 	payload, err := codec.ParsePayload[protocol.PlayTurnPayload](msg)
 	if err != nil {
@@ -414,7 +474,7 @@ func (b *BotClient) handlePlayTurn(msg *protocol.Message) {
 	if cards == nil {
 		playErr = sess.HandlePass(b.id)
 	} else {
-		playErr = sess.HandlePlayCards(b.id, convert.CardsToInfos(cards))
+		playErr = sess.HandlePlayCards(b.id, convert.CardsToInfos(cards), rule.HandReading{})
 	}
 
 	if playErr != nil {
@@ -452,6 +512,7 @@ func (b *BotClient) buildGameContext(mustPlay, canBeat bool) GameContext {
 		RecentPlays:    b.state.recentPlays,
 		MustPlay:       mustPlay,
 		CanBeat:        canBeat,
+		Rules:          b.state.rules,
 		PlayerCounts:   counts,
 		PlayerRoles:    roles,
 		RemainingCards: b.state.cardCounter.GetRemaining(),
@@ -466,7 +527,7 @@ func (b *BotClient) buildGameContext(mustPlay, canBeat bool) GameContext {
 // buildNumCardsLeft 构建 DouZero 位置 → 剩余牌数的映射（调用时需持有 state.mu.RLock）
 func (b *BotClient) buildNumCardsLeft() map[string]int {
 	m := make(map[string]int)
-	if b.state.landlordID == "" {
+	if b.state.landlordID == "" || len(b.state.seatPlayerIDs) != 3 {
 		return m
 	}
 	landlordSeat := -1
@@ -483,7 +544,7 @@ func (b *BotClient) buildNumCardsLeft() map[string]int {
 		if pid == "" {
 			continue
 		}
-		pos := seatToDouZeroPos(seat, landlordSeat)
+		pos := SeatToDouZeroPos(seat, landlordSeat)
 		m[pos] = b.state.cardCounts[pid]
 	}
 	return m
@@ -101,8 +101,12 @@ type douzeroResponse struct {
 	Error  string `json:"error,omitempty"`
 }
 
-func (e *DouZeroEngine) DecideBid(_ context.Context, _ string, hand []card.Card, _ *bool) bool {
-	return scoredBid(hand)
+func (e *DouZeroEngine) DecideBid(_ context.Context, _ string, hand []card.Card, req BidRequest) int {
+	return scoredBid(hand, req)
+}
+
+func (e *DouZeroEngine) DecideDouble(_ context.Context, _ string, hand []card.Card, isLandlord bool) int {
+	return scoredDouble(hand, isLandlord)
 }
 
 func (e *DouZeroEngine) DecidePlay(ctx context.Context, botName string, gctx GameContext) []card.Card {
@@ -112,20 +116,25 @@ func (e *DouZeroEngine) DecidePlay(ctx context.Context, botName string, gctx Gam
 
 	if gctx.DouZeroPos == "" {
 		log.Printf("🎮 [DouZero] %s: 位置未知，回退规则出牌", botName)
-		return rule.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+		return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+	}
+
+	// DouZero 模型不认识癞子，癞子玩法直接按规则出牌
+	if gctx.Rules.IsLaizi() {
+		return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
 	}
 
 	req := e.buildRequest(gctx)
 	action, err := e.callService(ctx, req)
 	if err != nil {
 		log.Printf("🎮 [DouZero] %s: 服务错误: %v，回退规则出牌", botName, err)
-		return rule.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+		return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
 	}
 
 	if len(action) == 0 {
 		if gctx.MustPlay {
 			log.Printf("🎮 [DouZero] %s: 返回 pass 但必须出牌，回退规则出牌", botName)
-			return rule.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+			return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
 		}
 		log.Printf("🎮 [DouZero] %s: pass", botName)
 		return nil
@@ -134,7 +143,13 @@ func (e *DouZeroEngine) DecidePlay(ctx context.Context, botName string, gctx Gam
 	cards := e.douzeroToCards(action, gctx.Hand)
 	if cards == nil {
 		log.Printf("🎮 [DouZero] %s: 牌面转换失败，回退规则出牌", botName)
-		return rule.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+		return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+	}
+
+	// DouZero 按经典规则训练，房规禁止的牌型需回退
+	if _, err := gctx.Rules.ParseHand(cards); err != nil {
+		log.Printf("🎮 [DouZero] %s: %v，回退规则出牌", botName, err)
+		return gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
 	}
 
 	log.Printf("🎮 [DouZero] %s 出牌: %s", botName, cardsToStr(cards))
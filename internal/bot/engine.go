@@ -3,10 +3,11 @@ package bot
 import (
 	"context"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
-	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 )
 
 // HeuristicEngine 规则启发式决策引擎。
@@ -25,7 +26,7 @@ func (e *HeuristicEngine) DecidePlay(_ context.Context, botName string, gctx Gam
 		return nil
 	}
 
-	cards := rule.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
+	cards := gctx.Rules.FindSmallestBeatingCards(gctx.Hand, gctx.RecentPlays[0].Played)
 	if cards == nil {
 		log.Printf("🤖 %s 选择 pass", botName)
 	} else {
@@ -34,13 +35,67 @@ func (e *HeuristicEngine) DecidePlay(_ context.Context, botName string, gctx Gam
 	return cards
 }
 
-// DecideBid 决定是否叫地主 / 抢地主
-func (e *HeuristicEngine) DecideBid(_ context.Context, _ string, hand []card.Card, _ *bool) bool {
-	return scoredBid(hand)
+// DecideBid 决定是否叫地主 / 抢地主，叫分模式下决定叫几分
+func (e *HeuristicEngine) DecideBid(_ context.Context, _ string, hand []card.Card, req BidRequest) int {
+	return scoredBid(hand, req)
 }
 
-// scoredBid 启发式叫地主决策：根据大牌、炸弹给手牌打分，达到阈值则叫/抢
-func scoredBid(hand []card.Card) bool {
+// DecideDouble 决定是否加倍
+func (e *HeuristicEngine) DecideDouble(_ context.Context, _ string, hand []card.Card, isLandlord bool) int {
+	return scoredDouble(hand, isLandlord)
+}
+
+// 叫地主阈值：叫抢模式手牌分达到 bidThreshold 即叫/抢；叫分模式按 scoreThresholds 依次对应 1/2/3 分
+const bidThreshold = 3.5
+
+var scoreThresholds = [...]float64{3.5, 5, 6.5}
+
+// scoredBid 启发式叫地主决策：叫抢模式达到阈值则叫/抢，叫分模式按手牌分决定想叫的分数，
+// 想叫的分数不高于当前最高分时不叫
+func scoredBid(hand []card.Card, req BidRequest) int {
+	strength := handStrength(hand)
+	if !req.ScoreMode() {
+		if strength >= bidThreshold {
+			return 1
+		}
+		return 0
+	}
+
+	want := 0
+	for i, th := range scoreThresholds {
+		if strength >= th {
+			want = i + 1
+		}
+	}
+	if slices.Contains(req.Options, want) {
+		return want
+	}
+	return 0
+}
+
+// 加倍阈值：手牌分依次达到 doubleThresholds 时加倍、超级加倍；
+// 地主手里多了底牌，阈值相应提高
+var doubleThresholds = [...]float64{5, 7.5}
+
+const landlordDoubleBonus = 1.5
+
+// scoredDouble 启发式加倍决策
+func scoredDouble(hand []card.Card, isLandlord bool) int {
+	strength := handStrength(hand)
+	if isLandlord {
+		strength -= landlordDoubleBonus
+	}
+	level := room.DoubleNone
+	for i, th := range doubleThresholds {
+		if strength >= th {
+			level = i + 1
+		}
+	}
+	return level
+}
+
+// handStrength 根据大牌、炸弹给手牌打分
+func handStrength(hand []card.Card) float64 {
 	score := 0.0
 	rankCounts := make(map[card.Rank]int)
 	for _, c := range hand {
@@ -61,7 +116,7 @@ func scoredBid(hand []card.Card) bool {
 			score += 0.5
 		}
 	}
-	return score >= 3.5
+	return score
 }
 
 // cardsToStr 将牌切片格式化为以空格分隔的牌面字符串（日志用）
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 )
 
@@ -48,14 +49,67 @@ func TestHeuristicEngine_DecideBid(t *testing.T) {
 
 	// 强牌（含双王 + 炸弹 + 2）应叫地主
 	strong := cards("R B 2 2 2 2 A K")
-	if !e.DecideBid(context.Background(), "bot", strong, nil) {
-		t.Errorf("强牌应叫地主，scoredBid=%v", scoredBid(strong))
+	if got := e.DecideBid(context.Background(), "bot", strong, BidRequest{}); got != 1 {
+		t.Errorf("强牌应叫地主，handStrength=%v", handStrength(strong))
 	}
 
 	// 弱牌不应叫地主
 	weak := cards("3 4 5 6 7 8 9 T")
-	if e.DecideBid(context.Background(), "bot", weak, nil) {
-		t.Errorf("弱牌不应叫地主，scoredBid=%v", scoredBid(weak))
+	if got := e.DecideBid(context.Background(), "bot", weak, BidRequest{}); got != 0 {
+		t.Errorf("弱牌不应叫地主，handStrength=%v", handStrength(weak))
+	}
+}
+
+func TestHeuristicEngine_DecideBid_ScoreMode(t *testing.T) {
+	t.Parallel()
+	e := NewHeuristicEngine()
+
+	tests := []struct {
+		name    string
+		hand    string
+		options []int
+		want    int
+	}{
+		{"强牌叫 3 分", "R B 2 2 2 2 A K", []int{1, 2, 3}, 3},
+		{"较好牌叫 2 分", "R B 2 A 5 6 7 8", []int{1, 2, 3}, 2},
+		{"一般牌叫 1 分", "R 2 A 5 6 7 8 9", []int{1, 2, 3}, 1},
+		{"想叫的分不高于当前最高分则不叫", "R 2 A 5 6 7 8 9", []int{2, 3}, 0},
+		{"弱牌不叫", "3 4 5 6 7 8 9 T", []int{1, 2, 3}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hand := cards(tt.hand)
+			got := e.DecideBid(context.Background(), "bot", hand, BidRequest{Options: tt.options})
+			if got != tt.want {
+				t.Errorf("DecideBid = %d, want %d（handStrength=%v）", got, tt.want, handStrength(hand))
+			}
+		})
+	}
+}
+
+func TestHeuristicEngine_DecideDouble(t *testing.T) {
+	t.Parallel()
+	e := NewHeuristicEngine()
+
+	tests := []struct {
+		name       string
+		hand       string
+		isLandlord bool
+		want       int
+	}{
+		{"农民强牌超级加倍", "R B 2 2 2 2 A K", false, room.DoubleSuper},
+		{"地主同样的牌只加倍", "R B 2 2 2 2 A K", true, room.DoubleTwice},
+		{"弱牌不加倍", "3 4 5 6 7 8 9 T", false, room.DoubleNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hand := cards(tt.hand)
+			if got := e.DecideDouble(context.Background(), "bot", hand, tt.isLandlord); got != tt.want {
+				t.Errorf("DecideDouble = %d, want %d（handStrength=%v）", got, tt.want, handStrength(hand))
+			}
+		})
 	}
 }
 
@@ -17,17 +17,34 @@ const (
 
 // DecisionEngine 决策引擎接口，规则启发式引擎和 DouZero 均实现此接口
 type DecisionEngine interface {
-	DecideBid(ctx context.Context, botName string, hand []card.Card, prevBid *bool) bool
+	// DecideBid 叫抢模式返回 1 表示叫/抢、0 表示不叫；叫分模式返回所叫分数（须在 req.Options 中），0 表示不叫
+	DecideBid(ctx context.Context, botName string, hand []card.Card, req BidRequest) int
+	// DecideDouble 加倍阶段返回 0 不加倍、1 加倍、2 超级加倍（见 room.DoubleNone 等）
+	DecideDouble(ctx context.Context, botName string, hand []card.Card, isLandlord bool) int
 	DecidePlay(ctx context.Context, botName string, gctx GameContext) []card.Card
 }
 
 // SessionInterface 避免 session↔bot 循环依赖
 type SessionInterface interface {
 	HandleBid(playerID string, bid bool) error
-	HandlePlayCards(playerID string, cardInfos []protocol.CardInfo) error
+	HandleBidScore(playerID string, score int) error
+	HandleDouble(playerID string, level int) error
+	HandlePlayCards(playerID string, cardInfos []protocol.CardInfo, reading rule.HandReading) error
 	HandlePass(playerID string) error
 }
 
+// BidRequest 叫地主决策所需信息
+type BidRequest struct {
+	IsGrab  bool  // 是否处于抢地主阶段
+	Options []int // 叫分模式下可叫的分数（不含不叫），叫抢模式为空
+	PrevBid *bool // 上一个玩家的决策（nil=尚无）
+}
+
+// ScoreMode 是否为叫分模式
+func (r BidRequest) ScoreMode() bool {
+	return len(r.Options) > 0
+}
+
 // PlayRecord 一次出牌记录
 type PlayRecord struct {
 	Played     rule.ParsedHand
@@ -43,6 +60,7 @@ type GameContext struct {
 	RecentPlays    [2]PlayRecord // [0]=上家(最近), [1]=上上家
 	MustPlay       bool
 	CanBeat        bool
+	Rules          rule.RuleSet      // 本局房规，出牌须满足
 	PlayerCounts   [2]int            // [0]=上家, [1]=下家 剩余牌数
 	PlayerRoles    [2]bool           // 对应 PlayerCounts 的角色，true=地主
 	RemainingCards map[card.Rank]int // 场上剩余各点数牌数（记牌器）
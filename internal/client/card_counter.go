@@ -5,27 +5,31 @@ import "github.com/palemoky/fight-the-landlord/internal/game/card"
 // CardCounter 跟踪不在玩家手中的剩余牌
 type CardCounter struct {
 	remaining map[card.Rank]int
+	deck      card.Deck // 本局使用的整副牌
 }
 
 // NewCardCounter 创建并初始化一个新的记牌器
 func NewCardCounter() *CardCounter {
 	cc := &CardCounter{
 		remaining: make(map[card.Rank]int),
+		deck:      card.NewDeck(),
 	}
 	cc.Reset()
 	return cc
 }
 
-// Reset 使用完整的一副牌（54张）初始化计数器
+// Reset 使用本局的整副牌（默认一副 54 张）初始化计数器
 func (cc *CardCounter) Reset() {
-	// 3-A 和 2 各有 4 张牌
-	for rank := card.Rank3; rank <= card.Rank2; rank++ {
-		cc.remaining[rank] = 4
+	clear(cc.remaining)
+	for _, c := range cc.deck {
+		cc.remaining[c.Rank]++
 	}
+}
 
-	// 王各有 1 张
-	cc.remaining[card.RankBlackJoker] = 1
-	cc.remaining[card.RankRedJoker] = 1
+// SetDeck 设置本局使用的整副牌（两副牌、去掉部分点数等玩法）并重新初始化计数器
+func (cc *CardCounter) SetDeck(deck card.Deck) {
+	cc.deck = deck
+	cc.Reset()
 }
 
 // DeductCards 从计数器中扣除已出的牌
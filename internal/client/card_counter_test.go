@@ -316,3 +316,38 @@ func TestCardCounter_GameScenario_DuringPlay(t *testing.T) {
 	cc.DeductCards(otherPlayerPlayed)
 	assert.Equal(t, 35, countTotalCards(cc), "其他玩家出2张后，记牌器35张")
 }
+
+func TestCardCounter_SetDeck(t *testing.T) {
+	t.Parallel()
+
+	cc := NewCardCounter()
+	cc.DeductCards([]card.Card{{Rank: card.Rank3}})
+	cc.SetDeck(card.NewDecks(2))
+
+	remaining := cc.GetRemaining()
+	assert.Equal(t, 8, remaining[card.Rank3], "two decks should reset to 8 cards per rank")
+	assert.Equal(t, 2, remaining[card.RankRedJoker])
+
+	total := 0
+	for _, count := range remaining {
+		total += count
+	}
+	assert.Equal(t, 108, total)
+
+	// Reset keeps the deck count
+	cc.DeductCards([]card.Card{{Rank: card.RankA}})
+	cc.Reset()
+	assert.Equal(t, 8, cc.GetRemaining()[card.RankA])
+}
+
+func TestCardCounter_SetDeckWithoutRanks(t *testing.T) {
+	t.Parallel()
+
+	cc := NewCardCounter()
+	cc.SetDeck(card.NewDeck().WithoutRanks(card.Rank3, card.Rank4))
+
+	remaining := cc.GetRemaining()
+	assert.Zero(t, remaining[card.Rank3])
+	assert.Zero(t, remaining[card.Rank4])
+	assert.Equal(t, 4, remaining[card.Rank5])
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// dealRecordFile 最近一局发牌记录的文件名（位于 ~/.fight-the-landlord）
+const dealRecordFile = "last_deal.json"
+
+// DealRecord 一局的发牌记录：开局承诺、自己的种子与手牌，以及结算时服务端揭示的证明
+type DealRecord struct {
+	SeedCommit string                `json:"seed_commit"`         // 开局时服务端公布的承诺值
+	ClientSeed string                `json:"client_seed"`         // 自己上报的客户端种子
+	WildRank   card.Rank             `json:"wild_rank,omitempty"` // 癞子玩法本局的癞子点数，0 表示非癞子玩法
+	Seat       int                   `json:"seat"`                // 自己的座位号
+	Hand       []protocol.CardInfo   `json:"hand"`                // 自己拿到的初始手牌
+	Proof      protocol.ShuffleProof `json:"proof"`               // 结算时揭示的洗牌证明
+}
+
+// DefaultDealRecordPath 返回默认的发牌记录路径
+func DefaultDealRecordPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("获取用户目录失败: %w", err)
+	}
+	return filepath.Join(home, ".fight-the-landlord", dealRecordFile), nil
+}
+
+// SaveDealRecord 将发牌记录写入 path
+func SaveDealRecord(path string, rec DealRecord) error {
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// LoadDealRecord 读取 path 中的发牌记录
+func LoadDealRecord(path string) (DealRecord, error) {
+	var rec DealRecord
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return rec, err
+	}
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return rec, fmt.Errorf("发牌记录格式错误: %w", err)
+	}
+	return rec, nil
+}
+
+// Verify 用揭示的服务端种子重算洗牌，确认：种子与开局承诺一致、牌序由种子推导而来、
+// 自己的种子确实参与了推导、自己拿到的手牌与牌序中本座位应得的牌一致，癞子玩法中癞子点数也由种子推导而来
+func (r DealRecord) Verify() error {
+	layout, ok := room.LayoutByMode(r.Proof.Mode)
+	if !ok {
+		return fmt.Errorf("未知的玩法模式: %s", r.Proof.Mode)
+	}
+	if r.Seat < 0 || r.Seat >= len(r.Proof.ClientSeeds) {
+		return fmt.Errorf("座位号 %d 超出范围", r.Seat)
+	}
+	if r.Proof.ClientSeeds[r.Seat] != r.ClientSeed {
+		return errors.New("证明中的客户端种子与自己上报的不一致")
+	}
+
+	deck := convert.InfosToCards(r.Proof.Deck)
+	if err := fairness.Verify(r.SeedCommit, r.Proof.ServerSeed, r.Proof.ClientSeeds, r.Proof.Round, layout.NewDeck(), deck); err != nil {
+		return err
+	}
+	if r.WildRank != 0 {
+		serverSeed, _ := hex.DecodeString(r.Proof.ServerSeed) // 已由 fairness.Verify 校验过格式
+		if fairness.WildRank(serverSeed, r.Proof.ClientSeeds, r.Proof.Round, layout.WildRanks()) != r.WildRank {
+			return errors.New("癞子点数与种子推导结果不符")
+		}
+	}
+
+	// 复式赛各桌按座位轮换原始牌序，本座位拿的是原始座位 (Seat+Shift) 的手牌
+	holding := (r.Seat + r.Proof.Shift) % layout.Players
+	expected := sortedCards(fairness.HandOf(deck, layout.Players, layout.HandSize, holding))
+	if !slices.Equal(expected, sortedCards(convert.InfosToCards(r.Hand))) {
+		return errors.New("自己拿到的手牌与牌序不符")
+	}
+	return nil
+}
+
+// UnseededSeats 返回未带客户端种子参与洗牌的座位（从小到大）。机器人不上报种子，
+// 真人玩家未上报时牌序只由服务端种子决定，验证通过也只说明服务端没有在承诺后换种子
+func (r DealRecord) UnseededSeats() []int {
+	var seats []int
+	for seat, seed := range r.Proof.ClientSeeds {
+		if seed == "" {
+			seats = append(seats, seat)
+		}
+	}
+	return seats
+}
+
+// sortedCards 返回按点数、花色排序的副本，用于忽略顺序比较手牌
+func sortedCards(cards []card.Card) []card.Card {
+	sorted := slices.Clone(cards)
+	slices.SortFunc(sorted, func(a, b card.Card) int {
+		if a.Rank != b.Rank {
+			return int(a.Rank - b.Rank)
+		}
+		return int(a.Suit - b.Suit)
+	})
+	return sorted
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// newDealRecord 模拟服务端洗牌，生成座位 1 的发牌记录
+func newDealRecord() DealRecord {
+	serverSeed := fairness.NewServerSeed()
+	clientSeeds := []string{"", "mine", "other"}
+	deck := fairness.Shuffle(card.NewDeck(), serverSeed, clientSeeds, 0)
+	return DealRecord{
+		SeedCommit: fairness.Commit(serverSeed),
+		ClientSeed: "mine",
+		Seat:       1,
+		Hand:       convert.CardsToInfos(fairness.HandOf(deck, 3, 17, 1)),
+		Proof: protocol.ShuffleProof{
+			ServerSeed:  hex.EncodeToString(serverSeed),
+			ClientSeeds: clientSeeds,
+			Deck:        convert.CardsToInfos(deck),
+		},
+	}
+}
+
+func TestDealRecord_Verify(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, newDealRecord().Verify())
+
+	t.Run("hand mismatch", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		rec.Hand[0] = rec.Proof.Deck[0]
+		assert.Error(t, rec.Verify())
+	})
+
+	t.Run("own seed missing", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		rec.ClientSeed = "forgotten"
+		assert.Error(t, rec.Verify())
+	})
+
+	t.Run("duplicate shift", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		deck := convert.InfosToCards(rec.Proof.Deck)
+		rec.Proof.Shift = 1
+		rec.Hand = convert.CardsToInfos(fairness.HandOf(deck, 3, 17, 2))
+		require.NoError(t, rec.Verify(), "座位 1 轮换后拿原始座位 2 的手牌")
+	})
+
+	t.Run("wild rank", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		serverSeed, err := hex.DecodeString(rec.Proof.ServerSeed)
+		require.NoError(t, err)
+		rec.WildRank = fairness.WildRank(serverSeed, rec.Proof.ClientSeeds, rec.Proof.Round, room.Layout{}.WildRanks())
+		require.NoError(t, rec.Verify())
+
+		if rec.WildRank == card.Rank3 {
+			rec.WildRank = card.Rank4
+		} else {
+			rec.WildRank = card.Rank3
+		}
+		assert.Error(t, rec.Verify(), "癞子点数不是由种子推导的")
+	})
+
+	t.Run("unseeded seats", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		assert.Equal(t, []int{0}, rec.UnseededSeats())
+		rec.Proof.ClientSeeds = []string{"", "", ""}
+		assert.Equal(t, []int{0, 1, 2}, rec.UnseededSeats())
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		t.Parallel()
+		rec := newDealRecord()
+		rec.Proof.Mode = "five"
+		assert.Error(t, rec.Verify())
+	})
+}
+
+func TestDealRecord_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "deal.json")
+	rec := newDealRecord()
+	require.NoError(t, SaveDealRecord(path, rec))
+
+	loaded, err := LoadDealRecord(path)
+	require.NoError(t, err)
+	assert.Equal(t, rec, loaded)
+	assert.NoError(t, loaded.Verify())
+}
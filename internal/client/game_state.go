@@ -2,9 +2,15 @@ package client
 
 import (
 	"cmp"
+	"fmt"
+	"maps"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 )
 
@@ -20,15 +26,45 @@ type GameState struct {
 
 	// 游戏进程
 	RoomCode       string
+	RuleSet        string    // 本局房规名称（空为经典规则）
+	WildRank       card.Rank // 本局癞子点数，0 表示非癞子玩法
+	Mode           string    // 人数玩法模式（空为经典三人）
 	CurrentTurn    string
 	LastPlayedBy   string
 	LastPlayedName string
 	LastPlayed     []card.Card
-	LastHandType   string
+	LastHandType   string           // 上家出牌的牌型名称，仅用于展示
+	LastReading    rule.HandReading // 上家出牌的读法，还原牌型时以它为准
 
 	// 叫抢地主 / 倍数
-	Multiplier int  // 当前倍数（叫抢阶段为底倍，出牌阶段含炸弹等累计）
-	IsGrabTurn bool // 当前叫地主轮是否处于抢地主阶段
+	Multiplier int                           // 当前倍数（由服务端推送，含抢地主、明牌、底牌、炸弹等）
+	Breakdown  *protocol.MultiplierBreakdown // 当前倍数的构成，结算时含春天/反春天
+	IsGrabTurn bool                          // 当前叫地主轮是否处于抢地主阶段
+	BidOptions []int                         // 叫分模式下当前可叫的分数，叫抢模式为空
+
+	// 底牌翻倍
+	BottomPattern string // 触发翻倍的底牌牌型名称，未翻倍为空
+	BottomBonus   int    // 底牌翻倍倍数
+
+	// 加倍
+	Doubles       map[string]int // 玩家 ID → 加倍选择（只含已选择的玩家）
+	DoublePending bool           // 加倍阶段自己是否尚未选择
+
+	// 明牌
+	RevealedHands map[string][]card.Card // 玩家 ID → 明牌玩家的当前手牌
+
+	// 观战
+	SpectateStage  string                 // 观战时对局所处阶段（bidding/doubling/playing），非观战为空
+	SpectatorHands map[string][]card.Card // 玩家 ID → 延迟公开的手牌
+	HandDelay      int                    // 手牌延迟公开的秒数，0 表示不公开
+
+	// 系列赛
+	SeriesHand  int                              // 当前是系列赛第几局，0 表示单局
+	SeriesHands int                              // 系列赛总局数
+	Standings   *protocol.SeriesStandingsPayload // 最近一局结束后的系列赛排名
+
+	// 再来一局投票状态，对局结束后由服务端推送
+	Rematch *protocol.RematchStatusPayload
 
 	// 游戏结果
 	Winner           string
@@ -36,6 +72,12 @@ type GameState struct {
 	FinalMultiplier  int                    // 结算最终倍数
 	Scores           []protocol.PlayerScore // 各玩家本局得分
 
+	// 可验证发牌
+	SeedCommit     string      // 开局时服务端公布的种子承诺值
+	ClientSeed     string      // 本局使用的自己的客户端种子
+	DealtHand      []card.Card // 本局发到的初始手牌（不含底牌）
+	NextSeedCommit string      // 上报种子前收到的下一局种子承诺，开局时与 SeedCommit 核对
+
 	// 功能组件
 	CardCounter *CardCounter
 }
@@ -60,17 +102,282 @@ func (gs *GameState) Reset() {
 	gs.BottomCards = nil
 	gs.Players = nil
 	gs.RoomCode = ""
+	gs.RuleSet = ""
+	gs.WildRank = 0
+	gs.Mode = ""
 	gs.CurrentTurn = ""
 	gs.LastPlayedBy = ""
 	gs.LastPlayedName = ""
 	gs.LastPlayed = nil
 	gs.LastHandType = ""
+	gs.LastReading = rule.HandReading{}
 	gs.IsLandlord = false
 	gs.Multiplier = 0
+	gs.Breakdown = nil
 	gs.IsGrabTurn = false
+	gs.BidOptions = nil
+	gs.BottomPattern = ""
+	gs.BottomBonus = 0
+	gs.Doubles = nil
+	gs.DoublePending = false
+	gs.RevealedHands = nil
+	gs.SpectateStage = ""
+	gs.SpectatorHands = nil
+	gs.HandDelay = 0
+	gs.SeriesHand = 0
+	gs.SeriesHands = 0
+	gs.Standings = nil
+	gs.Rematch = nil
 	gs.Winner = ""
 	gs.WinnerIsLandlord = false
 	gs.FinalMultiplier = 0
 	gs.Scores = nil
+	gs.SeedCommit = ""
+	gs.ClientSeed = ""
+	gs.DealtHand = nil
+	gs.NextSeedCommit = ""
 	gs.CardCounter = NewCardCounter()
 }
+
+// SeriesContinues 刚结束的一局是否属于尚未打完的系列赛（结算后继续在房间里打下一局）
+func (gs *GameState) SeriesContinues() bool {
+	return gs.Standings != nil && !gs.Standings.Final
+}
+
+// RematchPending 再来一局投票是否仍在进行
+func (gs *GameState) RematchPending() bool {
+	return gs.Rematch != nil && gs.Rematch.Result == protocol.RematchPending
+}
+
+// RematchAgreed 玩家是否已同意再来一局
+func (gs *GameState) RematchAgreed(playerID string) bool {
+	return gs.Rematch != nil && slices.Contains(gs.Rematch.Agreed, playerID)
+}
+
+// ResetForNextHand 系列赛局间清除上一局的状态，保留房间、玩家与系列赛成绩
+func (gs *GameState) ResetForNextHand() {
+	roomCode, players, standings := gs.RoomCode, gs.Players, gs.Standings
+	hand, hands, nextCommit := gs.SeriesHand, gs.SeriesHands, gs.NextSeedCommit
+	gs.Reset()
+	gs.RoomCode, gs.Standings, gs.NextSeedCommit = roomCode, standings, nextCommit
+	gs.SeriesHand, gs.SeriesHands = hand, hands
+	for _, p := range players {
+		gs.Players = append(gs.Players, protocol.PlayerInfo{ID: p.ID, Name: p.Name, Seat: p.Seat, IsBot: p.IsBot, Online: p.Online})
+	}
+}
+
+// ScoreBidding 当前是否为叫分模式
+func (gs *GameState) ScoreBidding() bool {
+	return len(gs.BidOptions) > 0
+}
+
+// BidAction 当前叫地主轮的动作名称
+func (gs *GameState) BidAction() string {
+	switch {
+	case gs.ScoreBidding():
+		return "叫分"
+	case gs.IsGrabTurn:
+		return "抢地主"
+	default:
+		return "叫地主"
+	}
+}
+
+// BidPrompt 轮到自己叫地主时的输入提示
+func (gs *GameState) BidPrompt() string {
+	if !gs.ScoreBidding() {
+		return gs.BidAction() + "? (Y/N)"
+	}
+	opts := make([]string, len(gs.BidOptions))
+	for i, score := range gs.BidOptions {
+		opts[i] = strconv.Itoa(score)
+	}
+	return fmt.Sprintf("叫分? (%s 或 0 不叫)", strings.Join(opts, "/"))
+}
+
+// DoubleLabel 返回玩家加倍选择的简短标签，未加倍时为空
+func (gs *GameState) DoubleLabel(playerID string) string {
+	switch gs.Doubles[playerID] {
+	case room.DoubleTwice:
+		return "加倍"
+	case room.DoubleSuper:
+		return "超级加倍"
+	default:
+		return ""
+	}
+}
+
+// IsTrustee 玩家是否处于托管中
+func (gs *GameState) IsTrustee(playerID string) bool {
+	for _, p := range gs.Players {
+		if p.ID == playerID {
+			return p.Trustee
+		}
+	}
+	return false
+}
+
+// RevealedHand 返回玩家明牌后的当前手牌（按点数降序），未明牌时返回 nil
+func (gs *GameState) RevealedHand(playerID string) []card.Card {
+	return gs.RevealedHands[playerID]
+}
+
+// BreakdownText 将倍数构成渲染为一行文本，如 "底分3 · 抢地主2次 · 底牌同花×3 · 炸弹1次 · 春天"
+func (gs *GameState) BreakdownText() string {
+	b := gs.Breakdown
+	if b == nil {
+		return ""
+	}
+
+	parts := []string{fmt.Sprintf("底分%d", max(b.Base, 1))}
+	if b.GrabCount > 0 {
+		parts = append(parts, fmt.Sprintf("抢地主%d次", b.GrabCount))
+	}
+	if b.ShowHand > 1 {
+		parts = append(parts, fmt.Sprintf("明牌×%d", b.ShowHand))
+	}
+	if b.BottomBonus > 1 {
+		parts = append(parts, fmt.Sprintf("底牌%s×%d", gs.BottomPattern, b.BottomBonus))
+	}
+	if b.BombCount > 0 {
+		parts = append(parts, fmt.Sprintf("炸弹%d次", b.BombCount))
+	}
+	switch {
+	case b.Spring:
+		parts = append(parts, "春天")
+	case b.AntiSpring:
+		parts = append(parts, "反春天")
+	}
+	return strings.Join(parts, " · ")
+}
+
+// Rules 返回本局的出牌规则（房规、癞子与几副牌）
+func (gs *GameState) Rules() rule.RuleSet {
+	rules, _ := rule.RuleSetByName(gs.RuleSet)
+	layout, _ := room.LayoutByMode(gs.Mode)
+	return layout.ApplyTo(rules).WithWild(gs.WildRank)
+}
+
+// LastHand 按服务端播报的读法还原上家出的牌，没有上家出牌时返回空
+func (gs *GameState) LastHand() rule.ParsedHand {
+	hand, _ := gs.Rules().PlayedHand(gs.LastPlayed, gs.LastReading)
+	return hand
+}
+
+// PlayChoices 列出这手牌当前可以按哪些读法打出（按优先级排列），同一牌型关键点数不同的读法
+// （如 3333 带 4444 与 4444 带 3333）各列一个。新一轮出牌时列出所有读法，否则只列能压过上家的读法；
+// 多于一个时需要玩家选择。
+func (gs *GameState) PlayChoices(cards []card.Card, newRound bool) []rule.ParsedHand {
+	rules := gs.Rules()
+	var last rule.ParsedHand
+	if !newRound {
+		last = gs.LastHand()
+	}
+	var choices []rule.ParsedHand
+	for _, h := range rules.Interpretations(cards) {
+		if last.IsEmpty() || rules.CanBeat(h, last) {
+			choices = append(choices, h)
+		}
+	}
+	return choices
+}
+
+// Hints 列出当前可以出的牌作为出牌提示：新一轮列出所有合法出牌，否则只列能压过上家的。
+// 按代价从小到大排列：普通牌型按关键点数、张数从小到大，炸弹与王炸放在最后（软炸弹在硬炸弹前）；
+// 同一组牌的多种读法只列一次
+func (gs *GameState) Hints(newRound bool) []rule.ParsedHand {
+	var last rule.ParsedHand
+	if !newRound {
+		last = gs.LastHand()
+	}
+	moves := gs.Rules().GenerateMoves(gs.Hand, last)
+	slices.SortStableFunc(moves, func(a, b rule.ParsedHand) int {
+		if c := cmp.Compare(bombClass(a), bombClass(b)); c != 0 || bombClass(a) == 0 {
+			return cmp.Or(c, cmp.Compare(a.KeyRank, b.KeyRank), cmp.Compare(len(a.Cards), len(b.Cards)))
+		}
+		return cmp.Or(cmp.Compare(a.Length, b.Length), cmp.Compare(a.KeyRank, b.KeyRank))
+	})
+
+	var hints []rule.ParsedHand
+	seen := make(map[string]bool)
+	for _, m := range moves {
+		if text := HintText(m.Cards); !seen[text] {
+			seen[text] = true
+			hints = append(hints, m)
+		}
+	}
+	return hints
+}
+
+// bombClass 出牌提示的排序分组：普通牌型为 0，软炸弹 1，硬炸弹 2，王炸 3
+func bombClass(h rule.ParsedHand) int {
+	switch {
+	case h.Type == rule.Rocket:
+		return 3
+	case h.Type == rule.Bomb && h.Soft:
+		return 1
+	case h.Type == rule.Bomb:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// HintText 把一手牌写成出牌输入框的格式（如 33344），按点数从小到大、同点数多的在前
+func HintText(cards []card.Card) string {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		counts[c.Rank]++
+	}
+	ranks := slices.Collect(maps.Keys(counts))
+	slices.SortFunc(ranks, func(a, b card.Rank) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+	var sb strings.Builder
+	for _, r := range ranks {
+		sb.WriteString(strings.Repeat(r.String(), counts[r]))
+	}
+	return sb.String()
+}
+
+// ChoiceLabels 出牌读法选择提示中每个选项的文字。同名的读法（如 3333 带 4444 与 4444 带 3333
+// 都是四带两对）在名称后注明主体的点数，供玩家区分
+func ChoiceLabels(choices []rule.ParsedHand) []string {
+	labels := make([]string, len(choices))
+	for i, h := range choices {
+		labels[i] = h.Name()
+		same := slices.ContainsFunc(choices, func(c rule.ParsedHand) bool {
+			return c.Reading() != h.Reading() && c.Name() == h.Name()
+		})
+		if same {
+			labels[i] += "(" + mainText(h) + ")"
+		}
+	}
+	return labels
+}
+
+// mainText 写出读法的主体（不含带牌），如四带两对的 4444、飞机的 333444
+func mainText(h rule.ParsedHand) string {
+	copies, span := 1, 1
+	switch h.Type {
+	case rule.Pair:
+		copies = 2
+	case rule.Trio, rule.TrioWithSingle, rule.TrioWithPair:
+		copies = 3
+	case rule.Bomb:
+		copies = max(4, h.Length)
+	case rule.FourWithTwo, rule.FourWithTwoPairs:
+		copies = 4
+	case rule.Straight:
+		span = h.Length
+	case rule.PairStraight:
+		copies, span = 2, h.Length
+	case rule.Plane, rule.PlaneWithSingles, rule.PlaneWithPairs:
+		copies, span = 3, h.Length
+	}
+	var sb strings.Builder
+	for i := range span {
+		sb.WriteString(strings.Repeat((h.KeyRank + card.Rank(i)).String(), copies))
+	}
+	return sb.String()
+}
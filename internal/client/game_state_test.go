@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 )
 
@@ -122,6 +124,7 @@ func TestGameState_Reset(t *testing.T) {
 	gs.LastPlayedName = "Bob"
 	gs.LastPlayed = []card.Card{{Rank: card.Rank7}}
 	gs.LastHandType = "Single"
+	gs.LastReading = rule.HandReading{Type: rule.Single, KeyRank: card.Rank7}
 	gs.IsLandlord = true
 	gs.Winner = "player1"
 	gs.WinnerIsLandlord = true
@@ -142,6 +145,7 @@ func TestGameState_Reset(t *testing.T) {
 	assert.Empty(t, gs.LastPlayedName, "LastPlayedName should be empty after reset")
 	assert.Nil(t, gs.LastPlayed, "LastPlayed should be nil after reset")
 	assert.Empty(t, gs.LastHandType, "LastHandType should be empty after reset")
+	assert.True(t, gs.LastReading.IsEmpty(), "LastReading should be empty after reset")
 	assert.False(t, gs.IsLandlord, "IsLandlord should be false after reset")
 	assert.Empty(t, gs.Winner, "Winner should be empty after reset")
 	assert.False(t, gs.WinnerIsLandlord, "WinnerIsLandlord should be false after reset")
@@ -231,3 +235,160 @@ func TestGameState_Integration(t *testing.T) {
 	assert.Equal(t, 4, gs.CardCounter.GetRemaining()[card.Rank3],
 		"CardCounter not reset properly")
 }
+
+func TestGameState_PlayChoices(t *testing.T) {
+	t.Parallel()
+
+	gs := NewGameState()
+	var cards []card.Card
+	for _, rank := range []card.Rank{card.Rank3, card.Rank4} {
+		for range 4 {
+			cards = append(cards, card.Card{Rank: rank})
+		}
+	}
+
+	// 新一轮：4444 带 3333、3333 带 4444 与飞机带单各列一个
+	choices := gs.PlayChoices(cards, true)
+	require.Len(t, choices, 3)
+	assert.Equal(t, rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank4}, choices[0].Reading())
+	assert.Equal(t, rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank3}, choices[1].Reading())
+	assert.Equal(t, rule.PlaneWithSingles, choices[2].Type)
+	assert.Equal(t, []string{"四带两对(4444)", "四带两对(3333)", "飞机带单"}, ChoiceLabels(choices))
+
+	// 上家四带两对（5 带 66、77）：两种读法都压不过
+	gs.LastPlayed = []card.Card{
+		{Rank: card.Rank5}, {Rank: card.Rank5}, {Rank: card.Rank5},
+		{Rank: card.Rank5}, {Rank: card.Rank6}, {Rank: card.Rank6},
+		{Rank: card.Rank7}, {Rank: card.Rank7},
+	}
+	gs.LastHandType = rule.FourWithTwoPairs.String()
+	gs.LastReading = rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank5}
+	assert.Empty(t, gs.PlayChoices(cards, false))
+
+	// 上家四带两对（3 带 55、66）：只能按 4444 带 3333 压
+	gs.LastPlayed = []card.Card{
+		{Rank: card.Rank3}, {Rank: card.Rank3}, {Rank: card.Rank3},
+		{Rank: card.Rank3}, {Rank: card.Rank5}, {Rank: card.Rank5},
+		{Rank: card.Rank6}, {Rank: card.Rank6},
+	}
+	gs.LastReading = rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank3}
+	choices = gs.PlayChoices(cards, false)
+	require.Len(t, choices, 1)
+	assert.Equal(t, rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank4}, choices[0].Reading())
+}
+
+func TestGameState_LastHand(t *testing.T) {
+	t.Parallel()
+
+	gs := NewGameState()
+	assert.True(t, gs.LastHand().IsEmpty())
+
+	// 上家按 3333 带 4444 出的牌，按播报的读法还原，而不是默认的 4444 带 3333
+	for _, rank := range []card.Rank{card.Rank3, card.Rank4} {
+		for range 4 {
+			gs.LastPlayed = append(gs.LastPlayed, card.Card{Rank: rank})
+		}
+	}
+	gs.LastReading = rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank3}
+	assert.Equal(t, gs.LastReading, gs.LastHand().Reading())
+}
+
+func TestGameState_Hints(t *testing.T) {
+	t.Parallel()
+
+	hand := func(ranks ...card.Rank) []card.Card {
+		cards := make([]card.Card, len(ranks))
+		for i, r := range ranks {
+			cards[i] = card.Card{Rank: r}
+		}
+		return cards
+	}
+	texts := func(hints []rule.ParsedHand) []string {
+		out := make([]string, len(hints))
+		for i, h := range hints {
+			out[i] = HintText(h.Cards)
+		}
+		return out
+	}
+
+	gs := NewGameState()
+	gs.Hand = hand(card.RankRedJoker, card.RankBlackJoker, card.RankK,
+		card.Rank9, card.Rank9, card.Rank9, card.Rank9, card.Rank8, card.Rank8, card.Rank5)
+
+	// 压单张 7：从小到大，炸弹与王炸在最后
+	gs.LastPlayed = hand(card.Rank7)
+	gs.LastHandType = rule.Single.String()
+	assert.Equal(t, []string{"8", "9", "K", "B", "R", "9999", "BR"}, texts(gs.Hints(false)))
+
+	// 压对 K：只有炸弹能压
+	gs.LastPlayed = hand(card.RankK, card.RankK)
+	gs.LastHandType = rule.Pair.String()
+	assert.Equal(t, []string{"9999", "BR"}, texts(gs.Hints(false)))
+
+	// 新一轮：同点数先出张数少的
+	gs.Hand = hand(card.Rank4, card.Rank3, card.Rank3)
+	assert.Equal(t, []string{"3", "33", "4"}, texts(gs.Hints(true)))
+
+	gs.Hand = hand(card.Rank3)
+	gs.LastPlayed = hand(card.Rank2)
+	gs.LastHandType = rule.Single.String()
+	assert.Empty(t, gs.Hints(false))
+}
+
+func TestHintText(t *testing.T) {
+	t.Parallel()
+
+	cards := []card.Card{{Rank: card.Rank4}, {Rank: card.Rank3}, {Rank: card.Rank4}, {Rank: card.Rank3}, {Rank: card.Rank3}}
+	assert.Equal(t, "33344", HintText(cards))
+	assert.Equal(t, "1010", HintText([]card.Card{{Rank: card.Rank10}, {Rank: card.Rank10}}))
+}
+
+func TestGameState_BidPrompt(t *testing.T) {
+	t.Parallel()
+	gs := NewGameState()
+
+	assert.Equal(t, "叫地主? (Y/N)", gs.BidPrompt())
+
+	gs.IsGrabTurn = true
+	assert.Equal(t, "抢地主? (Y/N)", gs.BidPrompt())
+
+	gs.BidOptions = []int{2, 3}
+	assert.True(t, gs.ScoreBidding())
+	assert.Equal(t, "叫分", gs.BidAction())
+	assert.Equal(t, "叫分? (2/3 或 0 不叫)", gs.BidPrompt())
+}
+
+func TestGameState_DoubleLabel(t *testing.T) {
+	t.Parallel()
+	gs := NewGameState()
+	assert.Empty(t, gs.DoubleLabel("p1"))
+
+	gs.Doubles = map[string]int{"p1": room.DoubleTwice, "p2": room.DoubleSuper, "p3": room.DoubleNone}
+	assert.Equal(t, "加倍", gs.DoubleLabel("p1"))
+	assert.Equal(t, "超级加倍", gs.DoubleLabel("p2"))
+	assert.Empty(t, gs.DoubleLabel("p3"))
+}
+
+func TestGameState_IsTrustee(t *testing.T) {
+	t.Parallel()
+	gs := NewGameState()
+	assert.False(t, gs.IsTrustee("p1"))
+
+	gs.Players = []protocol.PlayerInfo{{ID: "p1", Trustee: true}, {ID: "p2"}}
+	assert.True(t, gs.IsTrustee("p1"))
+	assert.False(t, gs.IsTrustee("p2"))
+	assert.False(t, gs.IsTrustee("p3"))
+}
+
+func TestGameState_BreakdownText(t *testing.T) {
+	t.Parallel()
+	gs := NewGameState()
+	assert.Empty(t, gs.BreakdownText())
+
+	gs.Breakdown = &protocol.MultiplierBreakdown{Base: 1, ShowHand: 1, BottomBonus: 1}
+	assert.Equal(t, "底分1", gs.BreakdownText())
+
+	gs.BottomPattern = "同花"
+	gs.Breakdown = &protocol.MultiplierBreakdown{Base: 3, GrabCount: 2, ShowHand: 5, BottomBonus: 3, BombCount: 1, Spring: true}
+	assert.Equal(t, "底分3 · 抢地主2次 · 明牌×5 · 底牌同花×3 · 炸弹1次 · 春天", gs.BreakdownText())
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+)
+
+// CheckPlay 发送前按本局规则检查这手牌，返回可以打出的读法（同 PlayChoices）；
+// 没有可出的读法时说明具体原因（组不成牌型、房规不允许、压不过上家）。
+// 只是提前给出提示，是否合法仍以服务端校验为准；无法判断原因时返回 nil，交给服务端裁决
+func (gs *GameState) CheckPlay(cards []card.Card, newRound bool) ([]rule.ParsedHand, error) {
+	choices := gs.PlayChoices(cards, newRound)
+	if len(choices) > 0 {
+		return choices, nil
+	}
+	return nil, gs.explainNoChoice(cards, newRound)
+}
+
+// explainNoChoice 说明一手牌为什么没有可以打出的读法，无法判断原因时返回 nil
+func (gs *GameState) explainNoChoice(cards []card.Card, newRound bool) error {
+	rules := gs.Rules()
+	interps := rules.Interpretations(cards)
+	if len(interps) == 0 {
+		if rules.IsLaizi() && slices.ContainsFunc(cards, func(c card.Card) bool { return c.IsWild(rules.Wild) }) {
+			return errors.New("这几张牌加上癞子也组不成合法牌型")
+		}
+		if _, err := rule.ParseHands(cards); err == nil {
+			// 标准规则下是合法牌型，只是本房规不允许
+			_, err = rules.ParseHand(cards)
+			return err
+		}
+		return explainShape(cards)
+	}
+
+	last := gs.LastHand()
+	if newRound || last.IsEmpty() {
+		return nil
+	}
+	// 有与上家同牌型的读法时按它说明，否则按优先级最高的读法说明
+	hand := interps[0]
+	if i := slices.IndexFunc(interps, func(h rule.ParsedHand) bool { return h.Type == last.Type }); i >= 0 {
+		hand = interps[i]
+	}
+	return explainCannotBeat(hand, last)
+}
+
+// explainShape 说明一组牌为什么组不成任何牌型
+func explainShape(cards []card.Card) error {
+	counts := make(map[card.Rank]int)
+	for _, c := range cards {
+		counts[c.Rank]++
+	}
+	var ones, pairs, trios, fours int
+	for _, n := range counts {
+		switch {
+		case n == 1:
+			ones++
+		case n == 2:
+			pairs++
+		case n == 3:
+			trios++
+		default:
+			fours++
+		}
+	}
+	total := len(cards)
+
+	switch {
+	case fours == 1 && counts[fourRank(counts)] > 4:
+		// 两副牌中五张及以上同点数只能当炸弹单独出
+		return fmt.Errorf("%d 张的炸弹不能带牌", counts[fourRank(counts)])
+	case fours == 1 && trios == 0:
+		switch kickers := total - counts[fourRank(counts)]; {
+		case kickers == 1:
+			return errors.New("四张不能只带一张，四带二要带两张单牌或一对")
+		case kickers == 4:
+			return errors.New("四带两对要带两个对子，你带的不是两对")
+		default:
+			return fmt.Errorf("四带二只能带两张，四带两对只能带两对，你带了 %d 张", kickers)
+		}
+	case trios == 1 && fours == 0:
+		if total == 5 {
+			return errors.New("三带二要带一对，你带的是两张单牌")
+		}
+		return fmt.Errorf("三张最多带一对（三带一或三带二），你带了 %d 张", total-3)
+	case trios >= 2:
+		body := longestTrioRun(counts)
+		if body < 2 {
+			return errors.New("飞机的三张点数必须相连，且不能有 2 和王")
+		}
+		switch wings := total - body*3; wings {
+		case body:
+			return errors.New("飞机带单的翅膀点数不能相同")
+		case body * 2:
+			return fmt.Errorf("飞机带对的翅膀要是 %d 个对子", body)
+		default:
+			return fmt.Errorf("%d 连飞机要带 %d 张单牌或 %d 对，你带了 %d 张", body, body, body, wings)
+		}
+	case ones == total:
+		switch {
+		case total < 5:
+			return fmt.Errorf("顺子至少要 5 张，你出了 %d 张", total)
+		case hasTwoOrJoker(counts):
+			return errors.New("顺子不能有 2 和王")
+		default:
+			return errors.New("顺子的点数必须相连")
+		}
+	case pairs*2 == total:
+		switch {
+		case pairs < 3:
+			return fmt.Errorf("连对至少要 3 对，你出了 %d 对", pairs)
+		case hasTwoOrJoker(counts):
+			return errors.New("连对不能有 2 和王")
+		default:
+			return errors.New("连对的点数必须相连")
+		}
+	}
+	return errors.New("这几张牌组不成合法牌型")
+}
+
+// explainCannotBeat 说明 hand 为什么压不过上家的 last
+func explainCannotBeat(hand, last rule.ParsedHand) error {
+	switch {
+	case last.Type == rule.Rocket:
+		return errors.New("上家是王炸，没有牌能压过")
+	case hand.Type != last.Type:
+		return fmt.Errorf("上家出的是%s，你出的是%s，牌型不同只能用炸弹压", last.Name(), hand.Name())
+	case hand.Length != last.Length && hand.Type != rule.Bomb:
+		return fmt.Errorf("你的%s长度为 %d，上家为 %d", hand.Name(), hand.Length, last.Length)
+	default:
+		return fmt.Errorf("你的%s %s 压不过上家的%s %s", hand.Name(), HintText(hand.Cards), last.Name(), HintText(last.Cards))
+	}
+}
+
+// fourRank 返回张数最多的点数（四张及以上）
+func fourRank(counts map[card.Rank]int) card.Rank {
+	var best card.Rank
+	for r, n := range counts {
+		if n >= 4 && n > counts[best] {
+			best = r
+		}
+	}
+	return best
+}
+
+// longestTrioRun 三张及以上的点数（不含 2 和王）中最长的连续段长度
+func longestTrioRun(counts map[card.Rank]int) int {
+	var ranks []card.Rank
+	for r, n := range counts {
+		if n >= 3 && r < card.Rank2 {
+			ranks = append(ranks, r)
+		}
+	}
+	slices.Sort(ranks)
+
+	longest, run := 0, 0
+	for i, r := range ranks {
+		if i > 0 && ranks[i-1]+1 == r {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+// hasTwoOrJoker 是否含有 2 或王
+func hasTwoOrJoker(counts map[card.Rank]int) bool {
+	for r := range counts {
+		if r >= card.Rank2 {
+			return true
+		}
+	}
+	return false
+}
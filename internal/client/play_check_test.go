@@ -0,0 +1,132 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+)
+
+// ranksToCards 按点数构造一手牌（不区分花色）
+func ranksToCards(ranks ...card.Rank) []card.Card {
+	cards := make([]card.Card, len(ranks))
+	for i, r := range ranks {
+		cards[i] = card.Card{Rank: r}
+	}
+	return cards
+}
+
+func TestGameState_CheckPlay_Shape(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ranks []card.Rank
+		want  string
+	}{
+		{"三带两单", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank7, card.Rank9}, "三带二要带一对，你带的是两张单牌"},
+		{"三张带三张", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank7, card.Rank9, card.Rank9}, "三张最多带一对（三带一或三带二），你带了 3 张"},
+		{"四带一", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank5, card.Rank7}, "四张不能只带一张，四带二要带两张单牌或一对"},
+		{"顺子太短", []card.Rank{card.Rank5, card.Rank6, card.Rank7, card.Rank8}, "顺子至少要 5 张，你出了 4 张"},
+		{"顺子带 2", []card.Rank{card.RankJ, card.RankQ, card.RankK, card.RankA, card.Rank2}, "顺子不能有 2 和王"},
+		{"顺子不连", []card.Rank{card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank8}, "顺子的点数必须相连"},
+		{"连对太短", []card.Rank{card.Rank5, card.Rank5, card.Rank6, card.Rank6}, "连对至少要 3 对，你出了 2 对"},
+		{"飞机不连", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank7, card.Rank7, card.Rank7}, "飞机的三张点数必须相连，且不能有 2 和王"},
+		{"飞机翅膀不对", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank6, card.Rank8, card.Rank9, card.Rank9}, "2 连飞机要带 2 张单牌或 2 对，你带了 3 张"},
+		{"飞机带对不是对子", []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank6, card.Rank8, card.Rank9, card.Rank10, card.RankJ}, "飞机带对的翅膀要是 2 个对子"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewGameState().CheckPlay(ranksToCards(tt.ranks...), true)
+			require.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
+func TestGameState_CheckPlay_TwoDecks(t *testing.T) {
+	t.Parallel()
+
+	gs := NewGameState()
+	gs.Mode = room.ModeFour
+	five := []card.Rank{card.Rank5, card.Rank5, card.Rank5, card.Rank5, card.Rank5}
+
+	_, err := gs.CheckPlay(ranksToCards(append(five, card.Rank7)...), true)
+	require.Error(t, err)
+	assert.Equal(t, "5 张的炸弹不能带牌", err.Error())
+
+	_, err = gs.CheckPlay(ranksToCards(append(five, card.Rank7, card.Rank9)...), true)
+	require.Error(t, err)
+	assert.Equal(t, "5 张的炸弹不能带牌", err.Error())
+
+	choices, err := gs.CheckPlay(ranksToCards(five...), true)
+	require.NoError(t, err)
+	require.NotEmpty(t, choices)
+	assert.Equal(t, rule.Bomb, choices[0].Type)
+}
+
+func TestGameState_CheckPlay_Rules(t *testing.T) {
+	t.Parallel()
+
+	gs := NewGameState()
+	gs.RuleSet = rule.RuleSetShort
+	_, err := gs.CheckPlay(ranksToCards(card.Rank5, card.Rank5, card.Rank5, card.Rank5, card.Rank7, card.Rank9), true)
+	require.Error(t, err)
+	assert.Equal(t, "当前规则不允许四带二", err.Error())
+}
+
+func TestGameState_CheckPlay_Beat(t *testing.T) {
+	t.Parallel()
+
+	plane := func(from card.Rank, n int) []card.Card {
+		var cards []card.Card
+		for r := from; r < from+card.Rank(n); r++ {
+			cards = append(cards, ranksToCards(r, r, r)...)
+		}
+		return cards
+	}
+
+	gs := NewGameState()
+	gs.LastPlayed = plane(card.Rank3, 3)
+	gs.LastHandType = rule.Plane.String()
+
+	_, err := gs.CheckPlay(plane(card.Rank8, 2), false)
+	require.Error(t, err)
+	assert.Equal(t, "你的飞机长度为 2，上家为 3", err.Error())
+
+	_, err = gs.CheckPlay(ranksToCards(card.Rank9, card.Rank9), false)
+	require.Error(t, err)
+	assert.Equal(t, "上家出的是飞机，你出的是对子，牌型不同只能用炸弹压", err.Error())
+
+	choices, err := gs.CheckPlay(plane(card.Rank8, 3), false)
+	require.NoError(t, err)
+	require.Len(t, choices, 1)
+	assert.Equal(t, rule.Plane, choices[0].Type)
+
+	choices, err = gs.CheckPlay(ranksToCards(card.Rank9, card.Rank9, card.Rank9, card.Rank9), false)
+	require.NoError(t, err, "炸弹可以压")
+	require.Len(t, choices, 1)
+	assert.Equal(t, rule.Bomb, choices[0].Type)
+
+	choices, err = gs.CheckPlay(ranksToCards(card.Rank9, card.Rank9), true)
+	require.NoError(t, err, "新一轮随便出")
+	assert.Len(t, choices, 1)
+
+	gs.LastPlayed = ranksToCards(card.RankK, card.RankK)
+	gs.LastHandType = rule.Pair.String()
+	_, err = gs.CheckPlay(ranksToCards(card.Rank9, card.Rank9), false)
+	require.Error(t, err)
+	assert.Equal(t, "你的对子 99 压不过上家的对子 KK", err.Error())
+
+	gs.LastPlayed = ranksToCards(card.RankBlackJoker, card.RankRedJoker)
+	gs.LastHandType = rule.Rocket.String()
+	_, err = gs.CheckPlay(ranksToCards(card.Rank9, card.Rank9, card.Rank9, card.Rank9), false)
+	require.Error(t, err)
+	assert.Equal(t, "上家是王炸，没有牌能压过", err.Error())
+}
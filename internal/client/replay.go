@@ -0,0 +1,192 @@
+package client
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// ReplayFrame 回放中的一步：应用一条事件后的牌桌快照
+type ReplayFrame struct {
+	Event   gamelog.Event
+	Caption string        // 本步说明，如 "张三: 抢地主"
+	State   *GameState    // 牌桌快照（玩家、底牌、上家出牌、倍数与结算）
+	Hands   [][]card.Card // 与 State.Players 对应的各家当前手牌（按点数降序）
+}
+
+// BuildReplay 依次应用日志中的事件，生成每一步的牌桌快照
+func BuildReplay(l *gamelog.Log) []ReplayFrame {
+	st := &GameState{
+		RoomCode: l.RoomCode,
+		RuleSet:  l.RuleSet,
+		Mode:     l.Mode,
+		Players:  make([]protocol.PlayerInfo, len(l.Players)),
+	}
+	seatOf := make(map[string]int, len(l.Players))
+	for i, p := range l.Players {
+		st.Players[i] = protocol.PlayerInfo{ID: p.ID, Name: p.Name, Seat: p.Seat, Online: true}
+		seatOf[p.ID] = i
+	}
+	hands := make([][]card.Card, len(l.Players))
+	name := func(id string) string {
+		if i, ok := seatOf[id]; ok {
+			return l.Players[i].Name
+		}
+		return id
+	}
+
+	frames := make([]ReplayFrame, 0, len(l.Events))
+	for _, e := range l.Events {
+		seat, hasSeat := seatOf[e.PlayerID]
+		st.CurrentTurn = e.PlayerID
+		caption := ""
+
+		switch e.Type {
+		case gamelog.EventDeal:
+			for i := range hands {
+				if i < len(e.Hands) {
+					hands[i] = sortedDesc(e.Hands[i])
+				}
+				st.Players[i].IsLandlord = false
+			}
+			st.BottomCards = slices.Clone(e.BottomCards)
+			st.WildRank = e.WildRank
+			st.LastPlayed, st.LastPlayedBy, st.LastPlayedName, st.LastHandType = nil, "", "", ""
+			st.LastReading = rule.HandReading{}
+			st.Doubles = nil
+			caption = "发牌"
+			if e.Round > 1 {
+				caption = fmt.Sprintf("流局，第 %d 次发牌", e.Round)
+			}
+		case gamelog.EventBid:
+			caption = name(e.PlayerID) + ": " + bidCaption(e)
+		case gamelog.EventLandlord:
+			if hasSeat {
+				hands[seat] = sortedDesc(append(slices.Clone(hands[seat]), e.BottomCards...))
+				st.Players[seat].IsLandlord = true
+			}
+			caption = name(e.PlayerID) + " 成为地主，拿到底牌"
+		case gamelog.EventDouble:
+			if st.Doubles == nil {
+				st.Doubles = make(map[string]int)
+			}
+			st.Doubles[e.PlayerID] = e.Level
+			caption = name(e.PlayerID) + ": " + doubleCaption(e.Level)
+		case gamelog.EventShowHand:
+			caption = fmt.Sprintf("%s 明牌 ×%d", name(e.PlayerID), e.Level)
+		case gamelog.EventPlay:
+			if hasSeat {
+				hands[seat] = card.RemoveCards(hands[seat], e.Cards)
+			}
+			st.LastPlayed = slices.Clone(e.Cards)
+			st.LastPlayedBy = e.PlayerID
+			st.LastPlayedName = name(e.PlayerID)
+			st.LastHandType = e.HandType
+			st.LastReading = convert.InfoToReading(e.Reading)
+			caption = fmt.Sprintf("%s 出 %s", name(e.PlayerID), e.HandType)
+		case gamelog.EventPass:
+			caption = name(e.PlayerID) + ": 不出"
+		case gamelog.EventTimeout:
+			caption = fmt.Sprintf("%s %s超时", name(e.PlayerID), phaseCaption(e.Phase))
+		case gamelog.EventOffline:
+			caption = name(e.PlayerID) + " 离线"
+		case gamelog.EventOnline:
+			caption = name(e.PlayerID) + " 重连"
+		case gamelog.EventGameOver:
+			st.Winner = e.PlayerID
+			st.WinnerIsLandlord = hasSeat && st.Players[seat].IsLandlord
+			st.FinalMultiplier = e.Multiplier
+			st.Multiplier = e.Multiplier
+			st.Breakdown = e.Breakdown
+			st.Scores = e.Scores
+			role := "农民"
+			if st.WinnerIsLandlord {
+				role = "地主"
+			}
+			caption = fmt.Sprintf("%s（%s）获胜", name(e.PlayerID), role)
+		}
+
+		for i := range st.Players {
+			st.Players[i].CardsCount = len(hands[i])
+		}
+		frames = append(frames, ReplayFrame{
+			Event:   e,
+			Caption: caption,
+			State:   st.replaySnapshot(),
+			Hands:   cloneHands(hands),
+		})
+	}
+	return frames
+}
+
+// replaySnapshot 复制回放过程中会变化的字段，其余切片在回放时只整体替换不原地修改
+func (gs *GameState) replaySnapshot() *GameState {
+	snap := *gs
+	snap.Players = slices.Clone(gs.Players)
+	snap.Doubles = maps.Clone(gs.Doubles)
+	return &snap
+}
+
+// bidCaption 叫地主事件的说明
+func bidCaption(e gamelog.Event) string {
+	switch {
+	case e.Score > 0:
+		return fmt.Sprintf("叫 %d 分", e.Score)
+	case e.Bid && e.IsGrab:
+		return "抢地主"
+	case e.Bid:
+		return "叫地主"
+	case e.IsGrab:
+		return "不抢"
+	default:
+		return "不叫"
+	}
+}
+
+// doubleCaption 加倍选择的说明
+func doubleCaption(level int) string {
+	switch level {
+	case room.DoubleTwice:
+		return "加倍"
+	case room.DoubleSuper:
+		return "超级加倍"
+	default:
+		return "不加倍"
+	}
+}
+
+// phaseCaption 超时阶段的说明
+func phaseCaption(phase string) string {
+	switch phase {
+	case "bidding":
+		return "叫地主"
+	case "doubling":
+		return "加倍"
+	default:
+		return "出牌"
+	}
+}
+
+func sortedDesc(cards []card.Card) []card.Card {
+	sorted := slices.Clone(cards)
+	slices.SortStableFunc(sorted, func(a, b card.Card) int {
+		return cmp.Compare(b.Rank, a.Rank)
+	})
+	return sorted
+}
+
+func cloneHands(hands [][]card.Card) [][]card.Card {
+	out := make([][]card.Card, len(hands))
+	for i, h := range hands {
+		out[i] = slices.Clone(h)
+	}
+	return out
+}
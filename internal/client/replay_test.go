@@ -0,0 +1,89 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+func TestBuildReplay(t *testing.T) {
+	t.Parallel()
+
+	c := func(r card.Rank) card.Card { return card.Card{Suit: card.Spade, Rank: r} }
+	l := &gamelog.Log{
+		RoomCode: "AB12",
+		Players:  []gamelog.Player{{ID: "p1", Name: "甲"}, {ID: "p2", Name: "乙", Seat: 1}, {ID: "p3", Name: "丙", Seat: 2}},
+	}
+	l.Append(gamelog.Event{
+		Type:        gamelog.EventDeal,
+		Round:       1,
+		Hands:       [][]card.Card{{c(card.Rank3), c(card.Rank5)}, {c(card.Rank4)}, {c(card.Rank6)}},
+		BottomCards: []card.Card{c(card.RankK)},
+	})
+	l.Append(gamelog.Event{Type: gamelog.EventBid, PlayerID: "p1", Bid: true})
+	l.Append(gamelog.Event{Type: gamelog.EventBid, PlayerID: "p2", IsGrab: true})
+	l.Append(gamelog.Event{Type: gamelog.EventLandlord, PlayerID: "p1", BottomCards: []card.Card{c(card.RankK)}})
+	l.Append(gamelog.Event{Type: gamelog.EventDouble, PlayerID: "p2", Level: room.DoubleTwice})
+	l.Append(gamelog.Event{
+		Type:     gamelog.EventPlay,
+		PlayerID: "p1",
+		Cards:    []card.Card{c(card.Rank5)},
+		HandType: "单牌",
+		Reading:  protocol.HandReading{HandKind: int(rule.Single), KeyRank: int(card.Rank5)},
+	})
+	l.Append(gamelog.Event{Type: gamelog.EventTimeout, PlayerID: "p2", Phase: "playing"})
+	l.Append(gamelog.Event{Type: gamelog.EventPass, PlayerID: "p2"})
+	l.Append(gamelog.Event{
+		Type:       gamelog.EventGameOver,
+		PlayerID:   "p1",
+		Multiplier: 4,
+		Scores:     []protocol.PlayerScore{{PlayerID: "p1", Score: 8, IsLandlord: true}},
+	})
+
+	frames := BuildReplay(l)
+	require.Len(t, frames, len(l.Events))
+
+	captions := make([]string, len(frames))
+	for i, f := range frames {
+		captions[i] = f.Caption
+	}
+	assert.Equal(t, []string{
+		"发牌", "甲: 叫地主", "乙: 不抢", "甲 成为地主，拿到底牌", "乙: 加倍",
+		"甲 出 单牌", "乙 出牌超时", "乙: 不出", "甲（地主）获胜",
+	}, captions)
+
+	// 发牌后各家手牌按点数降序
+	assert.Equal(t, []card.Card{c(card.Rank5), c(card.Rank3)}, frames[0].Hands[0])
+	assert.Equal(t, 2, frames[0].State.Players[0].CardsCount)
+
+	// 地主拿到底牌
+	landlord := frames[3]
+	assert.True(t, landlord.State.Players[0].IsLandlord)
+	assert.Equal(t, []card.Card{c(card.RankK), c(card.Rank5), c(card.Rank3)}, landlord.Hands[0])
+	assert.Equal(t, "加倍", frames[4].State.DoubleLabel("p2"))
+
+	// 出牌后从手牌移除并成为上家出牌
+	play := frames[5]
+	assert.Equal(t, []card.Card{c(card.RankK), c(card.Rank3)}, play.Hands[0])
+	assert.Equal(t, 2, play.State.Players[0].CardsCount)
+	assert.Equal(t, "p1", play.State.LastPlayedBy)
+	assert.Equal(t, "单牌", play.State.LastHandType)
+	assert.Equal(t, rule.HandReading{Type: rule.Single, KeyRank: card.Rank5}, play.State.LastReading)
+
+	// 每一帧是独立快照，后续事件不影响前面的帧
+	assert.Len(t, frames[3].Hands[0], 3)
+	assert.Empty(t, frames[3].State.LastPlayed)
+	assert.Empty(t, frames[3].State.DoubleLabel("p2"))
+
+	over := frames[len(frames)-1].State
+	assert.Equal(t, "p1", over.Winner)
+	assert.True(t, over.WinnerIsLandlord)
+	assert.Equal(t, 4, over.FinalMultiplier)
+}
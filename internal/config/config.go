@@ -20,11 +20,16 @@ const (
 	defaultRedisAddr             = "localhost:6379"
 	defaultTurnTimeout           = 30
 	defaultBidTimeout            = 15
+	defaultScoreBidTimeout       = 20
+	defaultDoubleTimeout         = 10
 	defaultRoomTimeout           = 10
 	defaultShutdownTimeout       = 30
 	defaultShutdownCheckInterval = 15
 	defaultRoomCleanupDelay      = 30
 	defaultOfflineWaitTimeout    = 30
+	defaultRematchTimeout        = 20
+	defaultClientSeedTimeout     = 3
+	defaultTournamentHands       = 3
 	defaultRateLimitPerSecond    = 10
 	defaultRateLimitPerMinute    = 60
 	defaultBanDuration           = 60
@@ -36,11 +41,26 @@ const (
 
 // Config 服务端配置
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Redis    RedisConfig    `yaml:"redis"`
-	Game     GameConfig     `yaml:"game"`
-	Security SecurityConfig `yaml:"security"`
-	BOT      BotConfig      `yaml:"bot"`
+	Server     ServerConfig     `yaml:"server"`
+	Redis      RedisConfig      `yaml:"redis"`
+	Game       GameConfig       `yaml:"game"`
+	Security   SecurityConfig   `yaml:"security"`
+	BOT        BotConfig        `yaml:"bot"`
+	Tournament TournamentConfig `yaml:"tournament"`
+}
+
+// TournamentConfig 锦标赛配置
+type TournamentConfig struct {
+	Events []TournamentEventConfig `yaml:"events"` // 每天定时举行的锦标赛
+}
+
+// TournamentEventConfig 每天定时开赛的淘汰赛
+type TournamentEventConfig struct {
+	Name       string `yaml:"name"`
+	Time       string `yaml:"time"`        // 每天开赛时间 HH:MM（服务器时区）
+	Hands      int    `yaml:"hands"`       // 每轮每桌局数，至少 2 局
+	MinPlayers int    `yaml:"min_players"` // 开赛所需的最少报名人数，至少 2 人
+	Duplicate  bool   `yaml:"duplicate"`   // 复式赛：每轮各桌打同一组牌（座位轮换），按与其他桌同一手牌的成绩比较晋级
 }
 
 // BotConfig 机器人配置
@@ -51,6 +71,9 @@ type BotConfig struct {
 	// DouZero 引擎配置；未启用时使用内置规则启发式机器人
 	DouZeroEnabled bool   `yaml:"douzero_enabled"` // 使用 DouZero 神经网络引擎
 	DouZeroURL     string `yaml:"douzero_url"`     // Python 服务地址
+
+	// 托管、出牌超时与离线超时代打使用的引擎：heuristic 或 douzero，空表示与机器人一致
+	AutoPlayEngine string `yaml:"autoplay_engine"`
 }
 
 // ServerConfig WebSocket 服务器配置
@@ -73,12 +96,33 @@ type RedisConfig struct {
 // GameConfig 游戏配置
 type GameConfig struct {
 	TurnTimeout           int `yaml:"turn_timeout"`            // 出牌超时（秒）
+	TimeBank              int `yaml:"time_bank"`               // 每名玩家的出牌备用时间（秒），出牌超时后继续消耗，0 表示不启用
+	TimeBankIncrement     int `yaml:"time_bank_increment"`     // 每出一手牌（含不出）补充的备用时间（秒），补充后不超过 time_bank
 	BidTimeout            int `yaml:"bid_timeout"`             // 叫地主超时（秒）
+	ScoreBidTimeout       int `yaml:"score_bid_timeout"`       // 叫分超时（秒），叫分要比叫抢多一些考虑时间
+	DoubleTimeout         int `yaml:"double_timeout"`          // 加倍阶段超时（秒）
 	RoomTimeout           int `yaml:"room_timeout"`            // 房间等待超时（分钟）
 	ShutdownTimeout       int `yaml:"shutdown_timeout"`        // 优雅关闭超时（分钟）
 	ShutdownCheckInterval int `yaml:"shutdown_check_interval"` // 优雅关闭检测间隔（秒）
 	RoomCleanupDelay      int `yaml:"room_cleanup_delay"`      // 游戏结束后服务器关闭延迟（秒）
 	OfflineWaitTimeout    int `yaml:"offline_wait_timeout"`    // 玩家离线等待超时（秒）
+	RematchTimeout        int `yaml:"rematch_timeout"`         // 对局结束后等待玩家同意再来一局的超时（秒）
+	ClientSeedTimeout     int `yaml:"client_seed_timeout"`     // 匹配成功后等待玩家上报客户端种子的最长时间（秒）
+
+	BottomBonus BottomBonusConfig `yaml:"bottom_bonus"` // 底牌翻倍
+
+	ReplayDir string `yaml:"replay_dir"` // 回放文件保存目录，空表示不保存
+
+	SpectatorHandDelay int `yaml:"spectator_hand_delay"` // 观战者延迟看到各家手牌的秒数，0 表示不公开
+}
+
+// BottomBonusConfig 底牌翻倍配置：三张底牌满足对应牌型时的倍数，0 或 1 表示不翻倍。
+// 同时满足多种牌型时取其中最高的一个。
+type BottomBonusConfig struct {
+	Joker    int `yaml:"joker"`    // 含王
+	Pair     int `yaml:"pair"`     // 对子
+	Straight int `yaml:"straight"` // 顺子
+	Flush    int `yaml:"flush"`    // 同花
 }
 
 // SecurityConfig 安全配置
@@ -113,10 +157,26 @@ func (c *GameConfig) TurnTimeoutDuration() time.Duration {
 	return time.Duration(c.TurnTimeout) * time.Second
 }
 
+func (c *GameConfig) TimeBankDuration() time.Duration {
+	return time.Duration(c.TimeBank) * time.Second
+}
+
+func (c *GameConfig) TimeBankIncrementDuration() time.Duration {
+	return time.Duration(c.TimeBankIncrement) * time.Second
+}
+
 func (c *GameConfig) BidTimeoutDuration() time.Duration {
 	return time.Duration(c.BidTimeout) * time.Second
 }
 
+func (c *GameConfig) ScoreBidTimeoutDuration() time.Duration {
+	return time.Duration(c.ScoreBidTimeout) * time.Second
+}
+
+func (c *GameConfig) DoubleTimeoutDuration() time.Duration {
+	return time.Duration(c.DoubleTimeout) * time.Second
+}
+
 func (c *GameConfig) RoomTimeoutDuration() time.Duration {
 	return time.Duration(c.RoomTimeout) * time.Minute
 }
@@ -137,6 +197,18 @@ func (c *GameConfig) OfflineWaitTimeoutDuration() time.Duration {
 	return time.Duration(c.OfflineWaitTimeout) * time.Second
 }
 
+func (c *GameConfig) RematchTimeoutDuration() time.Duration {
+	return time.Duration(c.RematchTimeout) * time.Second
+}
+
+func (c *GameConfig) ClientSeedTimeoutDuration() time.Duration {
+	return time.Duration(c.ClientSeedTimeout) * time.Second
+}
+
+func (c *GameConfig) SpectatorHandDelayDuration() time.Duration {
+	return time.Duration(c.SpectatorHandDelay) * time.Second
+}
+
 func (c *RateLimitConfig) BanDurationTime() time.Duration {
 	return time.Duration(c.BanDuration) * time.Second
 }
@@ -206,11 +278,23 @@ func loadFromEnv(cfg *Config) {
 
 	// Game
 	getEnvInt("GAME_TURN_TIMEOUT", &cfg.Game.TurnTimeout)
+	getEnvInt("GAME_TIME_BANK", &cfg.Game.TimeBank)
+	getEnvInt("GAME_TIME_BANK_INCREMENT", &cfg.Game.TimeBankIncrement)
 	getEnvInt("GAME_BID_TIMEOUT", &cfg.Game.BidTimeout)
+	getEnvInt("GAME_SCORE_BID_TIMEOUT", &cfg.Game.ScoreBidTimeout)
+	getEnvInt("GAME_DOUBLE_TIMEOUT", &cfg.Game.DoubleTimeout)
 	getEnvInt("GAME_ROOM_TIMEOUT", &cfg.Game.RoomTimeout)
 	getEnvInt("GAME_SHUTDOWN_TIMEOUT", &cfg.Game.ShutdownTimeout)
 	getEnvInt("GAME_SHUTDOWN_CHECK_INTERVAL", &cfg.Game.ShutdownCheckInterval)
 	getEnvInt("GAME_ROOM_CLEANUP_DELAY", &cfg.Game.RoomCleanupDelay)
+	getEnvInt("GAME_BOTTOM_BONUS_JOKER", &cfg.Game.BottomBonus.Joker)
+	getEnvInt("GAME_BOTTOM_BONUS_PAIR", &cfg.Game.BottomBonus.Pair)
+	getEnvInt("GAME_BOTTOM_BONUS_STRAIGHT", &cfg.Game.BottomBonus.Straight)
+	getEnvInt("GAME_BOTTOM_BONUS_FLUSH", &cfg.Game.BottomBonus.Flush)
+	getEnvStr("GAME_REPLAY_DIR", &cfg.Game.ReplayDir)
+	getEnvInt("GAME_SPECTATOR_HAND_DELAY", &cfg.Game.SpectatorHandDelay)
+	getEnvInt("GAME_REMATCH_TIMEOUT", &cfg.Game.RematchTimeout)
+	getEnvInt("GAME_CLIENT_SEED_TIMEOUT", &cfg.Game.ClientSeedTimeout)
 
 	// BOT
 	if v := os.Getenv("BOT_ENABLED"); v == "true" || v == "1" {
@@ -221,6 +305,7 @@ func loadFromEnv(cfg *Config) {
 		cfg.BOT.DouZeroEnabled = true
 	}
 	getEnvStr("DOUZERO_URL", &cfg.BOT.DouZeroURL)
+	getEnvStr("BOT_AUTOPLAY_ENGINE", &cfg.BOT.AutoPlayEngine)
 
 	// Security
 	getEnvStrSlice("SECURITY_ALLOWED_ORIGINS", &cfg.Security.AllowedOrigins)
@@ -261,11 +346,15 @@ func setDefaults(cfg *Config) {
 	// Game
 	setDefaultInt(&cfg.Game.TurnTimeout, defaultTurnTimeout)
 	setDefaultInt(&cfg.Game.BidTimeout, defaultBidTimeout)
+	setDefaultInt(&cfg.Game.ScoreBidTimeout, defaultScoreBidTimeout)
+	setDefaultInt(&cfg.Game.DoubleTimeout, defaultDoubleTimeout)
 	setDefaultInt(&cfg.Game.RoomTimeout, defaultRoomTimeout)
 	setDefaultInt(&cfg.Game.ShutdownTimeout, defaultShutdownTimeout)
 	setDefaultInt(&cfg.Game.ShutdownCheckInterval, defaultShutdownCheckInterval)
 	setDefaultInt(&cfg.Game.RoomCleanupDelay, defaultRoomCleanupDelay)
 	setDefaultInt(&cfg.Game.OfflineWaitTimeout, defaultOfflineWaitTimeout)
+	setDefaultInt(&cfg.Game.RematchTimeout, defaultRematchTimeout)
+	setDefaultInt(&cfg.Game.ClientSeedTimeout, defaultClientSeedTimeout)
 
 	// Security
 	setDefaultStrSlice(&cfg.Security.AllowedOrigins, []string{"*"})
@@ -280,6 +369,14 @@ func setDefaults(cfg *Config) {
 	// Bot
 	setDefaultInt(&cfg.BOT.BotFillTimeout, 30)
 	setDefaultStr(&cfg.BOT.DouZeroURL, "http://localhost:2021")
+
+	// Tournament
+	for i := range cfg.Tournament.Events {
+		ev := &cfg.Tournament.Events[i]
+		setDefaultInt(&ev.Hands, defaultTournamentHands)
+		ev.Hands = max(ev.Hands, 2)
+		ev.MinPlayers = max(ev.MinPlayers, 2)
+	}
 }
 
 // Default 返回默认配置
@@ -111,6 +111,8 @@ func TestLoad_AppliesDefaults(t *testing.T) {
 	assert.Equal(t, defaultRedisAddr, cfg.Redis.Addr)
 	assert.Equal(t, defaultTurnTimeout, cfg.Game.TurnTimeout)
 	assert.Equal(t, defaultBidTimeout, cfg.Game.BidTimeout)
+	assert.Equal(t, defaultScoreBidTimeout, cfg.Game.ScoreBidTimeout)
+	assert.Equal(t, defaultDoubleTimeout, cfg.Game.DoubleTimeout)
 	assert.Equal(t, []string{"*"}, cfg.Security.AllowedOrigins)
 }
 
@@ -131,7 +133,11 @@ func TestGameConfig_DurationMethods(t *testing.T) {
 
 	cfg := &GameConfig{
 		TurnTimeout:           30,
+		TimeBank:              90,
+		TimeBankIncrement:     5,
 		BidTimeout:            15,
+		ScoreBidTimeout:       20,
+		DoubleTimeout:         10,
 		RoomTimeout:           10,
 		ShutdownTimeout:       60,
 		ShutdownCheckInterval: 5,
@@ -139,7 +145,11 @@ func TestGameConfig_DurationMethods(t *testing.T) {
 	}
 
 	assert.Equal(t, 30*time.Second, cfg.TurnTimeoutDuration())
+	assert.Equal(t, 90*time.Second, cfg.TimeBankDuration())
+	assert.Equal(t, 5*time.Second, cfg.TimeBankIncrementDuration())
 	assert.Equal(t, 15*time.Second, cfg.BidTimeoutDuration())
+	assert.Equal(t, 20*time.Second, cfg.ScoreBidTimeoutDuration())
+	assert.Equal(t, 10*time.Second, cfg.DoubleTimeoutDuration())
 	assert.Equal(t, 10*time.Minute, cfg.RoomTimeoutDuration())
 	assert.Equal(t, 60*time.Minute, cfg.ShutdownTimeoutDuration())
 	assert.Equal(t, 5*time.Second, cfg.ShutdownCheckIntervalDuration())
@@ -188,3 +198,28 @@ func TestLoadFromEnv(t *testing.T) {
 	assert.Equal(t, 120, cfg.Game.TurnTimeout)
 	assert.Equal(t, []string{"http://a.com", "http://b.com"}, cfg.Security.AllowedOrigins)
 }
+
+func TestLoad_TournamentDefaults(t *testing.T) {
+	t.Parallel()
+
+	content := `
+tournament:
+  events:
+    - name: "每日淘汰赛"
+      time: "20:00"
+    - name: "快速赛"
+      time: "12:30"
+      hands: 1
+      min_players: 6
+`
+	configPath := filepath.Join(t.TempDir(), "tournament.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0o600))
+
+	cfg, err := Load(configPath)
+	require.NoError(t, err)
+	require.Len(t, cfg.Tournament.Events, 2)
+	assert.Equal(t, defaultTournamentHands, cfg.Tournament.Events[0].Hands)
+	assert.Equal(t, 2, cfg.Tournament.Events[0].MinPlayers)
+	assert.Equal(t, 2, cfg.Tournament.Events[1].Hands, "每轮至少 2 局")
+	assert.Equal(t, 6, cfg.Tournament.Events[1].MinPlayers)
+}
@@ -3,6 +3,7 @@ package card
 import (
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"strconv"
 )
 
@@ -142,8 +143,46 @@ func NewDeck() Deck {
 	return deck
 }
 
+// NewDecks 将 n 副牌合成一副（两副牌玩法共 108 张）
+func NewDecks(n int) Deck {
+	deck := make(Deck, 0, 54*n)
+	for range n {
+		deck = append(deck, NewDeck()...)
+	}
+	return deck
+}
+
+// WithoutRanks 返回去掉指定点数后的牌（二人玩法去掉 3 和 4）
+func (d Deck) WithoutRanks(ranks ...Rank) Deck {
+	result := make(Deck, 0, len(d))
+	for _, c := range d {
+		if !slices.Contains(ranks, c.Rank) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
 func (d Deck) Shuffle() {
 	rand.Shuffle(len(d), func(i, j int) {
 		d[i], d[j] = d[j], d[i]
 	})
 }
+
+// ShuffleSeeded 用给定种子确定性地洗牌，相同种子与初始牌序总得到相同结果（用于可验证发牌）
+func (d Deck) ShuffleSeeded(seed [32]byte) {
+	rng := rand.New(rand.NewChaCha8(seed))
+	rng.Shuffle(len(d), func(i, j int) {
+		d[i], d[j] = d[j], d[i]
+	})
+}
+
+// RandomWildRank 随机选出癞子点数（3 到 2，大小王不能作癞子）
+func RandomWildRank() Rank {
+	return Rank3 + Rank(rand.IntN(int(Rank2-Rank3)+1))
+}
+
+// IsWild 判断该牌是否为癞子，wild 为 0 表示不使用癞子
+func (c Card) IsWild(wild Rank) bool {
+	return wild != 0 && c.Rank == wild
+}
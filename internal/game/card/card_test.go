@@ -107,6 +107,53 @@ func TestNewDeck(t *testing.T) {
 	assert.Equal(27, colorCounts[Black], "Should have 27 Black cards (26 + Black Joker)")
 }
 
+func TestNewDecks(t *testing.T) {
+	t.Parallel()
+
+	deck := NewDecks(2)
+	assert.Len(t, deck, 108)
+
+	rankCounts := make(map[Rank]int)
+	for _, c := range deck {
+		rankCounts[c.Rank]++
+	}
+	for r := Rank3; r <= Rank2; r++ {
+		assert.Equal(t, 8, rankCounts[r])
+	}
+	assert.Equal(t, 2, rankCounts[RankBlackJoker])
+	assert.Equal(t, 2, rankCounts[RankRedJoker])
+}
+
+func TestDeck_WithoutRanks(t *testing.T) {
+	t.Parallel()
+
+	deck := NewDeck().WithoutRanks(Rank3, Rank4)
+	assert.Len(t, deck, 46)
+	for _, c := range deck {
+		assert.NotContains(t, []Rank{Rank3, Rank4}, c.Rank)
+	}
+
+	assert.Len(t, NewDeck().WithoutRanks(), 54)
+}
+
+func TestDeck_ShuffleSeeded(t *testing.T) {
+	t.Parallel()
+
+	var seed [32]byte
+	seed[0] = 42
+
+	a, b := NewDeck(), NewDeck()
+	a.ShuffleSeeded(seed)
+	b.ShuffleSeeded(seed)
+	assert.Equal(t, a, b, "same seed should give the same order")
+	assert.NotEqual(t, NewDeck(), a)
+
+	seed[0] = 43
+	c := NewDeck()
+	c.ShuffleSeeded(seed)
+	assert.NotEqual(t, a, c, "different seeds should give different orders")
+}
+
 // TestDeck_Shuffle 验证洗牌功能
 func TestDeck_Shuffle(t *testing.T) {
 	t.Parallel()
@@ -355,6 +402,12 @@ func TestRemoveCards(t *testing.T) {
 			cardsToRemove: []Card{threeOfSpades},
 			expectedHand:  []Card{threeOfHearts, fourOfClubs},
 		},
+		{
+			name:          "remove one of two identical cards from two decks",
+			initialHand:   []Card{threeOfSpades, threeOfSpades, fourOfClubs},
+			cardsToRemove: []Card{threeOfSpades},
+			expectedHand:  []Card{threeOfSpades, fourOfClubs},
+		},
 		{
 			name:          "attempt to remove a card not in hand",
 			initialHand:   []Card{threeOfSpades, fourOfClubs},
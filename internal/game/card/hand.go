@@ -68,13 +68,19 @@ func FindCardsInHand(hand []Card, input string) ([]Card, error) {
 	return extractCards(handCopy, inputRanks), nil
 }
 
-// RemoveCards 从手牌中移除指定的牌
+// RemoveCards 从手牌中移除指定的牌；两副牌中完全相同的牌按张数逐一移除
 func RemoveCards(hand, toRemove []Card) []Card {
+	pending := make(map[Card]int, len(toRemove))
+	for _, c := range toRemove {
+		pending[c]++
+	}
 	var result []Card
 	for _, hCard := range hand {
-		if !slices.Contains(toRemove, hCard) {
-			result = append(result, hCard)
+		if pending[hCard] > 0 {
+			pending[hCard]--
+			continue
 		}
+		result = append(result, hCard)
 	}
 	return result
 }
@@ -0,0 +1,169 @@
+// Package duplicate 实现复式赛：同一副牌由每局共用的服务端种子推导，在多桌按座位轮换后重放，
+// 结算时把每名玩家的成绩与其他桌拿同一手牌的玩家比较，抵消牌运的影响。
+package duplicate
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+)
+
+// BoardKey 一副牌的编号：第几局，以及该局的第几次发牌（流局重发时递增）
+type BoardKey struct {
+	Hand   int
+	Redeal int
+}
+
+// result 一名玩家在一副牌上的成绩
+type result struct {
+	board    BoardKey
+	table    string
+	holding  int // 拿的是原始牌序中哪个座位的手牌
+	playerID string
+	score    int
+}
+
+// Board 某桌的一次复式发牌。各桌的原始牌序、首叫与强制地主都按原始座位确定，
+// 再按本桌的座位轮换换算，拿同一手牌的玩家在各桌面对的局面相同
+type Board struct {
+	Base           card.Deck // 原始牌序，由本局的服务端种子推导，结算时作为洗牌证明揭示
+	Deck           card.Deck // 按本桌座位轮换后实际发出的牌序
+	Shift          int       // 本桌座位 s 拿到原始牌序中座位 (s+Shift)%人数 的手牌
+	FirstBidder    int       // 本桌第一个叫地主的座位
+	ForcedLandlord int       // 连续流局后强制指定的地主座位
+}
+
+// tableState 一桌的座位轮换与正在打的牌
+type tableState struct {
+	shift   int
+	current BoardKey
+	dealt   bool
+}
+
+// Set 一组复式桌共用的牌：每局的服务端种子在第一次被请求时生成，各桌开局时公布其承诺，
+// 该局（含流局重发）的牌序都由它推导，各桌再按自己的座位轮换重放
+type Set struct {
+	layout  room.Layout
+	seeds   map[int][]byte // 局数 → 各桌共用的服务端种子
+	tables  map[string]*tableState
+	results []result
+	mu      sync.Mutex
+}
+
+// NewSet 创建按 layout 发牌的复式牌组
+func NewSet(layout room.Layout) *Set {
+	return &Set{
+		layout: layout,
+		seeds:  make(map[int][]byte),
+		tables: make(map[string]*tableState),
+	}
+}
+
+// AddTable 登记一桌，shift 为该桌手牌相对原始发牌向后轮换的座位数
+func (s *Set) AddTable(table string, shift int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables[table] = &tableState{shift: shift % s.layout.Players}
+}
+
+// Seed 返回第 hand 局各桌共用的服务端种子，首次请求时生成。
+// 各局的种子相互独立，某桌结算揭示本局种子不会泄露之后的牌
+func (s *Set) Seed(hand int) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seedLocked(hand)
+}
+
+// seedLocked 返回第 hand 局的服务端种子（调用方需持有 s.mu）
+func (s *Set) seedLocked(hand int) []byte {
+	seed, ok := s.seeds[hand]
+	if !ok {
+		seed = fairness.NewServerSeed()
+		s.seeds[hand] = seed
+	}
+	return seed
+}
+
+// ClientSeeds 复式发牌使用的客户端种子：各桌要打同一副牌，不采用玩家的种子，按座位填空串
+func (s *Set) ClientSeeds() []string {
+	return make([]string, s.layout.Players)
+}
+
+// Deal 返回某桌第 hand 局第 redeal 次发牌；未登记的桌返回 nil。
+// 牌序以 redeal 作为发牌轮次推导，与游戏会话中流局重发时递增的轮次一致
+func (s *Set) Deal(table string, hand, redeal int) *Board {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts := s.tables[table]
+	if ts == nil {
+		return nil
+	}
+	ts.current = BoardKey{Hand: hand, Redeal: redeal}
+	ts.dealt = true
+
+	players := s.layout.Players
+	seed, clientSeeds := s.seedLocked(hand), s.ClientSeeds()
+	base := fairness.Shuffle(s.layout.NewDeck(), seed, clientSeeds, redeal)
+	// 原始座位换算为本桌座位：本桌座位 s 拿原始座位 (s+shift)%players 的手牌
+	seat := func(holding int) int { return (holding - ts.shift + players) % players }
+	return &Board{
+		Base:           base,
+		Deck:           Rotate(base, players, s.layout.HandSize, ts.shift),
+		Shift:          ts.shift,
+		FirstBidder:    seat(max(hand-1, 0) % players),
+		ForcedLandlord: seat(fairness.Pick(seed, clientSeeds, redeal, "landlord", players)),
+	}
+}
+
+// Record 记录某桌刚打完的一副牌的成绩，seats 为按座位排列的玩家 ID，scores 为玩家得分
+func (s *Set) Record(table string, seats []string, scores map[string]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts := s.tables[table]
+	if ts == nil || !ts.dealt {
+		return
+	}
+	for seat, id := range seats {
+		s.results = append(s.results, result{
+			board:    ts.current,
+			table:    table,
+			holding:  (seat + ts.shift) % s.layout.Players,
+			playerID: id,
+			score:    scores[id],
+		})
+	}
+	ts.dealt = false
+}
+
+// Scores 复式得分：每副牌上，玩家的得分与其他桌拿同一手牌的每名玩家逐一比较，差值累加
+func (s *Set) Scores() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scores := make(map[string]int)
+	for _, a := range s.results {
+		for _, b := range s.results {
+			if a.board == b.board && a.holding == b.holding && a.table != b.table {
+				scores[a.playerID] += a.score - b.score
+			}
+		}
+	}
+	return scores
+}
+
+// Rotate 返回轮流发牌时各座位手牌向后轮换 shift 个座位的牌序：
+// 座位 s 拿到原牌序中座位 (s+shift)%players 的手牌，底牌与暗牌不变
+func Rotate(deck card.Deck, players, handSize, shift int) card.Deck {
+	out := slices.Clone(deck)
+	for k := range handSize {
+		for seat := range players {
+			out[k*players+seat] = deck[k*players+(seat+shift)%players]
+		}
+	}
+	return out
+}
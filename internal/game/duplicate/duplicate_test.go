@@ -0,0 +1,87 @@
+package duplicate
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+)
+
+func TestRotate(t *testing.T) {
+	t.Parallel()
+
+	layout := room.RoomOptions{}.Layout()
+	deck := layout.NewDeck()
+	deck.Shuffle()
+
+	rotated := Rotate(deck, layout.Players, layout.HandSize, 1)
+	for seat := range layout.Players {
+		assert.Equal(t,
+			fairness.HandOf(deck, layout.Players, layout.HandSize, (seat+1)%layout.Players),
+			fairness.HandOf(rotated, layout.Players, layout.HandSize, seat))
+	}
+	assert.Equal(t, deck[len(deck)-layout.BottomSize:], rotated[len(rotated)-layout.BottomSize:], "底牌不变")
+	assert.Equal(t, deck, Rotate(deck, layout.Players, layout.HandSize, 0))
+}
+
+func TestSet_Deal(t *testing.T) {
+	t.Parallel()
+
+	layout := room.RoomOptions{}.Layout()
+	s := NewSet(layout)
+	s.AddTable("A", 0)
+	s.AddTable("B", 1)
+
+	a := s.Deal("A", 1, 0)
+	b := s.Deal("B", 1, 0)
+	require.Len(t, a.Deck, 54)
+	assert.Equal(t, a.Base, b.Base)
+	assert.Equal(t, a.Base, a.Deck, "桌 A 不轮换")
+	assert.Equal(t, Rotate(a.Deck, layout.Players, layout.HandSize, 1), b.Deck, "同一副牌按桌轮换座位")
+	assert.Equal(t, a, s.Deal("A", 1, 0), "重复请求得到同一副牌")
+	assert.NotEqual(t, a.Deck, s.Deal("A", 1, 1).Deck, "流局重发是另一副牌")
+	assert.NotEqual(t, a.Deck, s.Deal("A", 2, 0).Deck, "下一局是另一副牌")
+	assert.Nil(t, s.Deal("C", 1, 0), "未登记的桌")
+
+	// 原始牌序可由本局种子验证
+	require.NoError(t, fairness.Verify(fairness.Commit(s.Seed(1)), hex.EncodeToString(s.Seed(1)), s.ClientSeeds(), 0, layout.NewDeck(), a.Base))
+
+	// 首叫与强制地主落在拿同一手牌的玩家
+	holding := func(seat, shift int) int { return (seat + shift) % layout.Players }
+	assert.Equal(t, holding(a.FirstBidder, a.Shift), holding(b.FirstBidder, b.Shift))
+	assert.Equal(t, holding(a.ForcedLandlord, a.Shift), holding(b.ForcedLandlord, b.Shift))
+	assert.Equal(t, 0, holding(a.FirstBidder, a.Shift), "第 1 局由原始座位 0 先叫")
+	assert.Equal(t, 1, holding(s.Deal("B", 2, 0).FirstBidder, 1), "逐局轮换首叫")
+}
+
+func TestSet_Scores(t *testing.T) {
+	t.Parallel()
+
+	s := NewSet(room.RoomOptions{}.Layout())
+	s.AddTable("A", 0)
+	s.AddTable("B", 1)
+
+	// 桌 A 座位 0 拿原始第 0 手牌；桌 B 轮换 1 个座位，座位 2 拿原始第 0 手牌
+	s.Deal("A", 1, 0)
+	s.Deal("B", 1, 0)
+	s.Record("A", []string{"a0", "a1", "a2"}, map[string]int{"a0": 4, "a1": -2, "a2": -2})
+	s.Record("B", []string{"b0", "b1", "b2"}, map[string]int{"b0": 1, "b1": 1, "b2": -2})
+
+	scores := s.Scores()
+	assert.Equal(t, 6, scores["a0"], "同一手牌 a0 赢 4 分，b2 输 2 分")
+	assert.Equal(t, -6, scores["b2"])
+	assert.Equal(t, -3, scores["a1"], "原始第 1 手牌：a1 -2 对 b0 +1")
+	assert.Equal(t, 3, scores["b0"])
+	assert.Equal(t, -3, scores["a2"])
+	assert.Equal(t, 3, scores["b1"])
+
+	// 只有一桌打过的牌无从比较；未发牌就记录的成绩忽略
+	s.Deal("A", 2, 0)
+	s.Record("A", []string{"a0", "a1", "a2"}, map[string]int{"a0": 10})
+	s.Record("B", []string{"b0", "b1", "b2"}, map[string]int{"b0": 10})
+	assert.Equal(t, scores, s.Scores())
+}
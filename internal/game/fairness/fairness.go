@@ -0,0 +1,115 @@
+// Package fairness 实现可验证的承诺-揭示（commit-reveal）洗牌：
+// 开局前服务端公布自身种子的哈希，洗牌种子由服务端种子与各玩家的客户端种子共同推导，
+// 结算时揭示服务端种子与完整牌序，任何人都可以据此重算并核对发牌。
+package fairness
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// SeedSize 服务端种子字节数
+const SeedSize = 32
+
+// MaxClientSeedLen 客户端种子的最大字节数
+const MaxClientSeedLen = 128
+
+// ValidClientSeed 客户端种子是否可用：非空、不超过 MaxClientSeedLen 字节，且只含可见的 ASCII 字符。
+// 种子会写进发牌证明与回放文件，不接受控制字符、空白或超长内容
+func ValidClientSeed(seed string) bool {
+	if seed == "" || len(seed) > MaxClientSeedLen {
+		return false
+	}
+	for i := 0; i < len(seed); i++ {
+		if seed[i] <= ' ' || seed[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// NewServerSeed 生成一局的服务端随机种子
+func NewServerSeed() []byte {
+	seed := make([]byte, SeedSize)
+	_, _ = rand.Read(seed)
+	return seed
+}
+
+// Commit 返回服务端种子的承诺值（SHA-256 的十六进制）
+func Commit(serverSeed []byte) string {
+	sum := sha256.Sum256(serverSeed)
+	return hex.EncodeToString(sum[:])
+}
+
+// DeriveSeed 由服务端种子、按座位排列的客户端种子和发牌轮次（流局重发时递增）推导洗牌种子。
+// 每个客户端种子前写入长度，避免不同切分得到相同的拼接结果。
+func DeriveSeed(serverSeed []byte, clientSeeds []string, round int) [32]byte {
+	h := sha256.New()
+	h.Write(serverSeed)
+	var buf [8]byte
+	for _, s := range clientSeeds {
+		binary.BigEndian.PutUint64(buf[:], uint64(len(s)))
+		h.Write(buf[:])
+		h.Write([]byte(s))
+	}
+	binary.BigEndian.PutUint64(buf[:], uint64(round))
+	h.Write(buf[:])
+
+	var seed [32]byte
+	copy(seed[:], h.Sum(nil))
+	return seed
+}
+
+// Shuffle 复制 base 并按推导出的种子洗牌，base 须是未洗的初始牌序
+func Shuffle(base card.Deck, serverSeed []byte, clientSeeds []string, round int) card.Deck {
+	deck := slices.Clone(base)
+	deck.ShuffleSeeded(DeriveSeed(serverSeed, clientSeeds, round))
+	return deck
+}
+
+// Pick 由同一组种子为 purpose 指定的用途推导 [0, n) 中的一个数，结算时同样可以核对。
+// 不同用途互不相关，也与牌序无关
+func Pick(serverSeed []byte, clientSeeds []string, round int, purpose string, n int) int {
+	seed := DeriveSeed(serverSeed, clientSeeds, round)
+	sum := sha256.Sum256(append(seed[:], purpose...))
+	return int(binary.BigEndian.Uint64(sum[:8]) % uint64(n))
+}
+
+// WildRank 由同一组种子推导癞子点数，从 candidates（本玩法可作癞子的点数）中选取
+func WildRank(serverSeed []byte, clientSeeds []string, round int, candidates []card.Rank) card.Rank {
+	if len(candidates) == 0 {
+		return 0
+	}
+	return candidates[Pick(serverSeed, clientSeeds, round, "wild", len(candidates))]
+}
+
+// HandOf 返回按轮流发牌时座位 seat 拿到的牌（每人 handSize 张，共 players 人）
+func HandOf(deck []card.Card, players, handSize, seat int) []card.Card {
+	hand := make([]card.Card, 0, handSize)
+	for i := seat; i < players*handSize && i < len(deck); i += players {
+		hand = append(hand, deck[i])
+	}
+	return hand
+}
+
+// Verify 核对揭示的服务端种子与开局承诺一致，并用它重算牌序与 deck 比对
+func Verify(commit string, serverSeedHex string, clientSeeds []string, round int, base, deck card.Deck) error {
+	serverSeed, err := hex.DecodeString(serverSeedHex)
+	if err != nil {
+		return fmt.Errorf("服务端种子格式错误: %w", err)
+	}
+	if Commit(serverSeed) != commit {
+		return errors.New("服务端种子与开局承诺不符")
+	}
+	if !slices.Equal(Shuffle(base, serverSeed, clientSeeds, round), deck) {
+		return errors.New("揭示的牌序与种子重算结果不符")
+	}
+	return nil
+}
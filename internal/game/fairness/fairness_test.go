@@ -0,0 +1,101 @@
+package fairness
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+func TestDeriveSeed(t *testing.T) {
+	t.Parallel()
+
+	server := []byte("server-seed")
+	base := DeriveSeed(server, []string{"a", "b", "c"}, 0)
+
+	assert.Equal(t, base, DeriveSeed(server, []string{"a", "b", "c"}, 0), "推导应确定")
+	assert.NotEqual(t, base, DeriveSeed(server, []string{"a", "b", "d"}, 0), "任一客户端种子都影响结果")
+	assert.NotEqual(t, base, DeriveSeed(server, []string{"ab", "", "c"}, 0), "拼接歧义不应得到相同种子")
+	assert.NotEqual(t, base, DeriveSeed(server, []string{"a", "b", "c"}, 1), "重发牌轮次不同结果不同")
+	assert.NotEqual(t, base, DeriveSeed([]byte("other"), []string{"a", "b", "c"}, 0))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	serverSeed := NewServerSeed()
+	require.Len(t, serverSeed, SeedSize)
+	commit := Commit(serverSeed)
+	clientSeeds := []string{"alice", "bob", ""}
+	deck := Shuffle(card.NewDeck(), serverSeed, clientSeeds, 2)
+	seedHex := hex.EncodeToString(serverSeed)
+
+	require.NoError(t, Verify(commit, seedHex, clientSeeds, 2, card.NewDeck(), deck))
+
+	t.Run("wrong commit", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, Verify(Commit([]byte("x")), seedHex, clientSeeds, 2, card.NewDeck(), deck))
+	})
+
+	t.Run("tampered deck", func(t *testing.T) {
+		t.Parallel()
+		tampered := append(card.Deck(nil), deck...)
+		tampered[0], tampered[1] = tampered[1], tampered[0]
+		assert.Error(t, Verify(commit, seedHex, clientSeeds, 2, card.NewDeck(), tampered))
+	})
+
+	t.Run("different client seed", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, Verify(commit, seedHex, []string{"alice", "bob", "eve"}, 2, card.NewDeck(), deck))
+	})
+
+	t.Run("malformed seed", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, Verify(commit, "not-hex", clientSeeds, 2, card.NewDeck(), deck))
+	})
+}
+
+func TestWildRank(t *testing.T) {
+	t.Parallel()
+
+	server := []byte("server-seed")
+	candidates := []card.Rank{card.Rank5, card.Rank6, card.Rank7, card.Rank8}
+	wild := WildRank(server, []string{"a", "b", "c"}, 0, candidates)
+	assert.Contains(t, candidates, wild)
+	assert.Equal(t, wild, WildRank(server, []string{"a", "b", "c"}, 0, candidates), "推导应确定")
+	assert.Zero(t, WildRank(server, nil, 0, nil))
+
+	// 不同种子应能选到所有候选点数
+	seen := make(map[card.Rank]bool)
+	for round := range 64 {
+		seen[WildRank(server, []string{"a", "b", "c"}, round, candidates)] = true
+	}
+	assert.Len(t, seen, len(candidates))
+}
+
+func TestValidClientSeed(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ValidClientSeed(hex.EncodeToString(make([]byte, 16))))
+	assert.True(t, ValidClientSeed(strings.Repeat("a", MaxClientSeedLen)))
+	assert.False(t, ValidClientSeed(""), "空种子")
+	assert.False(t, ValidClientSeed(strings.Repeat("a", MaxClientSeedLen+1)), "超长种子")
+	assert.False(t, ValidClientSeed("seed with space"), "含空白")
+	assert.False(t, ValidClientSeed("seed\n"), "含控制字符")
+	assert.False(t, ValidClientSeed("种子"), "非 ASCII")
+}
+
+func TestHandOf(t *testing.T) {
+	t.Parallel()
+
+	deck := card.NewDeck()
+	hand := HandOf(deck, 3, 17, 1)
+	require.Len(t, hand, 17)
+	assert.Equal(t, deck[1], hand[0])
+	assert.Equal(t, deck[4], hand[1])
+	assert.Equal(t, deck[49], hand[16])
+}
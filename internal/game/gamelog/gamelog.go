@@ -0,0 +1,100 @@
+// Package gamelog 对局事件日志：按顺序记录一局中的每次状态变化（发牌、叫地主、出牌、超时、离线等），
+// 供回放、审计、分析与崩溃恢复使用。日志只追加不修改，对局结束时整体交给 Sink 落地。
+package gamelog
+
+import (
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+// EventType 事件类型
+type EventType string
+
+const (
+	EventDeal     EventType = "deal"      // 发牌（含所有人手牌与底牌，流局重发时再次记录）
+	EventBid      EventType = "bid"       // 叫/抢地主或叫分
+	EventLandlord EventType = "landlord"  // 地主确定
+	EventDouble   EventType = "double"    // 加倍选择
+	EventShowHand EventType = "show_hand" // 明牌
+	EventPlay     EventType = "play"      // 出牌
+	EventPass     EventType = "pass"      // 不出
+	EventTimeout  EventType = "timeout"   // 操作超时（随后会记录系统代为执行的操作）
+	EventOffline  EventType = "offline"   // 玩家离线
+	EventOnline   EventType = "online"    // 玩家重连
+	EventGameOver EventType = "game_over" // 对局结束
+)
+
+// Event 一条对局事件。各字段按事件类型选择性填写，未用到的字段为零值。
+type Event struct {
+	Seq      int       `json:"seq"`  // 序号，从 1 开始连续递增
+	Time     time.Time `json:"time"` // 发生时间
+	Type     EventType `json:"type"`
+	PlayerID string    `json:"player_id,omitempty"` // 事件相关玩家（对局结束时为获胜者）
+
+	// 发牌
+	Round       int           `json:"round,omitempty"`        // 发牌轮次，首次为 1，流局重发时递增
+	Hands       [][]card.Card `json:"hands,omitempty"`        // 按座位排列的手牌
+	BottomCards []card.Card   `json:"bottom_cards,omitempty"` // 底牌（发牌与定地主时）
+	WildRank    card.Rank     `json:"wild_rank,omitempty"`    // 癞子点数
+
+	// 叫地主 / 加倍 / 明牌
+	Bid    bool `json:"bid,omitempty"`     // 是否叫/抢
+	Score  int  `json:"score,omitempty"`   // 叫分模式下的叫分
+	IsGrab bool `json:"is_grab,omitempty"` // 是否处于抢地主阶段
+	Level  int  `json:"level,omitempty"`   // 加倍选择或明牌倍数
+
+	// 出牌
+	Cards    []card.Card          `json:"cards,omitempty"`
+	HandType string               `json:"hand_type,omitempty"` // 牌型名称，仅用于展示
+	Reading  protocol.HandReading `json:"reading,omitzero"`    // 这手牌的读法，还原牌型时以它为准
+
+	// 超时
+	Phase string `json:"phase,omitempty"` // 超时发生的阶段：bidding/doubling/playing
+
+	// 对局结束
+	Multiplier int                           `json:"multiplier,omitempty"`
+	Scores     []protocol.PlayerScore        `json:"scores,omitempty"`
+	Breakdown  *protocol.MultiplierBreakdown `json:"breakdown,omitempty"`
+}
+
+// Player 日志中的玩家信息
+type Player struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Seat int    `json:"seat"`
+}
+
+// Log 一局的完整事件日志
+type Log struct {
+	RoomCode  string    `json:"room_code"`
+	Mode      string    `json:"mode,omitempty"`     // 人数玩法模式
+	RuleSet   string    `json:"rule_set,omitempty"` // 房规名称
+	BidMode   string    `json:"bid_mode,omitempty"` // 叫地主方式
+	Players   []Player  `json:"players"`            // 按座位排列
+	StartedAt time.Time `json:"started_at"`
+	Events    []Event   `json:"events"`
+}
+
+// Append 追加一条事件，自动编号；Time 为零值时取当前时间。调用方负责并发保护。
+func (l *Log) Append(e Event) {
+	e.Seq = len(l.Events) + 1
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	l.Events = append(l.Events, e)
+}
+
+// Sink 对局日志的落地方式（文件、数据库、分析管道等）
+type Sink interface {
+	SaveGameLog(l *Log) error
+}
+
+// SinkFunc 将普通函数适配为 Sink
+type SinkFunc func(l *Log) error
+
+// SaveGameLog 实现 Sink
+func (f SinkFunc) SaveGameLog(l *Log) error {
+	return f(l)
+}
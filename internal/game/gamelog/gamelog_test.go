@@ -0,0 +1,24 @@
+package gamelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_Append(t *testing.T) {
+	t.Parallel()
+
+	var l Log
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.Append(Event{Type: EventDeal, Time: fixed})
+	l.Append(Event{Type: EventBid, PlayerID: "p1", Bid: true, Seq: 99})
+
+	require.Len(t, l.Events, 2)
+	assert.Equal(t, 1, l.Events[0].Seq)
+	assert.Equal(t, fixed, l.Events[0].Time, "已有时间戳不应被覆盖")
+	assert.Equal(t, 2, l.Events[1].Seq, "序号由日志统一分配")
+	assert.False(t, l.Events[1].Time.IsZero())
+}
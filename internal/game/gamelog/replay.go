@@ -0,0 +1,66 @@
+package gamelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ReplayVersion 回放文件格式版本，格式不兼容变更时递增
+const ReplayVersion = 1
+
+// replayFile 回放文件：带版本号的完整事件日志（JSON），自包含发牌、底牌、叫地主与每一手出牌
+type replayFile struct {
+	Version int `json:"version"`
+	Log
+}
+
+// SaveReplay 将对局日志写成回放文件
+func SaveReplay(path string, l *Log) error {
+	data, err := json.MarshalIndent(replayFile{Version: ReplayVersion, Log: *l}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// LoadReplay 读取回放文件
+func LoadReplay(path string) (*Log, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var f replayFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("回放文件格式错误: %w", err)
+	}
+	if f.Version != ReplayVersion {
+		return nil, fmt.Errorf("不支持的回放文件版本: %d", f.Version)
+	}
+	return &f.Log, nil
+}
+
+// ReplayFileName 回放文件名：开局时间加房间号，按文件名排序即按时间排序
+func ReplayFileName(l *Log) string {
+	return fmt.Sprintf("%s-%s.json", l.StartedAt.Format("20060102-150405"), strings.ToLower(l.RoomCode))
+}
+
+// FileSink 把每局日志保存为 Dir 下的一个回放文件
+type FileSink struct {
+	Dir string
+}
+
+// NewFileSink 创建写入 dir 的回放文件 Sink
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{Dir: dir}
+}
+
+// SaveGameLog 实现 Sink
+func (s *FileSink) SaveGameLog(l *Log) error {
+	return SaveReplay(filepath.Join(s.Dir, ReplayFileName(l)), l)
+}
@@ -0,0 +1,80 @@
+package gamelog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+func newTestLog() *Log {
+	l := &Log{
+		RoomCode:  "AB12",
+		Players:   []Player{{ID: "p1", Name: "甲"}, {ID: "p2", Name: "乙", Seat: 1}},
+		StartedAt: time.Date(2024, 5, 1, 20, 30, 0, 0, time.UTC),
+	}
+	l.Append(Event{Type: EventDeal, Hands: [][]card.Card{
+		{{Suit: card.Spade, Rank: card.Rank3}},
+		{{Suit: card.Heart, Rank: card.RankA, Color: card.Red}},
+	}})
+	l.Append(Event{Type: EventPlay, PlayerID: "p1", Cards: []card.Card{{Suit: card.Spade, Rank: card.Rank3}}, HandType: "单牌"})
+	return l
+}
+
+func TestReplay_SaveLoad(t *testing.T) {
+	t.Parallel()
+
+	l := newTestLog()
+	path := filepath.Join(t.TempDir(), "sub", "game.json")
+	require.NoError(t, SaveReplay(path, l))
+
+	loaded, err := LoadReplay(path)
+	require.NoError(t, err)
+	assert.Equal(t, l.RoomCode, loaded.RoomCode)
+	assert.Equal(t, l.Players, loaded.Players)
+	require.Len(t, loaded.Events, 2)
+	assert.Equal(t, l.Events[0].Hands, loaded.Events[0].Hands)
+	assert.Equal(t, l.Events[1].Cards, loaded.Events[1].Cards)
+	assert.True(t, l.Events[1].Time.Equal(loaded.Events[1].Time))
+}
+
+func TestLoadReplay_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"invalid json", "{"},
+		{"unknown version", `{"version": 99, "room_code": "AB12"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(dir, tt.name+".json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			_, err := LoadReplay(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	l := newTestLog()
+	require.NoError(t, NewFileSink(dir).SaveGameLog(l))
+
+	path := filepath.Join(dir, "20240501-203000-ab12.json")
+	assert.Equal(t, filepath.Base(path), ReplayFileName(l))
+	loaded, err := LoadReplay(path)
+	require.NoError(t, err)
+	assert.Len(t, loaded.Events, 2)
+}
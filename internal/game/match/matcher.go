@@ -3,6 +3,7 @@ package match
 import (
 	"context"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,8 +29,8 @@ type Matcher struct {
 	botEngine       bot.DecisionEngine
 	botCfg          config.BotConfig
 	registerSession SessionRegistrationFunc
-	queue           []types.ClientInterface
-	botFillTimer    *time.Timer
+	queues          map[string][]types.ClientInterface // 按人数模式分开排队
+	botFillTimers   map[string]*time.Timer
 	mu              sync.Mutex
 }
 
@@ -54,33 +55,36 @@ func NewMatcher(deps MatcherDeps) *Matcher {
 		botEngine:       deps.BotEngine,
 		botCfg:          deps.BotConfig,
 		registerSession: deps.RegisterSession,
-		queue:           make([]types.ClientInterface, 0),
+		queues:          make(map[string][]types.ClientInterface),
+		botFillTimers:   make(map[string]*time.Timer),
 	}
 }
 
-// AddToQueue 加入匹配队列
-func (m *Matcher) AddToQueue(client types.ClientInterface) {
+// AddToQueue 加入指定人数模式的匹配队列，同一玩家只会排在一个队列中
+func (m *Matcher) AddToQueue(client types.ClientInterface, mode string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	// 检查是否已在队列中
-	for _, c := range m.queue {
-		if c.GetID() == client.GetID() {
-			return
+	for _, queue := range m.queues {
+		for _, c := range queue {
+			if c.GetID() == client.GetID() {
+				return
+			}
 		}
 	}
 
-	m.queue = append(m.queue, client)
-	log.Printf("🔍 玩家 %s 加入匹配队列，当前队列长度: %d", client.GetName(), len(m.queue))
+	m.queues[mode] = append(m.queues[mode], client)
+	log.Printf("🔍 玩家 %s 加入匹配队列（%s），当前队列长度: %d", client.GetName(), modeName(mode), len(m.queues[mode]))
 
 	switch {
-	case len(m.queue) >= 3:
-		m.cancelBotFillTimer()
-		m.tryMatch()
-	case m.botCfg.Enabled && m.botEngine != nil && m.botFillTimer == nil:
-		m.startBotFillTimer()
+	case len(m.queues[mode]) >= playersOf(mode):
+		m.cancelBotFillTimer(mode)
+		m.tryMatch(mode)
+	case m.botCfg.Enabled && m.botEngine != nil && m.botFillTimers[mode] == nil:
+		m.startBotFillTimer(mode)
 	default:
-		m.tryMatch()
+		m.tryMatch(mode)
 	}
 }
 
@@ -89,67 +93,84 @@ func (m *Matcher) RemoveFromQueue(client types.ClientInterface) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for i, c := range m.queue {
-		if c.GetID() == client.GetID() {
-			m.queue = append(m.queue[:i], m.queue[i+1:]...)
-			log.Printf("🔍 玩家 %s 离开匹配队列", client.GetName())
-			if len(m.queue) == 0 {
-				m.cancelBotFillTimer()
+	for mode, queue := range m.queues {
+		for i, c := range queue {
+			if c.GetID() == client.GetID() {
+				m.queues[mode] = append(queue[:i], queue[i+1:]...)
+				log.Printf("🔍 玩家 %s 离开匹配队列", client.GetName())
+				if len(m.queues[mode]) == 0 {
+					m.cancelBotFillTimer(mode)
+				}
+				return
 			}
-			return
 		}
 	}
 }
 
-func (m *Matcher) startBotFillTimer() {
+func (m *Matcher) startBotFillTimer(mode string) {
 	timeout := time.Duration(m.botCfg.BotFillTimeout) * time.Second
 	log.Printf("🤖 等待玩家加入（%ds 后由 Bot 填充剩余座位）", m.botCfg.BotFillTimeout)
-	m.botFillTimer = time.AfterFunc(timeout, func() {
+	m.botFillTimers[mode] = time.AfterFunc(timeout, func() {
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.botFillTimer = nil
-		if len(m.queue) == 0 {
+		delete(m.botFillTimers, mode)
+		if len(m.queues[mode]) == 0 {
 			return
 		}
-		for len(m.queue) < 3 {
+		for len(m.queues[mode]) < playersOf(mode) {
 			bot := bot.NewBotClient(m.botEngine)
-			m.queue = append(m.queue, bot)
+			m.queues[mode] = append(m.queues[mode], bot)
 			log.Printf("🤖 Bot %s 加入匹配队列", bot.GetName())
 		}
-		m.tryMatch()
+		m.tryMatch(mode)
 	})
 }
 
-func (m *Matcher) cancelBotFillTimer() {
-	if m.botFillTimer != nil {
-		m.botFillTimer.Stop()
-		m.botFillTimer = nil
+func (m *Matcher) cancelBotFillTimer(mode string) {
+	if timer := m.botFillTimers[mode]; timer != nil {
+		timer.Stop()
+		delete(m.botFillTimers, mode)
 	}
 }
 
 // tryMatch 尝试匹配
-func (m *Matcher) tryMatch() {
-	if len(m.queue) < 3 {
+func (m *Matcher) tryMatch(mode string) {
+	n := playersOf(mode)
+	queue := m.queues[mode]
+	if len(queue) < n {
 		return
 	}
 
-	// 取出前 3 个玩家
-	players := m.queue[:3]
-	m.queue = m.queue[3:]
+	// 取出前 n 个玩家
+	players := queue[:n:n]
+	m.queues[mode] = queue[n:]
 
 	// 创建房间
-	go m.createMatchRoom(players)
+	go m.createMatchRoom(players, room.RoomOptions{Mode: mode})
+}
+
+// playersOf 模式对应的开局人数
+func playersOf(mode string) int {
+	return room.RoomOptions{Mode: mode}.Layout().Players
+}
+
+// modeName 日志中的模式名称
+func modeName(mode string) string {
+	if mode == room.ModeClassic {
+		return "classic"
+	}
+	return mode
 }
 
 // createMatchRoom 创建匹配房间
-func (m *Matcher) createMatchRoom(players []types.ClientInterface) {
+func (m *Matcher) createMatchRoom(players []types.ClientInterface, opts room.RoomOptions) {
 	// 创建房间（使用第一个玩家）
-	room, err := m.roomManager.CreateRoom(players[0])
+	room, err := m.roomManager.CreateRoom(players[0], opts)
 	if err != nil {
 		log.Printf("匹配创建房间失败: %v", err)
 		// 将玩家放回队列
 		m.mu.Lock()
-		m.queue = append(players, m.queue...) // 先到先匹配
+		m.queues[opts.Mode] = append(players, m.queues[opts.Mode]...) // 先到先匹配
 		m.mu.Unlock()
 		return
 	}
@@ -161,8 +182,11 @@ func (m *Matcher) createMatchRoom(players []types.ClientInterface) {
 		}
 	}
 
-	log.Printf("🎮 匹配成功！房间 %s，玩家: %s, %s, %s",
-		room.Code, players[0].GetName(), players[1].GetName(), players[2].GetName())
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.GetName()
+	}
+	log.Printf("🎮 匹配成功！房间 %s，玩家: %s", room.Code, strings.Join(names, ", "))
 
 	// 给所有玩家发送匹配成功消息和房间信息
 	time.Sleep(100 * time.Millisecond) // 短暂延迟确保房间状态同步
@@ -170,12 +194,16 @@ func (m *Matcher) createMatchRoom(players []types.ClientInterface) {
 	for _, client := range players {
 		// 发送加入房间成功消息
 		client.SendMessage(codec.MustNewMessage(protocol.MsgRoomJoined, protocol.RoomJoinedPayload{
-			RoomCode: room.Code,
-			Player:   room.GetPlayerInfo(client.GetID()),
-			Players:  room.GetAllPlayersInfo(),
+			RoomCode:   room.Code,
+			Player:     room.GetPlayerInfo(client.GetID()),
+			Players:    room.GetAllPlayersInfo(),
+			SeedCommit: room.SeedCommit(),
 		}))
 	}
 
+	// 玩家收到承诺后才上报种子，等全员上报（或超时）再发牌
+	m.awaitClientSeeds(room)
+
 	// 自动准备所有玩家
 	room.SetAllPlayersReady()
 
@@ -216,6 +244,15 @@ func (m *Matcher) createMatchRoom(players []types.ClientInterface) {
 	}
 }
 
+// awaitClientSeeds 等待房间内真人玩家上报客户端种子，最多等 ClientSeedTimeout；
+// 超时未上报的玩家本局不带客户端种子，`ddz verify` 会提示
+func (m *Matcher) awaitClientSeeds(r *room.Room) {
+	deadline := time.Now().Add(m.gameConfig.ClientSeedTimeoutDuration())
+	for !r.ClientSeedsReady() && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
 // PracticeMatch 人机练习：立即为玩家创建含 2 个机器人的房间
 func (m *Matcher) PracticeMatch(client types.ClientInterface) {
 	engine := m.botEngine
@@ -224,12 +261,16 @@ func (m *Matcher) PracticeMatch(client types.ClientInterface) {
 	}
 	bot1 := bot.NewBotClient(engine)
 	bot2 := bot.NewBotClient(engine)
-	go m.createMatchRoom([]types.ClientInterface{client, bot1, bot2})
+	go m.createMatchRoom([]types.ClientInterface{client, bot1, bot2}, room.RoomOptions{})
 }
 
-// GetQueueLength 获取队列长度
+// GetQueueLength 获取所有模式排队的总人数
 func (m *Matcher) GetQueueLength() int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	return len(m.queue)
+	total := 0
+	for _, queue := range m.queues {
+		total += len(queue)
+	}
+	return total
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/testutil"
 )
 
@@ -16,15 +17,20 @@ func TestMatcher_QueueOps(t *testing.T) {
 	c2 := &testutil.SimpleClient{ID: "p2", Name: "Player2"}
 
 	// Add c1
-	matcher.AddToQueue(c1)
+	matcher.AddToQueue(c1, room.ModeClassic)
 	assert.Equal(t, 1, matcher.GetQueueLength())
 
 	// Add c1 again (should be ignored)
-	matcher.AddToQueue(c1)
+	matcher.AddToQueue(c1, room.ModeClassic)
 	assert.Equal(t, 1, matcher.GetQueueLength())
 
+	// c1 can't queue for another mode while still queued
+	matcher.AddToQueue(c1, room.ModeFour)
+	assert.Equal(t, 1, matcher.GetQueueLength())
+	assert.Empty(t, matcher.queues[room.ModeFour])
+
 	// Add c2
-	matcher.AddToQueue(c2)
+	matcher.AddToQueue(c2, room.ModeClassic)
 	assert.Equal(t, 2, matcher.GetQueueLength())
 
 	// Remove c1
@@ -38,4 +44,19 @@ func TestMatcher_QueueOps(t *testing.T) {
 	// Remove c2
 	matcher.RemoveFromQueue(c2)
 	assert.Equal(t, 0, matcher.GetQueueLength())
+
+	// Four-player queue doesn't start a game with three players
+	c3 := &testutil.SimpleClient{ID: "p3", Name: "Player3"}
+	for _, c := range []*testutil.SimpleClient{c1, c2, c3} {
+		matcher.AddToQueue(c, room.ModeFour)
+	}
+	assert.Equal(t, 3, matcher.GetQueueLength())
+}
+
+func TestPlayersOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 3, playersOf(room.ModeClassic))
+	assert.Equal(t, 4, playersOf(room.ModeFour))
+	assert.Equal(t, 2, playersOf(room.ModeTwo))
 }
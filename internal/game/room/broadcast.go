@@ -3,31 +3,59 @@ package room
 import (
 	"errors"
 
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 )
 
 // --- Room 方法 ---
 
-// Broadcast 广播消息给房间内所有玩家
+// spectatorHiddenMessages 只属于玩家本人、不转发给观战者的消息
+var spectatorHiddenMessages = map[protocol.MessageType]bool{
+	protocol.MsgDealCards: true, // 含玩家手牌
+	protocol.MsgError:     true,
+}
+
+// Broadcast 广播消息给房间内所有在线玩家，公开消息同时转发给观战者
 func (r *Room) Broadcast(msg *protocol.Message) {
 	for _, player := range r.Players {
-		player.Client.SendMessage(msg)
+		player.Send(msg)
 	}
+	r.BroadcastSpectators(msg)
 }
 
-// broadcastExcept 广播消息给除指定玩家外的所有玩家
+// broadcastExcept 广播消息给除指定玩家外的所有在线玩家及观战者
 func (r *Room) BroadcastExcept(excludeID string, msg *protocol.Message) {
 	for id, player := range r.Players {
 		if id != excludeID {
-			player.Client.SendMessage(msg)
+			player.Send(msg)
 		}
 	}
+	r.BroadcastSpectators(msg)
+}
+
+// BroadcastSpectators 只向观战者广播消息，发牌等私密消息会被丢弃
+func (r *Room) BroadcastSpectators(msg *protocol.Message) {
+	if spectatorHiddenMessages[msg.Type] {
+		return
+	}
+	r.spectatorMu.RLock()
+	defer r.spectatorMu.RUnlock()
+	for _, client := range r.spectators {
+		client.SendMessage(msg)
+	}
+}
+
+// Send 向玩家发送消息，掉线（Client 为 nil）时丢弃
+func (p *RoomPlayer) Send(msg *protocol.Message) {
+	if p.Client != nil {
+		p.Client.SendMessage(msg)
+	}
 }
 
 // checkAllReady 检查是否所有玩家都准备好
 func (r *Room) checkAllReady() bool {
-	if len(r.Players) < 3 {
+	if len(r.Players) < r.MaxPlayers() {
 		return false
 	}
 	for _, player := range r.Players {
@@ -43,15 +71,19 @@ func (r *Room) GetPlayerInfo(playerID string) protocol.PlayerInfo {
 	player := r.Players[playerID]
 	cardsCount := 0
 	// 游戏会话由外部调用方管理，此处暂不传入
-	return protocol.PlayerInfo{
-		ID:         player.Client.GetID(),
-		Name:       player.Client.GetName(),
+	info := protocol.PlayerInfo{
+		ID:         playerID,
+		Name:       player.Name,
 		Seat:       player.Seat,
 		Ready:      player.Ready,
 		IsLandlord: player.IsLandlord,
 		CardsCount: cardsCount,
-		IsBot:      player.Client.IsBot(),
 	}
+	if player.Client != nil {
+		info.Name = player.Client.GetName()
+		info.IsBot = player.Client.IsBot()
+	}
+	return info
 }
 
 // GetAllPlayersInfo 获取所有玩家信息
@@ -73,15 +105,27 @@ func (r *Room) StartGame() error {
 
 // startGameLocked 开始游戏（调用者已持有锁时使用）
 func (r *Room) startGameLocked() error {
-	if r.State != RoomStateWaiting || len(r.Players) < 3 {
+	if r.State != RoomStateWaiting || len(r.Players) < r.MaxPlayers() {
 		return errors.New("cannot start game: room not ready or not enough players")
 	}
 
 	r.State = RoomStateReady
+	r.HandNo++
+	if r.seedSource != nil {
+		r.ServerSeed = r.seedSource(r.HandNo)
+	} else if len(r.ServerSeed) == 0 {
+		r.ServerSeed = fairness.NewServerSeed()
+	}
 
-	// 广播游戏开始
+	// 广播游戏开始，附带服务端种子的承诺值，结算时再揭示种子
 	r.Broadcast(codec.MustNewMessage(protocol.MsgGameStart, protocol.GameStartPayload{
-		Players: r.GetAllPlayersInfo(),
+		Players:    r.GetAllPlayersInfo(),
+		RuleSet:    r.Options.RuleSet,
+		Mode:       r.Options.Mode,
+		SeedCommit: fairness.Commit(r.ServerSeed),
+		BidMode:    r.Options.BidMode,
+		Hand:       r.HandNo,
+		Hands:      r.Options.Hands,
 	}))
 
 	return nil
@@ -36,8 +36,8 @@ func (rm *RoomManager) NotifyPlayerOffline(client types.ClientInterface) {
 
 	room.mu.Lock()
 
-	// 标记当前玩家为离线
-	if player, exists := room.Players[client.GetID()]; exists {
+	// 标记当前玩家为离线（已被重连的新连接取代时不处理）
+	if player, exists := room.Players[client.GetID()]; exists && player.Client == client {
 		player.Client = nil
 	}
 
@@ -65,6 +65,7 @@ func (rm *RoomManager) NotifyPlayerOffline(client types.ClientInterface) {
 		rm.mu.Lock()
 		delete(rm.rooms, roomCode)
 		rm.mu.Unlock()
+		rm.roomRemoved(roomCode)
 		return
 	}
 
@@ -74,9 +75,8 @@ func (rm *RoomManager) NotifyPlayerOffline(client types.ClientInterface) {
 	log.Printf("📴 玩家 %s 在房间 %s 中掉线", client.GetName(), roomCode)
 }
 
-// ReconnectPlayer 玩家重连到房间
-func (rm *RoomManager) ReconnectPlayer(oldClient, newClient types.ClientInterface) error {
-	roomCode := oldClient.GetRoom()
+// ReconnectPlayer 玩家以新连接重连到 roomCode 房间中的原座位
+func (rm *RoomManager) ReconnectPlayer(roomCode string, newClient types.ClientInterface) error {
 	if roomCode == "" {
 		return nil // 不在房间中，无需重连
 	}
@@ -90,7 +90,7 @@ func (rm *RoomManager) ReconnectPlayer(oldClient, newClient types.ClientInterfac
 
 	room.mu.Lock()
 
-	player, exists := room.Players[oldClient.GetID()]
+	player, exists := room.Players[newClient.GetID()]
 	if !exists {
 		room.mu.Unlock()
 		return apperrors.ErrNotInRoom
@@ -152,15 +152,22 @@ func (rm *RoomManager) cleanup() {
 	for code, room := range rm.rooms {
 		room.mu.RLock()
 		// 只清理等待状态且超时的房间
-		if room.State == RoomStateWaiting && now.Sub(room.CreatedAt) > rm.roomTimeout {
+		waitingSince := room.CreatedAt
+		if room.handEndedAt.After(waitingSince) {
+			waitingSince = room.handEndedAt
+		}
+		if room.State == RoomStateWaiting && now.Sub(waitingSince) > rm.roomTimeout {
 			room.mu.RUnlock()
 			// 通知所有玩家房间已关闭
 			room.Broadcast(codec.NewErrorMessageWithText(protocol.ErrCodeUnknown, "房间超时已关闭"))
 			// 清理玩家状态
 			for _, p := range room.Players {
-				p.Client.SetRoom("")
+				if p.Client != nil {
+					p.Client.SetRoom("")
+				}
 			}
 			delete(rm.rooms, code)
+			rm.roomRemoved(code)
 			log.Printf("🏠 房间 %s 超时已清理", code)
 		} else {
 			room.mu.RUnlock()
@@ -23,7 +23,7 @@ func TestNotifyPlayerOffline_AllPlayersOffline(t *testing.T) {
 	client3 := testutil.NewSimpleClient("p3", "Player3")
 
 	// Create room with 3 players
-	room, err := rm.CreateRoom(client1)
+	room, err := rm.CreateRoom(client1, RoomOptions{})
 	require.NoError(t, err)
 	_, err = rm.JoinRoom(client2, room.Code)
 	require.NoError(t, err)
@@ -49,7 +49,7 @@ func TestNotifyPlayerOffline_PartialOffline(t *testing.T) {
 	client3 := testutil.NewSimpleClient("p3", "Player3")
 
 	// Create room with 3 players
-	room, err := rm.CreateRoom(client1)
+	room, err := rm.CreateRoom(client1, RoomOptions{})
 	require.NoError(t, err)
 	_, err = rm.JoinRoom(client2, room.Code)
 	require.NoError(t, err)
@@ -89,11 +89,11 @@ func TestReconnectPlayer_Success(t *testing.T) {
 	newClient := testutil.NewSimpleClient("p1", "Player1") // Same ID, new connection
 
 	// Create room
-	room, err := rm.CreateRoom(oldClient)
+	room, err := rm.CreateRoom(oldClient, RoomOptions{})
 	require.NoError(t, err)
 
 	// Reconnect
-	err = rm.ReconnectPlayer(oldClient, newClient)
+	err = rm.ReconnectPlayer(room.Code, newClient)
 	require.NoError(t, err)
 
 	// Verify new client is in room
@@ -121,7 +121,7 @@ func TestReconnectPlayer_RoomNotFound(t *testing.T) {
 	// Set room code but room doesn't exist
 	oldClient.SetRoom("NONEXISTENT")
 
-	err := rm.ReconnectPlayer(oldClient, newClient)
+	err := rm.ReconnectPlayer(oldClient.GetRoom(), newClient)
 	assert.ErrorIs(t, err, apperrors.ErrRoomNotFound)
 }
 
@@ -134,12 +134,12 @@ func TestReconnectPlayer_PlayerNotInRoom(t *testing.T) {
 	newClient := testutil.NewSimpleClient("p2", "Player2")
 
 	// Create room with client1
-	room, err := rm.CreateRoom(client1)
+	room, err := rm.CreateRoom(client1, RoomOptions{})
 	require.NoError(t, err)
 
 	// Try to reconnect client2 who was never in the room
 	oldClient.SetRoom(room.Code)
-	err = rm.ReconnectPlayer(oldClient, newClient)
+	err = rm.ReconnectPlayer(room.Code, newClient)
 	assert.ErrorIs(t, err, apperrors.ErrNotInRoom)
 }
 
@@ -151,7 +151,7 @@ func TestReconnectPlayer_NotInAnyRoom(t *testing.T) {
 	newClient := testutil.NewSimpleClient("p1", "Player1")
 
 	// Client not in any room
-	err := rm.ReconnectPlayer(oldClient, newClient)
+	err := rm.ReconnectPlayer(oldClient.GetRoom(), newClient)
 	assert.NoError(t, err) // Should return nil, not error
 }
 
@@ -181,7 +181,7 @@ func TestCleanup_TimeoutRooms(t *testing.T) {
 	client := testutil.NewSimpleClient("p1", "Player1")
 
 	// Create room
-	room, err := rm.CreateRoom(client)
+	room, err := rm.CreateRoom(client, RoomOptions{})
 	require.NoError(t, err)
 
 	// Wait for timeout
@@ -204,7 +204,7 @@ func TestCleanup_DoesNotRemoveActiveRooms(t *testing.T) {
 	client := testutil.NewSimpleClient("p1", "Player1")
 
 	// Create room
-	room, err := rm.CreateRoom(client)
+	room, err := rm.CreateRoom(client, RoomOptions{})
 	require.NoError(t, err)
 
 	// Run cleanup immediately (room is fresh)
@@ -222,7 +222,7 @@ func TestCleanup_DoesNotRemovePlayingRooms(t *testing.T) {
 	client := testutil.NewSimpleClient("p1", "Player1")
 
 	// Create room
-	room, err := rm.CreateRoom(client)
+	room, err := rm.CreateRoom(client, RoomOptions{})
 	require.NoError(t, err)
 
 	// Change state to playing
@@ -249,7 +249,7 @@ func TestSetAllPlayersReady(t *testing.T) {
 	client3 := testutil.NewSimpleClient("p3", "Player3")
 
 	// Create room with 3 players
-	room, err := rm.CreateRoom(client1)
+	room, err := rm.CreateRoom(client1, RoomOptions{})
 	require.NoError(t, err)
 	_, err = rm.JoinRoom(client2, room.Code)
 	require.NoError(t, err)
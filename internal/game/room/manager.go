@@ -6,13 +6,14 @@ import (
 	"time"
 
 	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/types"
 )
 
-// CreateRoom 创建房间
-func (rm *RoomManager) CreateRoom(client types.ClientInterface) (*Room, error) {
+// CreateRoom 创建房间，opts 为房间玩法设置
+func (rm *RoomManager) CreateRoom(client types.ClientInterface, opts RoomOptions) (*Room, error) {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 
@@ -23,13 +24,17 @@ func (rm *RoomManager) CreateRoom(client types.ClientInterface) (*Room, error) {
 		Code:        code,
 		State:       RoomStateWaiting,
 		Players:     make(map[string]*RoomPlayer),
-		PlayerOrder: make([]string, 0, 3),
+		PlayerOrder: make([]string, 0, opts.Layout().Players),
+		Options:     opts,
 		CreatedAt:   time.Now(),
+		ServerSeed:  fairness.NewServerSeed(),
 	}
 
 	// 添加创建者
 	player := &RoomPlayer{
 		Client: client,
+		Name:   client.GetName(),
+		IsBot:  client.IsBot(),
 		Seat:   0,
 		Ready:  false,
 	}
@@ -62,7 +67,7 @@ func (rm *RoomManager) JoinRoom(client types.ClientInterface, code string) (*Roo
 	room.mu.Lock()
 	defer room.mu.Unlock()
 
-	if len(room.Players) >= 3 {
+	if len(room.Players) >= room.MaxPlayers() {
 		return nil, apperrors.ErrRoomFull
 	}
 
@@ -74,6 +79,8 @@ func (rm *RoomManager) JoinRoom(client types.ClientInterface, code string) (*Roo
 	seat := len(room.Players)
 	player := &RoomPlayer{
 		Client: client,
+		Name:   client.GetName(),
+		IsBot:  client.IsBot(),
 		Seat:   seat,
 		Ready:  false,
 	}
@@ -138,13 +145,23 @@ func (rm *RoomManager) LeaveRoom(client types.ClientInterface) {
 
 	log.Printf("👋 玩家 %s 离开房间 %s (座位 %d)", client.GetName(), roomCode, player.Seat)
 
+	// 系列赛局间有人离开：系列赛结束，其余玩家也随房间解散
+	if room.inSeriesBreak() {
+		room.endSeriesLocked()
+		clear(room.Players)
+		log.Printf("🏁 房间 %s 系列赛在第 %d 局后结束", roomCode, room.HandNo)
+	}
+
 	// 如果房间空了，删除房间
 	if len(room.Players) == 0 {
 		rm.mu.Lock()
 		delete(rm.rooms, roomCode)
 		rm.mu.Unlock()
+		rm.roomRemoved(roomCode)
 		// 从 Redis 删除
-		go func() { _ = rm.redisStore.DeleteRoom(context.Background(), roomCode) }()
+		if rm.redisStore != nil && rm.redisStore.IsReady() {
+			go func() { _ = rm.redisStore.DeleteRoom(context.Background(), roomCode) }()
+		}
 		log.Printf("🏠 房间 %s 已解散", roomCode)
 	} else if rm.redisStore != nil && rm.redisStore.IsReady() {
 		go func() { _ = rm.redisStore.SaveRoom(context.Background(), room.Code, room.ToRoomData()) }()
@@ -166,10 +183,9 @@ func (rm *RoomManager) SetPlayerReady(client types.ClientInterface, ready bool)
 	}
 
 	room.mu.Lock()
-	defer room.mu.Unlock()
-
 	player, exists := room.Players[client.GetID()]
 	if !exists {
+		room.mu.Unlock()
 		return apperrors.ErrNotInRoom
 	}
 
@@ -182,26 +198,94 @@ func (rm *RoomManager) SetPlayerReady(client types.ClientInterface, ready bool)
 	}))
 
 	// 检查是否所有人都准备好了
+	started := false
 	if room.checkAllReady() {
 		if err := room.startGameLocked(); err != nil {
 			log.Printf("开始游戏失败: %v", err)
-			return nil
+		} else {
+			started = true
 		}
+	}
+	room.mu.Unlock()
 
-		// 创建游戏会话并开始
-		if rm.onGameStart != nil {
-			rm.onGameStart(room)
-		}
+	if started {
+		rm.launchGame(room)
+	}
+	return nil
+}
 
-		// 保存房间状态
-		if rm.redisStore != nil && rm.redisStore.IsReady() {
-			go func() { _ = rm.redisStore.SaveRoom(context.Background(), room.Code, room.ToRoomData()) }()
-		}
+// ForceStart 不等玩家准备直接开始新的一局，用于锦标赛等由服务端安排开局的房间
+func (rm *RoomManager) ForceStart(code string) error {
+	room := rm.GetRoom(code)
+	if room == nil {
+		return apperrors.ErrRoomNotFound
+	}
+
+	room.mu.Lock()
+	for _, p := range room.Players {
+		p.Ready = true
 	}
+	err := room.startGameLocked()
+	room.mu.Unlock()
 
+	if err != nil {
+		return err
+	}
+	rm.launchGame(room)
 	return nil
 }
 
+// launchGame 房间开局后创建游戏会话并保存房间状态。
+// 调用方不能持有 room.mu：会话开始时保存对局快照需要获取房间锁
+func (rm *RoomManager) launchGame(room *Room) {
+	// 创建游戏会话并开始
+	if rm.onGameStart != nil {
+		rm.onGameStart(room)
+	}
+
+	// 保存房间状态
+	if rm.redisStore != nil && rm.redisStore.IsReady() {
+		go func() { _ = rm.redisStore.SaveRoom(context.Background(), room.Code, room.ToRoomData()) }()
+	}
+}
+
+// RemoveRoom 移除房间。已结束的房间不会被超时清理，不再使用时需由调用方移除
+func (rm *RoomManager) RemoveRoom(code string) {
+	if room := rm.GetRoom(code); room != nil {
+		rm.removeRoom(room)
+	}
+}
+
+// removeRoom 从内存与 Redis 中移除房间；同号房间已被替换时不做处理
+func (rm *RoomManager) removeRoom(room *Room) {
+	rm.mu.Lock()
+	if rm.rooms[room.Code] != room {
+		rm.mu.Unlock()
+		return
+	}
+	delete(rm.rooms, room.Code)
+	rm.mu.Unlock()
+	rm.roomRemoved(room.Code)
+
+	if rm.redisStore != nil && rm.redisStore.IsReady() {
+		go func() { _ = rm.redisStore.DeleteRoom(context.Background(), room.Code) }()
+	}
+}
+
+// SetOnRoomRemoved 设置房间从内存移除（解散、超时清理、锦标赛散桌等）后的回调
+func (rm *RoomManager) SetOnRoomRemoved(callback func(code string)) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	rm.onRoomRemoved = callback
+}
+
+// roomRemoved 通知房间已移除；可能在持有 rm.mu 时调用，回调中不能再操作 RoomManager
+func (rm *RoomManager) roomRemoved(code string) {
+	if rm.onRoomRemoved != nil {
+		rm.onRoomRemoved(code)
+	}
+}
+
 func (rm *RoomManager) SetOnGameStart(callback func(*Room)) {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
@@ -224,11 +308,11 @@ func (rm *RoomManager) GetRoomList() []protocol.RoomListItem {
 	for code, room := range rm.rooms {
 		room.mu.RLock()
 		// 只返回等待中且未满的房间
-		if room.State == RoomStateWaiting && len(room.Players) < 3 {
+		if room.State == RoomStateWaiting && len(room.Players) < room.MaxPlayers() {
 			rooms = append(rooms, protocol.RoomListItem{
 				RoomCode:    code,
 				PlayerCount: len(room.Players),
-				MaxPlayers:  3,
+				MaxPlayers:  room.MaxPlayers(),
 			})
 		}
 		room.mu.RUnlock()
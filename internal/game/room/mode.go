@@ -0,0 +1,76 @@
+package room
+
+import (
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+)
+
+// 人数玩法模式
+const (
+	ModeClassic = ""     // 经典三人斗地主
+	ModeFour    = "four" // 四人两副牌
+	ModeTwo     = "two"  // 二人斗地主
+)
+
+// Layout 一种人数玩法的发牌方式
+type Layout struct {
+	Players      int         // 玩家人数
+	Decks        int         // 使用几副牌
+	HandSize     int         // 每人发牌张数
+	BottomSize   int         // 底牌张数
+	RemovedRanks []card.Rank // 不参与本玩法的点数
+}
+
+// layouts 各模式的发牌方式。
+// 二人玩法去掉 3 和 4 共 46 张：每人 17 张、底牌 3 张，剩余 9 张作为无人使用的暗牌
+var layouts = map[string]Layout{
+	ModeClassic: {Players: 3, Decks: 1, HandSize: 17, BottomSize: 3},
+	ModeFour:    {Players: 4, Decks: 2, HandSize: 25, BottomSize: 8},
+	ModeTwo:     {Players: 2, Decks: 1, HandSize: 17, BottomSize: 3, RemovedRanks: []card.Rank{card.Rank3, card.Rank4}},
+}
+
+// LayoutByMode 按模式名称查找发牌方式
+func LayoutByMode(mode string) (Layout, bool) {
+	l, ok := layouts[mode]
+	return l, ok
+}
+
+// Layout 返回房间玩法对应的发牌方式，未知模式回退为经典三人
+func (o RoomOptions) Layout() Layout {
+	if l, ok := layouts[o.Mode]; ok {
+		return l
+	}
+	return layouts[ModeClassic]
+}
+
+// NewDeck 按发牌方式生成未洗的整副牌
+func (l Layout) NewDeck() card.Deck {
+	return card.NewDecks(l.Decks).WithoutRanks(l.RemovedRanks...)
+}
+
+// HasRank 该玩法的牌中是否包含指定点数
+func (l Layout) HasRank(r card.Rank) bool {
+	return !slices.Contains(l.RemovedRanks, r)
+}
+
+// WildRanks 该玩法可作癞子的点数：3 到 2 中本玩法牌里有的点数，大小王不能作癞子
+func (l Layout) WildRanks() []card.Rank {
+	var ranks []card.Rank
+	for r := card.Rank3; r <= card.Rank2; r++ {
+		if l.HasRank(r) {
+			ranks = append(ranks, r)
+		}
+	}
+	return ranks
+}
+
+// ApplyTo 按发牌方式调整牌型规则：两副牌玩法的炸弹与王炸，以及癞子可替代的最小点数
+func (l Layout) ApplyTo(rs rule.RuleSet) rule.RuleSet {
+	rs.TwoDecks = l.Decks > 1
+	if ranks := l.WildRanks(); len(ranks) > 0 && ranks[0] != card.Rank3 {
+		rs.MinRank = ranks[0]
+	}
+	return rs
+}
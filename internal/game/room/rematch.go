@@ -0,0 +1,222 @@
+package room
+
+import (
+	"log"
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// rematchVote 对局结束后的再来一局投票
+type rematchVote struct {
+	agreed   map[string]bool // 已同意的玩家 ID
+	deadline time.Time
+	timer    *time.Timer
+}
+
+// SetOnRequeue 设置再来一局未成行时的回调，参数为同意的玩家与房间的人数模式
+func (rm *RoomManager) SetOnRequeue(callback func(client types.ClientInterface, mode string)) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	rm.onRequeue = callback
+}
+
+// OpenRematch 对局结束后开启再来一局投票：机器人自动同意，超时未全员同意则投票失败
+func (rm *RoomManager) OpenRematch(code string) {
+	room := rm.GetRoom(code)
+	if room == nil {
+		return
+	}
+	timeout := rm.gameConfig.RematchTimeoutDuration()
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	if room.rematch != nil {
+		return
+	}
+	vote := &rematchVote{agreed: make(map[string]bool), deadline: time.Now().Add(timeout)}
+	for id, p := range room.Players {
+		if p.Client != nil && p.Client.IsBot() {
+			vote.agreed[id] = true
+		}
+	}
+	// 全是机器人时没有人需要表态
+	if len(vote.agreed) == len(room.Players) {
+		return
+	}
+	vote.timer = time.AfterFunc(timeout, func() { rm.closeRematch(room, vote) })
+	room.rematch = vote
+	room.sendRematchStatusLocked(vote, protocol.RematchPending)
+}
+
+// RequestRematch 玩家对再来一局表态：有人拒绝则投票失败，全员同意则原房间开始新的一局
+func (rm *RoomManager) RequestRematch(client types.ClientInterface, code string, accept bool) error {
+	room := rm.GetRoom(code)
+	if room == nil {
+		return apperrors.ErrRoomNotFound
+	}
+
+	room.mu.Lock()
+	player, exists := room.Players[client.GetID()]
+	if !exists {
+		room.mu.Unlock()
+		return apperrors.ErrNotInRoom
+	}
+	vote := room.rematch
+	if vote == nil {
+		room.mu.Unlock()
+		return apperrors.ErrRematchClosed
+	}
+	// 已进入其他房间的玩家不能再回原房间开局，之前的同意也一并作废
+	if code := client.GetRoom(); accept && code != "" && code != room.Code {
+		room.withdrawRematchLocked(vote, client.GetID())
+		room.mu.Unlock()
+		return apperrors.ErrInOtherRoom
+	}
+	// 结算后玩家可能已用新连接重连
+	player.Client = client
+
+	if !accept {
+		// 先同意后又拒绝的玩家不会被放回匹配队列
+		delete(vote.agreed, client.GetID())
+		room.mu.Unlock()
+		log.Printf("🙅 玩家 %s 拒绝在房间 %s 再来一局", client.GetName(), code)
+		rm.closeRematch(room, vote)
+		return nil
+	}
+
+	vote.agreed[client.GetID()] = true
+	// 先同意的玩家之后若被安排进其他房间（如锦标赛开赛），不再计入
+	for id, p := range room.Players {
+		if vote.agreed[id] && p.Client != nil && !p.Client.IsBot() && p.Client.GetRoom() != "" && p.Client.GetRoom() != room.Code {
+			delete(vote.agreed, id)
+		}
+	}
+	if len(vote.agreed) < len(room.Players) {
+		room.sendRematchStatusLocked(vote, protocol.RematchPending)
+		room.mu.Unlock()
+		return nil
+	}
+	started := rm.startRematchLocked(room, vote)
+	room.mu.Unlock()
+
+	if started {
+		rm.launchGame(room)
+		log.Printf("🔁 房间 %s 全员同意，再来一局", room.Code)
+	}
+	return nil
+}
+
+// WithdrawRematch 撤回玩家在再来一局投票中的同意：玩家离开、掉线、进入其他房间或匹配队列后，
+// 之后其他人同意时不会再把他拉回原房间开局
+func (rm *RoomManager) WithdrawRematch(client types.ClientInterface) {
+	rm.mu.RLock()
+	rooms := make([]*Room, 0, len(rm.rooms))
+	for _, room := range rm.rooms {
+		rooms = append(rooms, room)
+	}
+	rm.mu.RUnlock()
+
+	for _, room := range rooms {
+		room.mu.Lock()
+		if vote := room.rematch; vote != nil {
+			room.withdrawRematchLocked(vote, client.GetID())
+		}
+		room.mu.Unlock()
+	}
+}
+
+// withdrawRematchLocked 作废玩家的同意并推送最新投票状态（调用方需持有 r.mu）
+func (r *Room) withdrawRematchLocked(vote *rematchVote, playerID string) {
+	if !vote.agreed[playerID] {
+		return
+	}
+	delete(vote.agreed, playerID)
+	r.sendRematchStatusLocked(vote, protocol.RematchPending)
+}
+
+// startRematchLocked 全员同意：沿用房间号重新开局，返回是否开局成功；
+// 开局成功后由调用方在释放 room.mu 后创建游戏会话（调用方需持有 room.mu）
+func (rm *RoomManager) startRematchLocked(room *Room, vote *rematchVote) bool {
+	vote.timer.Stop()
+	room.rematch = nil
+	room.sendRematchStatusLocked(vote, protocol.RematchStarted)
+
+	// 系列赛的局数与累计成绩从头开始
+	room.State = RoomStateWaiting
+	room.HandNo = 0
+	room.series = nil
+	for _, p := range room.Players {
+		p.Ready = true
+		p.IsLandlord = false
+		if p.Client != nil {
+			p.Client.SetRoom(room.Code)
+		}
+	}
+	if err := room.startGameLocked(); err != nil {
+		log.Printf("再来一局开始游戏失败: %v", err)
+		return false
+	}
+	return true
+}
+
+// closeRematch 投票失败（有人拒绝或超时）：同意的玩家回到匹配队列，房间解散
+func (rm *RoomManager) closeRematch(room *Room, vote *rematchVote) {
+	room.mu.Lock()
+	if room.rematch != vote {
+		// 已开局或已被关闭
+		room.mu.Unlock()
+		return
+	}
+	vote.timer.Stop()
+	room.rematch = nil
+	room.sendRematchStatusLocked(vote, protocol.RematchFailed)
+
+	// 已进入其他房间的玩家不再排队
+	var requeue []types.ClientInterface
+	for _, id := range room.PlayerOrder {
+		p := room.Players[id]
+		if vote.agreed[id] && p.Client != nil && !p.Client.IsBot() && p.Client.GetRoom() == "" {
+			requeue = append(requeue, p.Client)
+		}
+	}
+	mode := room.Options.Mode
+	room.mu.Unlock()
+
+	rm.removeRoom(room)
+	rm.mu.RLock()
+	onRequeue := rm.onRequeue
+	rm.mu.RUnlock()
+	log.Printf("🏠 房间 %s 未能再来一局，已解散", room.Code)
+
+	if onRequeue != nil {
+		for _, c := range requeue {
+			onRequeue(c, mode)
+		}
+	}
+}
+
+// sendRematchStatusLocked 向房间内玩家推送投票状态（调用方需持有 r.mu）
+func (r *Room) sendRematchStatusLocked(vote *rematchVote, result string) {
+	payload := protocol.RematchStatusPayload{
+		RoomCode: r.Code,
+		Agreed:   make([]string, 0, len(vote.agreed)),
+		Result:   result,
+	}
+	for _, id := range r.PlayerOrder {
+		if vote.agreed[id] {
+			payload.Agreed = append(payload.Agreed, id)
+		}
+	}
+	if result == protocol.RematchPending {
+		payload.Timeout = max(int(time.Until(vote.deadline).Round(time.Second).Seconds()), 0)
+	}
+	msg := codec.MustNewMessage(protocol.MsgRematchStatus, payload)
+	for _, p := range r.Players {
+		p.Send(msg)
+	}
+}
@@ -0,0 +1,190 @@
+package room
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// botClient 测试用机器人客户端
+type botClient struct {
+	*testutil.SimpleClient
+}
+
+func (botClient) IsBot() bool { return true }
+
+// newEndedRoom 创建一局刚结束的三人房间，clients 中依次为座位 0~2 的玩家
+func newEndedRoom(t *testing.T, clients ...types.ClientInterface) (*RoomManager, *Room) {
+	t.Helper()
+	rm := NewRoomManager(storage.NewRedisStore(nil), config.GameConfig{RoomTimeout: 10, RematchTimeout: 60})
+	r, err := rm.CreateRoom(clients[0], RoomOptions{})
+	require.NoError(t, err)
+	for _, c := range clients[1:] {
+		_, err = rm.JoinRoom(c, r.Code)
+		require.NoError(t, err)
+	}
+	r.SetAllPlayersReady()
+	require.NoError(t, r.StartGame())
+	r.State = RoomStateEnded
+	for _, c := range clients {
+		c.SetRoom("")
+	}
+	return rm, r
+}
+
+// lastRematchStatus 客户端最近收到的再来一局投票状态
+func lastRematchStatus(t *testing.T, c *testutil.SimpleClient) *protocol.RematchStatusPayload {
+	t.Helper()
+	msgs := c.SentMessages()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].Type == protocol.MsgRematchStatus {
+			status, err := codec.ParsePayload[protocol.RematchStatusPayload](msgs[i])
+			require.NoError(t, err)
+			return status
+		}
+	}
+	t.Fatal("未收到再来一局投票状态")
+	return nil
+}
+
+func TestRoomManager_Rematch(t *testing.T) {
+	t.Parallel()
+
+	newClients := func() []*testutil.SimpleClient {
+		return []*testutil.SimpleClient{
+			testutil.NewSimpleClient("p1", "Player1"),
+			testutil.NewSimpleClient("p2", "Player2"),
+			testutil.NewSimpleClient("p3", "Player3"),
+		}
+	}
+
+	t.Run("全员同意后沿用房间号重新开局", func(t *testing.T) {
+		t.Parallel()
+		c := newClients()
+		rm, r := newEndedRoom(t, c[0], c[1], c[2])
+		started := 0
+		rm.SetOnGameStart(func(*Room) { started++ })
+
+		rm.OpenRematch(r.Code)
+		assert.Equal(t, protocol.RematchPending, lastRematchStatus(t, c[0]).Result)
+
+		require.NoError(t, rm.RequestRematch(c[0], r.Code, true))
+		require.NoError(t, rm.RequestRematch(c[1], r.Code, true))
+		status := lastRematchStatus(t, c[2])
+		assert.Equal(t, []string{"p1", "p2"}, status.Agreed)
+		assert.Positive(t, status.Timeout)
+		assert.Zero(t, started)
+
+		require.NoError(t, rm.RequestRematch(c[2], r.Code, true))
+		assert.Equal(t, protocol.RematchStarted, lastRematchStatus(t, c[0]).Result)
+		assert.Equal(t, 1, started)
+		assert.Equal(t, RoomStateReady, r.State)
+		assert.Equal(t, 1, r.HandNo)
+		for _, client := range c {
+			assert.Equal(t, r.Code, client.GetRoom())
+		}
+		assert.ErrorIs(t, rm.RequestRematch(c[0], r.Code, true), apperrors.ErrRematchClosed)
+	})
+
+	t.Run("有人拒绝时同意的玩家回到匹配队列", func(t *testing.T) {
+		t.Parallel()
+		c := newClients()
+		rm, r := newEndedRoom(t, c[0], c[1], c[2])
+		var requeued []string
+		rm.SetOnRequeue(func(client types.ClientInterface, mode string) {
+			requeued = append(requeued, client.GetID())
+			assert.Equal(t, r.Options.Mode, mode)
+		})
+		var removed []string
+		rm.SetOnRoomRemoved(func(code string) { removed = append(removed, code) })
+
+		rm.OpenRematch(r.Code)
+		require.NoError(t, rm.RequestRematch(c[0], r.Code, true))
+		require.NoError(t, rm.RequestRematch(c[1], r.Code, false))
+
+		assert.Equal(t, protocol.RematchFailed, lastRematchStatus(t, c[2]).Result)
+		assert.Equal(t, []string{"p1"}, requeued)
+		assert.Nil(t, rm.GetRoom(r.Code), "房间随投票失败解散")
+		assert.Equal(t, []string{r.Code}, removed, "解散后通知释放游戏会话")
+	})
+
+	t.Run("已进入其他房间的玩家不能同意，离开后撤回同意", func(t *testing.T) {
+		t.Parallel()
+		c := newClients()
+		rm, r := newEndedRoom(t, c[0], c[1], c[2])
+		started := 0
+		rm.SetOnGameStart(func(*Room) { started++ })
+
+		rm.OpenRematch(r.Code)
+		require.NoError(t, rm.RequestRematch(c[0], r.Code, true))
+		require.NoError(t, rm.RequestRematch(c[1], r.Code, true))
+
+		// p1 同意后进入了别的房间：再次同意被拒绝，之前的同意作废
+		c[0].SetRoom("654321")
+		require.ErrorIs(t, rm.RequestRematch(c[0], r.Code, true), apperrors.ErrInOtherRoom)
+		assert.Equal(t, []string{"p2"}, lastRematchStatus(t, c[2]).Agreed)
+
+		// p2 进入匹配队列等其他去处时撤回同意，p3 同意也不会开局
+		rm.WithdrawRematch(c[1])
+		assert.Empty(t, lastRematchStatus(t, c[2]).Agreed)
+		require.NoError(t, rm.RequestRematch(c[2], r.Code, true))
+		assert.Zero(t, started)
+		assert.Equal(t, "654321", c[0].GetRoom())
+		assert.Empty(t, c[1].GetRoom())
+	})
+
+	t.Run("同意的玩家被安排进其他房间后不计入", func(t *testing.T) {
+		t.Parallel()
+		c := newClients()
+		rm, r := newEndedRoom(t, c[0], c[1], c[2])
+		started := 0
+		rm.SetOnGameStart(func(*Room) { started++ })
+
+		rm.OpenRematch(r.Code)
+		require.NoError(t, rm.RequestRematch(c[0], r.Code, true))
+		require.NoError(t, rm.RequestRematch(c[1], r.Code, true))
+		c[0].SetRoom("654321")
+		require.NoError(t, rm.RequestRematch(c[2], r.Code, true))
+
+		assert.Zero(t, started)
+		assert.Equal(t, []string{"p2", "p3"}, lastRematchStatus(t, c[2]).Agreed)
+		assert.Equal(t, "654321", c[0].GetRoom(), "不会被拉回原房间")
+	})
+
+	t.Run("机器人自动同意，超时未表态视为拒绝", func(t *testing.T) {
+		t.Parallel()
+		c := newClients()
+		bot1 := botClient{testutil.NewSimpleClient("b1", "Bot1")}
+		bot2 := botClient{testutil.NewSimpleClient("b2", "Bot2")}
+		rm, r := newEndedRoom(t, c[0], bot1, bot2)
+		rm.gameConfig.RematchTimeout = 0
+
+		var mu sync.Mutex
+		var requeued []string
+		rm.SetOnRequeue(func(client types.ClientInterface, _ string) {
+			mu.Lock()
+			defer mu.Unlock()
+			requeued = append(requeued, client.GetID())
+		})
+
+		rm.OpenRematch(r.Code)
+		assert.Eventually(t, func() bool { return rm.GetRoom(r.Code) == nil }, time.Second, 10*time.Millisecond)
+		status := lastRematchStatus(t, c[0])
+		assert.Equal(t, protocol.RematchFailed, status.Result)
+		assert.Equal(t, []string{"b1", "b2"}, status.Agreed)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Empty(t, requeued, "机器人不会回到匹配队列")
+	})
+}
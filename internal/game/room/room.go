@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
 	"github.com/palemoky/fight-the-landlord/internal/types"
 )
@@ -16,10 +18,56 @@ const (
 
 // RoomPlayer 房间中的玩家
 type RoomPlayer struct {
-	Client     types.ClientInterface
-	Seat       int  // 座位号 0-2
-	Ready      bool // 是否准备
-	IsLandlord bool // 是否是地主
+	Client     types.ClientInterface // 掉线或服务重启后尚未重连时为 nil
+	Name       string                // 玩家昵称，Client 为 nil 时用于展示
+	IsBot      bool                  // 是否机器人，Client 为 nil 时用于判断
+	Seat       int                   // 座位号，从 0 开始
+	Ready      bool                  // 是否准备
+	IsLandlord bool                  // 是否是地主
+	ClientSeed string                // 收到本房间种子承诺后上报的下一局客户端种子
+}
+
+// RoomOptions 建房时选择的玩法设置，零值即经典玩法
+type RoomOptions struct {
+	RuleSet  string // 房规名称，空表示经典规则
+	Laizi    bool   // 癞子玩法：每局发牌后随机一个点数作为癞子
+	Mode     string // 人数玩法模式，见 ModeClassic 等
+	BidMode  string // 叫地主方式，见 BidModeGrab 等
+	Doubling bool   // 定地主后进入加倍阶段
+	Hands    int    // 系列赛局数，0 或 1 表示单局
+}
+
+// 叫地主方式
+const (
+	BidModeGrab  = ""      // 叫抢地主：叫地主后其余玩家可抢，每抢翻一倍
+	BidModeScore = "score" // 叫分：依次叫 1/2/3 分或不叫，分高者为地主，所叫分数即底倍
+)
+
+// MaxBidScore 叫分模式的最高分，叫到该分数立即成为地主
+const MaxBidScore = 3
+
+// ValidBidMode 判断叫地主方式是否有效
+func ValidBidMode(mode string) bool {
+	return mode == BidModeGrab || mode == BidModeScore
+}
+
+// 加倍选择
+const (
+	DoubleNone  = 0 // 不加倍
+	DoubleTwice = 1 // 加倍（×2）
+	DoubleSuper = 2 // 超级加倍（×4）
+)
+
+// DoubleFactor 返回加倍选择对应的倍数，无效选择视为不加倍
+func DoubleFactor(level int) int {
+	switch level {
+	case DoubleTwice:
+		return 2
+	case DoubleSuper:
+		return 4
+	default:
+		return 1
+	}
 }
 
 // Room 游戏房间
@@ -28,19 +76,41 @@ type Room struct {
 	State       RoomState              // 房间状态
 	Players     map[string]*RoomPlayer // 玩家列表
 	PlayerOrder []string               // 玩家顺序（按座位）
+	Options     RoomOptions            // 玩法设置
 	CreatedAt   time.Time              // 创建时间
+	ServerSeed  []byte                 // 下一局洗牌的服务端种子，建房与每局结算时生成，只公布其承诺值
+	HandNo      int                    // 已开始的局数，系列赛中即当前是第几局
+
+	// 复式赛各桌按局数共用的服务端种子，nil 表示使用房间自己的种子
+	seedSource func(hand int) []byte
+	// 所属锦标赛 ID，空表示普通房间；锦标赛进度只在内存中，重启后据此识别无法接续的锦标赛桌
+	tournament string
+
+	// 系列赛（Options.Hands > 1）累计成绩，按首局座位顺序
+	series      []protocol.SeriesStanding
+	handEndedAt time.Time // 上一局结束时间，局间等待超时从此刻起算
+
+	rematch *rematchVote // 对局结束后的再来一局投票，未在投票时为 nil
+
+	gameData *storage.GameSessionData // 进行中对局的最新快照，随房间一起存入 Redis
+
+	spectators  map[string]types.ClientInterface // 观战者，与 Players 分开，只接收公开消息
+	spectatorMu sync.RWMutex                     // 保护 spectators，广播时调用方未必持有 mu
 
 	mu sync.RWMutex
 }
 
 // RoomManager 房间管理器
 type RoomManager struct {
-	redisStore  *storage.RedisStore
-	roomTimeout time.Duration
-	gameConfig  config.GameConfig
-	onGameStart func(*Room)
-	rooms       map[string]*Room
-	mu          sync.RWMutex
+	redisStore    *storage.RedisStore
+	roomTimeout   time.Duration
+	gameConfig    config.GameConfig
+	onGameStart   func(*Room)
+	onRequeue     func(types.ClientInterface, string) // 再来一局未成行时把同意的玩家送回匹配队列
+	onRoomRemoved func(code string)                   // 房间从内存移除后调用（释放其游戏会话）
+	rooms         map[string]*Room
+	spectating    map[string]string // 观战者 ID → 房间号
+	mu            sync.RWMutex
 }
 
 // NewRoomManager 创建房间管理器
@@ -50,6 +120,7 @@ func NewRoomManager(rs *storage.RedisStore, gameConfig config.GameConfig) *RoomM
 		roomTimeout: gameConfig.RoomTimeoutDuration(),
 		gameConfig:  gameConfig,
 		rooms:       make(map[string]*Room),
+		spectating:  make(map[string]string),
 	}
 
 	// 启动房间清理协程
@@ -57,3 +128,14 @@ func NewRoomManager(rs *storage.RedisStore, gameConfig config.GameConfig) *RoomM
 
 	return rm
 }
+
+// Rules 返回房间的牌型规则，未知房规名称回退为经典规则
+func (r *Room) Rules() rule.RuleSet {
+	rs, _ := rule.RuleSetByName(r.Options.RuleSet)
+	return r.Options.Layout().ApplyTo(rs)
+}
+
+// MaxPlayers 房间满员人数
+func (r *Room) MaxPlayers() int {
+	return r.Options.Layout().Players
+}
@@ -5,8 +5,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
 	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/testutil"
 )
 
@@ -85,3 +91,77 @@ func TestRoom_GetPlayerInfo(t *testing.T) {
 	assert.Equal(t, 1, info.Seat)
 	assert.True(t, info.Ready)
 }
+
+func TestLayout_DealFitsDeck(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []string{ModeClassic, ModeFour, ModeTwo} {
+		layout, ok := LayoutByMode(mode)
+		assert.True(t, ok, "mode %q", mode)
+		dealt := layout.Players*layout.HandSize + layout.BottomSize
+		assert.LessOrEqual(t, dealt, len(layout.NewDeck()), "mode %q", mode)
+	}
+
+	two, _ := LayoutByMode(ModeTwo)
+	assert.Len(t, two.NewDeck(), 46)
+	assert.False(t, two.HasRank(card.Rank3))
+	assert.True(t, two.HasRank(card.Rank5))
+	assert.Len(t, layouts[ModeClassic].WildRanks(), 13)
+	assert.Equal(t, []card.Rank{card.Rank5, card.Rank6}, two.WildRanks()[:2], "二人玩法去掉的点数不能作癞子")
+	assert.Equal(t, card.Rank5, two.ApplyTo(rule.DefaultRuleSet).MinRank, "癞子不能替代二人玩法去掉的点数")
+	assert.Equal(t, rule.DefaultRuleSet, layouts[ModeClassic].ApplyTo(rule.DefaultRuleSet))
+}
+
+func TestRoom_BroadcastSpectators(t *testing.T) {
+	t.Parallel()
+
+	player := testutil.NewSimpleClient("p1", "Player1")
+	spectator := testutil.NewSimpleClient("s1", "Spectator1")
+	room := NewMockRoom("123456", player)
+	room.AddSpectator(spectator)
+	assert.Equal(t, 1, room.SpectatorCount())
+	assert.NotContains(t, room.Players, "s1", "观战者不应计入玩家")
+
+	// 公开消息同时发给玩家与观战者，发牌只发给玩家
+	room.Broadcast(codec.MustNewMessage(protocol.MsgPlayerPass, protocol.PlayerPassPayload{PlayerID: "p1"}))
+	room.Broadcast(codec.MustNewMessage(protocol.MsgDealCards, protocol.DealCardsPayload{}))
+	room.BroadcastExcept("p1", codec.MustNewMessage(protocol.MsgPlayerLeft, protocol.PlayerLeftPayload{PlayerID: "p2"}))
+
+	assert.Len(t, player.SentMessages(), 2)
+	require.Len(t, spectator.SentMessages(), 2)
+	assert.Equal(t, protocol.MsgPlayerPass, spectator.SentMessages()[0].Type)
+	assert.Equal(t, protocol.MsgPlayerLeft, spectator.SentMessages()[1].Type)
+
+	// 同 ID 的其他连接不能移除观战者
+	assert.False(t, room.RemoveSpectator(testutil.NewSimpleClient("s1", "Spectator1")))
+	assert.True(t, room.RemoveSpectator(spectator))
+	room.Broadcast(codec.MustNewMessage(protocol.MsgPlayerPass, protocol.PlayerPassPayload{PlayerID: "p1"}))
+	assert.Len(t, spectator.SentMessages(), 2)
+}
+
+func TestRoomManager_SpectateRoom(t *testing.T) {
+	t.Parallel()
+
+	rm := NewRoomManager(nil, config.GameConfig{RoomTimeout: 10})
+	waiting := NewMockRoom("111111", testutil.NewSimpleClient("p1", "Player1"))
+	playing := NewMockRoom("222222", testutil.NewSimpleClient("p2", "Player2"))
+	playing.State = RoomStatePlaying
+	rm.AddRoomForTest(waiting)
+	rm.AddRoomForTest(playing)
+
+	spectator := testutil.NewSimpleClient("s1", "Spectator1")
+	_, err := rm.SpectateRoom(spectator, "999999")
+	assert.ErrorIs(t, err, apperrors.ErrRoomNotFound)
+	_, err = rm.SpectateRoom(spectator, waiting.Code)
+	assert.ErrorIs(t, err, apperrors.ErrGameNotStart)
+
+	room, err := rm.SpectateRoom(spectator, playing.Code)
+	require.NoError(t, err)
+	assert.Same(t, playing, room)
+	assert.Equal(t, 1, playing.SpectatorCount())
+	assert.Empty(t, spectator.GetRoom(), "观战不占用玩家的房间号")
+
+	assert.Same(t, playing, rm.StopSpectating(spectator))
+	assert.Equal(t, 0, playing.SpectatorCount())
+	assert.Nil(t, rm.StopSpectating(spectator))
+}
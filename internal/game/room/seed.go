@@ -0,0 +1,96 @@
+package room
+
+import (
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// SeedCommit 返回下一局服务端种子的承诺值
+func (r *Room) SeedCommit() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return fairness.Commit(r.ServerSeed)
+}
+
+// SetSeedSource 复式赛桌改用按局数共用的服务端种子，开局时取出并公布其承诺
+func (r *Room) SetSeedSource(fn func(hand int) []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seedSource = fn
+}
+
+// RotateSeed 一局结算揭示种子后换一个新的服务端种子，并清空已上报的客户端种子，返回新种子的承诺值。
+// 客户端收到承诺后才上报下一局的种子，服务端因此无法再按客户端种子挑选对自己有利的服务端种子。
+// 复式赛桌的种子由赛事给出、不采用客户端种子，返回空串
+func (r *Room) RotateSeed() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seedSource != nil {
+		return ""
+	}
+	r.ServerSeed = fairness.NewServerSeed()
+	for _, p := range r.Players {
+		p.ClientSeed = ""
+	}
+	return fairness.Commit(r.ServerSeed)
+}
+
+// SetClientSeed 记录玩家上报的下一局客户端种子；空的、超长或含不可见字符的种子，
+// 以及开局后才上报（已晚于洗牌）的种子都拒绝采用
+func (rm *RoomManager) SetClientSeed(client types.ClientInterface, seed string) error {
+	if !fairness.ValidClientSeed(seed) {
+		return apperrors.ErrInvalidSeed
+	}
+	room := rm.seedRoom(client)
+	if room == nil {
+		return apperrors.ErrNotInRoom
+	}
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	player, exists := room.Players[client.GetID()]
+	if !exists {
+		return apperrors.ErrNotInRoom
+	}
+	if room.State != RoomStateWaiting && room.State != RoomStateEnded {
+		return apperrors.ErrGameStarted
+	}
+	player.ClientSeed = seed
+	return nil
+}
+
+// ClientSeedsReady 房间内的真人玩家是否都已上报客户端种子（机器人不上报）
+func (r *Room) ClientSeedsReady() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.Players {
+		if p.Client != nil && !p.Client.IsBot() && p.ClientSeed == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// seedRoom 找到玩家上报种子所对应的房间。对局打完后玩家已离开房间，
+// 但再来一局仍在原房间开局，因此也接受仍保留该玩家的已结束房间
+func (rm *RoomManager) seedRoom(client types.ClientInterface) *Room {
+	if code := client.GetRoom(); code != "" {
+		return rm.GetRoom(code)
+	}
+
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+	for _, room := range rm.rooms {
+		room.mu.RLock()
+		_, inRoom := room.Players[client.GetID()]
+		ended := room.State == RoomStateEnded
+		room.mu.RUnlock()
+		if inRoom && ended {
+			return room
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package room
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+)
+
+func TestRoomManager_SetClientSeed(t *testing.T) {
+	t.Parallel()
+
+	rm := NewRoomManager(storage.NewRedisStore(nil), config.GameConfig{RoomTimeout: 10})
+	c1 := testutil.NewSimpleClient("p1", "Player1")
+	c2 := testutil.NewSimpleClient("p2", "Player2")
+	c3 := testutil.NewSimpleClient("p3", "Player3")
+	r, err := rm.CreateRoom(c1, RoomOptions{})
+	require.NoError(t, err)
+	for _, c := range []*testutil.SimpleClient{c2, c3} {
+		_, err = rm.JoinRoom(c, r.Code)
+		require.NoError(t, err)
+	}
+
+	// 建房时已生成服务端种子，开局公布的承诺与入房时一致
+	commit := r.SeedCommit()
+	require.NotEmpty(t, commit)
+	// 空的、超长或含不可见字符的种子不被采用
+	for _, bad := range []string{"", strings.Repeat("x", fairness.MaxClientSeedLen+1), "seed\x00"} {
+		require.ErrorIs(t, rm.SetClientSeed(c1, bad), apperrors.ErrInvalidSeed)
+	}
+	assert.Empty(t, r.Players["p1"].ClientSeed)
+
+	require.NoError(t, rm.SetClientSeed(c1, "seed-1"))
+	assert.Equal(t, "seed-1", r.Players["p1"].ClientSeed)
+	assert.False(t, r.ClientSeedsReady(), "还有玩家未上报种子")
+	require.NoError(t, rm.SetClientSeed(c2, "seed-2"))
+	require.NoError(t, rm.SetClientSeed(c3, "seed-3"))
+	assert.True(t, r.ClientSeedsReady())
+
+	r.SetAllPlayersReady()
+	require.NoError(t, r.StartGame())
+	msgs := c1.SentMessages()
+	start, err := codec.ParsePayload[protocol.GameStartPayload](msgs[len(msgs)-1])
+	require.NoError(t, err)
+	assert.Equal(t, commit, start.SeedCommit)
+
+	// 开局后上报的种子不被采用
+	require.ErrorIs(t, rm.SetClientSeed(c2, "late"), apperrors.ErrGameStarted)
+	assert.Equal(t, "seed-2", r.Players["p2"].ClientSeed)
+
+	// 结算后换种子，打完离开房间的玩家仍可为再来一局上报种子
+	r.State = RoomStateEnded
+	next := r.RotateSeed()
+	assert.NotEqual(t, commit, next)
+	assert.Equal(t, next, r.SeedCommit())
+	assert.Empty(t, r.Players["p1"].ClientSeed)
+
+	c2.SetRoom("")
+	require.NoError(t, rm.SetClientSeed(c2, "seed-2"))
+	assert.Equal(t, "seed-2", r.Players["p2"].ClientSeed)
+
+	stranger := testutil.NewSimpleClient("p4", "Player4")
+	require.ErrorIs(t, rm.SetClientSeed(stranger, "seed-4"), apperrors.ErrNotInRoom)
+}
@@ -1,6 +1,9 @@
 package room
 
 import (
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
 )
 
@@ -15,6 +18,19 @@ func (r *Room) ToRoomData() *storage.RoomData {
 		Players:     make([]storage.PlayerData, 0, len(r.Players)),
 		PlayerOrder: r.PlayerOrder,
 		CreatedAt:   r.CreatedAt.Unix(),
+		Options: storage.RoomOptionsData{
+			RuleSet:  r.Options.RuleSet,
+			Laizi:    r.Options.Laizi,
+			Mode:     r.Options.Mode,
+			BidMode:  r.Options.BidMode,
+			Doubling: r.Options.Doubling,
+			Hands:    r.Options.Hands,
+		},
+		ServerSeed: r.ServerSeed,
+		GameData:   r.gameData,
+		HandNo:     r.HandNo,
+		Series:     seriesData(r.series),
+		Tournament: r.tournament,
 	}
 
 	for _, player := range r.Players {
@@ -31,7 +47,70 @@ func (r *Room) ToRoomData() *storage.RoomData {
 		})
 	}
 
-	// TODO: 如果需要保存游戏会话数据，在这里添加
+	return data
+}
+
+// SetGameData 更新随房间保存的对局快照，nil 表示没有进行中的对局
+func (r *Room) SetGameData(data *storage.GameSessionData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gameData = data
+}
+
+// RestoreRoom 由 Redis 中的房间数据重建进行中的房间，玩家均为掉线状态，等待各自重连
+func (rm *RoomManager) RestoreRoom(data *storage.RoomData) *Room {
+	r := &Room{
+		Code:        data.Code,
+		State:       RoomState(data.State),
+		Players:     make(map[string]*RoomPlayer, len(data.PlayerOrder)),
+		PlayerOrder: data.PlayerOrder,
+		Options: RoomOptions{
+			RuleSet:  data.Options.RuleSet,
+			Laizi:    data.Options.Laizi,
+			Mode:     data.Options.Mode,
+			BidMode:  data.Options.BidMode,
+			Doubling: data.Options.Doubling,
+			Hands:    data.Options.Hands,
+		},
+		CreatedAt:  time.Unix(data.CreatedAt, 0),
+		ServerSeed: data.ServerSeed,
+		HandNo:     data.HandNo,
+		series:     seriesFromData(data.Series),
+		gameData:   data.GameData,
+		tournament: data.Tournament,
+	}
+	if data.GameData != nil {
+		for _, p := range data.GameData.Players {
+			r.Players[p.ID] = &RoomPlayer{Name: p.Name, IsBot: p.IsBot, Seat: p.Seat, Ready: true, IsLandlord: p.IsLandlord}
+		}
+	}
 
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	rm.rooms[r.Code] = r
+	return r
+}
+
+// seriesData 把系列赛成绩转换为存储格式
+func seriesData(series []protocol.SeriesStanding) []storage.SeriesStandingData {
+	if series == nil {
+		return nil
+	}
+	data := make([]storage.SeriesStandingData, len(series))
+	for i, s := range series {
+		data[i] = storage.SeriesStandingData{PlayerID: s.PlayerID, PlayerName: s.PlayerName, Total: s.Total, Last: s.Last, Wins: s.Wins}
+	}
 	return data
 }
+
+// seriesFromData 由存储格式还原系列赛成绩
+func seriesFromData(data []storage.SeriesStandingData) []protocol.SeriesStanding {
+	if data == nil {
+		return nil
+	}
+	series := make([]protocol.SeriesStanding, len(data))
+	for i, s := range data {
+		series[i] = protocol.SeriesStanding{PlayerID: s.PlayerID, PlayerName: s.PlayerName, Total: s.Total, Last: s.Last, Wins: s.Wins}
+	}
+	return series
+}
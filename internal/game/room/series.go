@@ -0,0 +1,111 @@
+package room
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+)
+
+// MaxSeriesHands 系列赛最多局数
+const MaxSeriesHands = 20
+
+// IsSeries 是否为多局系列赛：同一房间连续打多局并累计得分
+func (o RoomOptions) IsSeries() bool {
+	return o.Hands > 1
+}
+
+// FinishHand 累计一局的得分。系列赛尚未打满时房间回到等待状态，所有玩家重新准备后开始下一局；
+// 返回系列赛当前排名（非系列赛为 nil）
+func (r *Room) FinishHand(scores []protocol.PlayerScore) *protocol.SeriesStandingsPayload {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.Options.IsSeries() {
+		return nil
+	}
+	for _, s := range scores {
+		i := slices.IndexFunc(r.series, func(st protocol.SeriesStanding) bool { return st.PlayerID == s.PlayerID })
+		if i == -1 {
+			r.series = append(r.series, protocol.SeriesStanding{PlayerID: s.PlayerID, PlayerName: s.PlayerName})
+			i = len(r.series) - 1
+		}
+		r.series[i].Total += s.Score
+		r.series[i].Last = s.Score
+		if s.Score > 0 {
+			r.series[i].Wins++
+		}
+	}
+
+	final := r.HandNo >= r.Options.Hands
+	if !final {
+		r.State = RoomStateWaiting
+		r.handEndedAt = time.Now()
+		for _, p := range r.Players {
+			p.Ready = false
+			p.IsLandlord = false
+		}
+	}
+	return r.standingsLocked(final)
+}
+
+// SetTournament 标记房间为锦标赛的牌桌，随房间数据一起保存
+func (r *Room) SetTournament(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tournament = id
+}
+
+// DetachTournament 服务重启后锦标赛进度已丢失，恢复的锦标赛桌改为普通对局：打完当前这局即结束，不再开始下一局。
+// 返回原锦标赛 ID，普通房间返回空串
+func (r *Room) DetachTournament() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := r.tournament
+	if id == "" {
+		return ""
+	}
+	r.tournament = ""
+	r.Options.Hands = r.HandNo
+	return id
+}
+
+// SeriesStandings 返回系列赛当前的累计排名，按得分从高到低
+func (r *Room) SeriesStandings() []protocol.SeriesStanding {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.standingsLocked(false).Standings
+}
+
+// endSeriesLocked 系列赛局间有人离开：提前结束系列赛，向其余玩家公布最终排名（调用方需持有 r.mu）
+func (r *Room) endSeriesLocked() {
+	r.State = RoomStateEnded
+	r.Broadcast(codec.MustNewMessage(protocol.MsgSeriesStandings, *r.standingsLocked(true)))
+	for _, p := range r.Players {
+		if p.Client != nil {
+			p.Client.SetRoom("")
+		}
+	}
+}
+
+// inSeriesBreak 系列赛是否处于局间等待（已打完至少一局、下一局尚未开始）（调用方需持有 r.mu）
+func (r *Room) inSeriesBreak() bool {
+	return r.Options.IsSeries() && r.HandNo > 0 && r.State == RoomStateWaiting
+}
+
+// standingsLocked 按累计得分从高到低生成排名（调用方需持有 r.mu）
+func (r *Room) standingsLocked(final bool) *protocol.SeriesStandingsPayload {
+	standings := slices.Clone(r.series)
+	slices.SortStableFunc(standings, func(a, b protocol.SeriesStanding) int {
+		return cmp.Compare(b.Total, a.Total)
+	})
+	return &protocol.SeriesStandingsPayload{
+		Hand:      r.HandNo,
+		Hands:     r.Options.Hands,
+		Standings: standings,
+		Final:     final,
+	}
+}
@@ -0,0 +1,123 @@
+package room
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+)
+
+// newSeriesRoom 创建三人满员的系列赛房间
+func newSeriesRoom(t *testing.T, hands int) (*RoomManager, *Room, []*testutil.SimpleClient) {
+	t.Helper()
+	rm := NewRoomManager(storage.NewRedisStore(nil), config.GameConfig{RoomTimeout: 10})
+	clients := []*testutil.SimpleClient{
+		testutil.NewSimpleClient("p1", "Player1"),
+		testutil.NewSimpleClient("p2", "Player2"),
+		testutil.NewSimpleClient("p3", "Player3"),
+	}
+	r, err := rm.CreateRoom(clients[0], RoomOptions{Hands: hands})
+	require.NoError(t, err)
+	for _, c := range clients[1:] {
+		_, err = rm.JoinRoom(c, r.Code)
+		require.NoError(t, err)
+	}
+	return rm, r, clients
+}
+
+// readyAll 所有玩家准备，开始新的一局
+func readyAll(t *testing.T, rm *RoomManager, clients []*testutil.SimpleClient) {
+	t.Helper()
+	for _, c := range clients {
+		require.NoError(t, rm.SetPlayerReady(c, true))
+	}
+}
+
+func handScores(p1, p2, p3 int) []protocol.PlayerScore {
+	return []protocol.PlayerScore{
+		{PlayerID: "p1", PlayerName: "Player1", Score: p1},
+		{PlayerID: "p2", PlayerName: "Player2", Score: p2},
+		{PlayerID: "p3", PlayerName: "Player3", Score: p3},
+	}
+}
+
+func TestRoom_FinishHand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("单局不产生排名", func(t *testing.T) {
+		t.Parallel()
+		r := NewMockRoom("123456", nil)
+		assert.Nil(t, r.FinishHand(handScores(4, -2, -2)))
+	})
+
+	t.Run("系列赛累计得分直到打满局数", func(t *testing.T) {
+		t.Parallel()
+		rm, r, clients := newSeriesRoom(t, 2)
+
+		readyAll(t, rm, clients)
+		assert.Equal(t, 1, r.HandNo)
+		standings := r.FinishHand(handScores(4, -2, -2))
+		require.NotNil(t, standings)
+		assert.False(t, standings.Final)
+		assert.Equal(t, RoomStateWaiting, r.State, "局间回到等待状态")
+		for _, p := range r.Players {
+			assert.False(t, p.Ready, "下一局需要重新准备")
+		}
+
+		readyAll(t, rm, clients)
+		assert.Equal(t, 2, r.HandNo)
+		standings = r.FinishHand(handScores(-4, -4, 8))
+		require.NotNil(t, standings)
+		assert.True(t, standings.Final)
+		assert.Equal(t, 2, standings.Hand)
+		assert.Equal(t, []protocol.SeriesStanding{
+			{PlayerID: "p3", PlayerName: "Player3", Total: 6, Last: 8, Wins: 1},
+			{PlayerID: "p1", PlayerName: "Player1", Total: 0, Last: -4, Wins: 1},
+			{PlayerID: "p2", PlayerName: "Player2", Total: -6, Last: -4},
+		}, standings.Standings)
+	})
+
+	t.Run("局间有人离开时系列赛结束", func(t *testing.T) {
+		t.Parallel()
+		rm, r, clients := newSeriesRoom(t, 5)
+
+		readyAll(t, rm, clients)
+		r.FinishHand(handScores(4, -2, -2))
+		rm.LeaveRoom(clients[1])
+
+		assert.Nil(t, rm.GetRoom(r.Code), "房间随系列赛结束解散")
+		msgs := clients[0].SentMessages()
+		last := msgs[len(msgs)-1]
+		assert.Equal(t, protocol.MsgSeriesStandings, last.Type)
+		for _, c := range clients {
+			assert.Empty(t, c.GetRoom())
+		}
+	})
+}
+
+func TestRoom_DetachTournament(t *testing.T) {
+	t.Parallel()
+
+	rm, r, clients := newSeriesRoom(t, 3)
+	r.SetTournament("t1")
+	readyAll(t, rm, clients)
+	require.NotNil(t, r.FinishHand(handScores(4, -2, -2)))
+	readyAll(t, rm, clients)
+
+	// 模拟服务重启：锦标赛标记随房间数据恢复，恢复后改为普通对局，打完第 2 局即结束
+	restored := NewRoomManager(nil, config.GameConfig{RoomTimeout: 10}).RestoreRoom(r.ToRoomData())
+	assert.Equal(t, "t1", restored.DetachTournament())
+	assert.Empty(t, restored.DetachTournament())
+	assert.Empty(t, restored.ToRoomData().Tournament)
+
+	standings := restored.FinishHand(handScores(-4, -4, 8))
+	require.NotNil(t, standings)
+	assert.True(t, standings.Final)
+
+	assert.Empty(t, NewMockRoom("123456", nil).DetachTournament(), "普通房间不受影响")
+}
@@ -0,0 +1,80 @@
+package room
+
+import (
+	"log"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// AddSpectator 添加观战者
+func (r *Room) AddSpectator(client types.ClientInterface) {
+	r.spectatorMu.Lock()
+	defer r.spectatorMu.Unlock()
+	if r.spectators == nil {
+		r.spectators = make(map[string]types.ClientInterface)
+	}
+	r.spectators[client.GetID()] = client
+}
+
+// RemoveSpectator 移除观战者；同一 ID 已换成新连接时不处理，返回是否移除
+func (r *Room) RemoveSpectator(client types.ClientInterface) bool {
+	r.spectatorMu.Lock()
+	defer r.spectatorMu.Unlock()
+	if r.spectators[client.GetID()] != client {
+		return false
+	}
+	delete(r.spectators, client.GetID())
+	return true
+}
+
+// SpectatorCount 返回观战人数
+func (r *Room) SpectatorCount() int {
+	r.spectatorMu.RLock()
+	defer r.spectatorMu.RUnlock()
+	return len(r.spectators)
+}
+
+// SpectateRoom 观战 code 房间中进行中的对局，已在观战其他房间时先退出
+func (rm *RoomManager) SpectateRoom(client types.ClientInterface, code string) (*Room, error) {
+	rm.StopSpectating(client)
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	room, exists := rm.rooms[code]
+	if !exists {
+		return nil, apperrors.ErrRoomNotFound
+	}
+
+	room.mu.RLock()
+	state := room.State
+	room.mu.RUnlock()
+	if state == RoomStateWaiting || state == RoomStateEnded {
+		return nil, apperrors.ErrGameNotStart
+	}
+
+	room.AddSpectator(client)
+	rm.spectating[client.GetID()] = code
+
+	log.Printf("👀 玩家 %s 开始观战房间 %s", client.GetName(), code)
+
+	return room, nil
+}
+
+// StopSpectating 退出观战，返回之前观战的房间；未在观战时返回 nil
+func (rm *RoomManager) StopSpectating(client types.ClientInterface) *Room {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	code, ok := rm.spectating[client.GetID()]
+	if !ok {
+		return nil
+	}
+	room := rm.rooms[code]
+	if room != nil && !room.RemoveSpectator(client) {
+		return nil
+	}
+	delete(rm.spectating, client.GetID())
+	return room
+}
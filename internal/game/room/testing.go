@@ -17,8 +17,8 @@ func (m *MockRoomManager) LeaveRoom(client types.ClientInterface) {
 	m.Called(client)
 }
 
-func (m *MockRoomManager) CreateRoom(client types.ClientInterface) (any, error) {
-	args := m.Called(client)
+func (m *MockRoomManager) CreateRoom(client types.ClientInterface, opts RoomOptions) (any, error) {
+	args := m.Called(client, opts)
 	return args.Get(0), args.Error(1)
 }
 
@@ -57,8 +57,8 @@ type MockMatcher struct {
 	mock.Mock
 }
 
-func (m *MockMatcher) AddToQueue(client types.ClientInterface) {
-	m.Called(client)
+func (m *MockMatcher) AddToQueue(client types.ClientInterface, mode string) {
+	m.Called(client, mode)
 }
 
 // NewMockRoom 创建测试用的 Room
@@ -70,6 +70,8 @@ func NewMockRoom(code string, client types.ClientInterface) *Room {
 	if client != nil {
 		room.Players[client.GetID()] = &RoomPlayer{
 			Client: client,
+			Name:   client.GetName(),
+			IsBot:  client.IsBot(),
 			Seat:   0,
 			Ready:  false,
 		}
@@ -29,6 +29,8 @@ func FindSmallestBeatingCards(playerHand []card.Card, opponentHand ParsedHand) [
 		result = findSmallestBeatingTrio(playerHand, analysis, opponentHand, 1)
 	case TrioWithPair:
 		result = findSmallestBeatingTrio(playerHand, analysis, opponentHand, 2)
+	case Straight, PairStraight, Plane, PlaneWithSingles, PlaneWithPairs, FourWithTwo, FourWithTwoPairs:
+		result = findSmallestBeatingSameType(playerHand, opponentHand)
 	}
 
 	// 如果找到了同类型的牌，返回
@@ -94,6 +96,16 @@ func findSmallestBeatingTrio(playerHand []card.Card, analysis HandAnalysis, oppo
 	return nil
 }
 
+// findSmallestBeatingSameType 从完整走法中找出同牌型里最小的一手（顺子、连对、飞机、四带二等）
+func findSmallestBeatingSameType(playerHand []card.Card, opponentHand ParsedHand) []card.Card {
+	for _, m := range GenerateMoves(playerHand, opponentHand) {
+		if m.Type == opponentHand.Type {
+			return m.Cards
+		}
+	}
+	return nil
+}
+
 // findSmallestBomb 找到最小的炸弹
 func findSmallestBomb(playerHand []card.Card, analysis HandAnalysis, opponentHand ParsedHand) []card.Card {
 	for _, r := range analysis.fours {
@@ -196,6 +196,20 @@ func TestFindSmallestBeatingCards(t *testing.T) {
 				{Rank: card.RankRedJoker, Suit: card.Joker},
 			},
 		},
+		{
+			name:         "Straight: Beat 34567 with smallest larger straight",
+			playerHand:   testRuleCards(card.Rank9, card.Rank8, card.Rank7, card.Rank6, card.Rank5, card.Rank4),
+			opponentHand: testRuleCards(card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank7),
+			expected:     testRuleCards(card.Rank4, card.Rank5, card.Rank6, card.Rank7, card.Rank8),
+		},
+		{
+			name: "Plane: Beat 333444 with 555666",
+			playerHand: testRuleCards(
+				card.Rank6, card.Rank6, card.Rank6, card.Rank5, card.Rank5, card.Rank5, card.Rank3,
+			),
+			opponentHand: testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4),
+			expected:     testRuleCards(card.Rank5, card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank6),
+		},
 		{
 			name: "New Round: Play smallest single",
 			playerHand: []card.Card{
@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// BottomPattern 底牌牌型，部分玩法据此给底牌翻倍
+type BottomPattern int
+
+const (
+	BottomNone     BottomPattern = iota
+	BottomJoker                  // 含王
+	BottomPair                   // 含对子（或三条）
+	BottomStraight               // 三张点数相连，不含 2 和王
+	BottomFlush                  // 三张同花色
+)
+
+// bottomPatternNames 底牌牌型名称映射表
+var bottomPatternNames = map[BottomPattern]string{
+	BottomJoker:    "含王",
+	BottomPair:     "对子",
+	BottomStraight: "顺子",
+	BottomFlush:    "同花",
+}
+
+// String 返回底牌牌型的中文名称
+func (p BottomPattern) String() string {
+	return bottomPatternNames[p]
+}
+
+// bottomSize 底牌翻倍只针对三张底牌的玩法
+const bottomSize = 3
+
+// BottomPatterns 返回底牌满足的所有牌型。只识别三张底牌，
+// 其他张数（如四人玩法的 8 张底牌）对子几乎必然出现，不参与翻倍，返回 nil。
+func BottomPatterns(cards []card.Card) []BottomPattern {
+	if len(cards) != bottomSize {
+		return nil
+	}
+
+	var patterns []BottomPattern
+	if slices.ContainsFunc(cards, func(c card.Card) bool { return c.Suit == card.Joker }) {
+		patterns = append(patterns, BottomJoker)
+	}
+
+	ranks := make([]card.Rank, len(cards))
+	for i, c := range cards {
+		ranks[i] = c.Rank
+	}
+	slices.Sort(ranks)
+	if len(slices.Compact(slices.Clone(ranks))) < len(ranks) {
+		patterns = append(patterns, BottomPair)
+	}
+	if isContinuous(ranks) {
+		patterns = append(patterns, BottomStraight)
+	}
+
+	suit := cards[0].Suit
+	if suit != card.Joker && !slices.ContainsFunc(cards, func(c card.Card) bool { return c.Suit != suit }) {
+		patterns = append(patterns, BottomFlush)
+	}
+	return patterns
+}
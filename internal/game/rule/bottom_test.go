@@ -0,0 +1,39 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+func TestBottomPatterns(t *testing.T) {
+	t.Parallel()
+
+	c := func(s card.Suit, r card.Rank) card.Card { return card.Card{Suit: s, Rank: r} }
+	redJoker := c(card.Joker, card.RankRedJoker)
+
+	testCases := []struct {
+		name  string
+		cards []card.Card
+		want  []BottomPattern
+	}{
+		{"杂牌", []card.Card{c(card.Spade, card.Rank3), c(card.Heart, card.Rank9), c(card.Club, card.RankK)}, nil},
+		{"含王", []card.Card{redJoker, c(card.Heart, card.Rank9), c(card.Club, card.RankK)}, []BottomPattern{BottomJoker}},
+		{"对子", []card.Card{c(card.Spade, card.Rank9), c(card.Heart, card.Rank9), c(card.Club, card.RankK)}, []BottomPattern{BottomPair}},
+		{"双王不算对子", []card.Card{redJoker, c(card.Joker, card.RankBlackJoker), c(card.Club, card.RankK)}, []BottomPattern{BottomJoker}},
+		{"顺子", []card.Card{c(card.Spade, card.RankQ), c(card.Heart, card.RankA), c(card.Club, card.RankK)}, []BottomPattern{BottomStraight}},
+		{"带 2 不算顺子", []card.Card{c(card.Spade, card.RankK), c(card.Heart, card.RankA), c(card.Club, card.Rank2)}, nil},
+		{"同花", []card.Card{c(card.Heart, card.Rank3), c(card.Heart, card.Rank9), c(card.Heart, card.RankK)}, []BottomPattern{BottomFlush}},
+		{"同花顺", []card.Card{c(card.Club, card.Rank5), c(card.Club, card.Rank6), c(card.Club, card.Rank7)}, []BottomPattern{BottomStraight, BottomFlush}},
+		{"非三张底牌不参与", []card.Card{c(card.Spade, card.Rank9), c(card.Heart, card.Rank9)}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, BottomPatterns(tc.cards))
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package rule
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// rankGroup 一手牌中某个点数取几张
+type rankGroup struct {
+	rank  card.Rank
+	count int
+}
+
+// moveGenerator 按点数枚举手牌中所有合法出牌。
+// 癞子玩法中癞子单独放在一边，某个点数的牌不够时用癞子补足（只能补 lowest 到 2），不再逐一枚举癞子的替代方式
+type moveGenerator struct {
+	hand     []card.Card  // 不含癞子的手牌
+	analysis HandAnalysis // hand 的点数统计
+	wild     card.Rank    // 癞子点数，0 表示不使用癞子
+	lowest   card.Rank    // 癞子可补的最小点数
+	wilds    []card.Card  // 手中的癞子
+	moves    []ParsedHand
+	seen     map[string]bool // 用到癞子时按牌型、点数、长度、软硬与所用原牌点数去重
+}
+
+// newMoveGenerator 按癞子点数把手牌分成普通牌与癞子，wild 为 0 表示不使用癞子；
+// lowest 为本玩法牌中最小的点数，癞子不会补成更小的点数
+func newMoveGenerator(hand []card.Card, wild, lowest card.Rank) *moveGenerator {
+	g := &moveGenerator{wild: wild, lowest: lowest}
+	for _, c := range hand {
+		if c.IsWild(wild) {
+			g.wilds = append(g.wilds, c)
+		} else {
+			g.hand = append(g.hand, c)
+		}
+	}
+	g.analysis = analyzeCards(g.hand)
+	if len(g.wilds) > 0 {
+		g.seen = make(map[string]bool)
+	}
+	return g
+}
+
+// GenerateMoves 列出手牌中所有能压过 last 的合法出牌；last 为空（新一轮）时列出全部合法出牌。
+// 结果按牌型（HandType 的定义顺序）分组，组内按关键点数、长度从小到大排列；
+// 只按点数组合区分，花色不同但点数相同的出法只列一次。每一手都经 ParseHand 复核，与服务端判定一致。
+func GenerateMoves(hand []card.Card, last ParsedHand) []ParsedHand {
+	if len(hand) == 0 {
+		return nil
+	}
+
+	g := newMoveGenerator(hand, 0, card.Rank3)
+	if last.IsEmpty() {
+		g.generate()
+		return g.moves
+	}
+	g.generate(last.Type, Bomb, Rocket)
+
+	var result []ParsedHand
+	for _, m := range g.moves {
+		if CanBeat(m, last) {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// generate 按牌型顺序依次生成，types 为空时生成全部牌型，否则只生成其中的牌型（压上家时只需同牌型与炸弹、王炸）
+func (g *moveGenerator) generate(types ...HandType) {
+	for t := Single; t <= Rocket; t++ {
+		if len(types) == 0 || slices.Contains(types, t) {
+			g.generateType(t)
+		}
+	}
+}
+
+// generateType 生成一种牌型的全部出牌
+func (g *moveGenerator) generateType(t HandType) {
+	switch t {
+	case Single:
+		g.genSimple(Single, 1)
+	case Pair:
+		g.genSimple(Pair, 2)
+	case Trio:
+		g.genSimple(Trio, 3)
+	case TrioWithSingle:
+		g.genTrioWithKickers(TrioWithSingle, 1)
+	case TrioWithPair:
+		g.genTrioWithKickers(TrioWithPair, 2)
+	case Straight:
+		g.genChains(Straight, 1, 5)
+	case PairStraight:
+		g.genChains(PairStraight, 2, 3)
+	case Plane:
+		g.genChains(Plane, 3, 2)
+	case PlaneWithSingles:
+		g.genPlaneWithKickers(PlaneWithSingles, 1)
+	case PlaneWithPairs:
+		g.genPlaneWithKickers(PlaneWithPairs, 2)
+	case Bomb:
+		g.genSimple(Bomb, 4)
+	case FourWithTwo:
+		g.genFourWithTwo()
+	case FourWithTwoPairs:
+		g.genFourWithTwoPairs()
+	case Rocket:
+		g.genRocket()
+	}
+}
+
+// add 按点数取牌组成一手，并以 ParseHand 的结果为准：解析出的牌型与预期不符则丢弃
+func (g *moveGenerator) add(handType HandType, groups ...rankGroup) {
+	cards, played, ok := g.take(groups)
+	if !ok {
+		return
+	}
+	parsed, err := ParseHand(played)
+	if err != nil || parsed.Type != handType {
+		return
+	}
+	g.push(parsed, cards, played)
+}
+
+// take 按点数取牌，某个点数不够时用癞子补足。
+// 返回手中的原牌与癞子换成所补点数后的牌（两者一一对应），癞子不够或要补的是王、本玩法没有的点数时 ok 为 false
+func (g *moveGenerator) take(groups []rankGroup) (cards, played []card.Card, ok bool) {
+	used := 0
+	for _, grp := range groups {
+		natural := findCardsWithRank(g.hand, grp.rank, grp.count)
+		cards = append(cards, natural...)
+		played = append(played, natural...)
+
+		short := grp.count - len(natural)
+		if short == 0 {
+			continue
+		}
+		if grp.rank > card.Rank2 || grp.rank < g.lowest || used+short > len(g.wilds) {
+			return nil, nil, false
+		}
+		for _, w := range g.wilds[used : used+short] {
+			cards = append(cards, w)
+			w.Rank = grp.rank
+			played = append(played, w)
+		}
+		used += short
+	}
+	return cards, played, true
+}
+
+// push 记录一手出牌，Cards 换回手中的原牌。用到癞子时标出软炸弹，并去掉全由癞子冒充其他点数组成的出法：
+// 全是癞子时按本身点数算（见 Interpretations），这样的出法服务端不会认
+func (g *moveGenerator) push(hand ParsedHand, cards, played []card.Card) {
+	hand.Cards = cards
+	if len(g.wilds) == 0 {
+		g.moves = append(g.moves, hand)
+		return
+	}
+
+	wilds, asOther := 0, 0
+	for i, c := range cards {
+		if c.IsWild(g.wild) {
+			wilds++
+			if played[i].Rank != g.wild {
+				asOther++
+			}
+		}
+	}
+	if wilds == len(cards) && asOther > 0 {
+		return
+	}
+	hand.Soft = hand.Type == Bomb && asOther > 0
+
+	key := fmt.Sprintf("%d/%d/%d/%t/%v", hand.Type, hand.KeyRank, hand.Length, hand.Soft, rankSignature(cards))
+	if g.seen[key] {
+		return
+	}
+	g.seen[key] = true
+	g.moves = append(g.moves, hand)
+}
+
+// count 某个点数可用的张数：癞子可以补足 lowest 到 2 中任意点数
+func (g *moveGenerator) count(r card.Rank) int {
+	if r > card.Rank2 || r < g.lowest {
+		return g.analysis.counts[r]
+	}
+	return g.analysis.counts[r] + len(g.wilds)
+}
+
+// ranksWithAtLeast 返回数量不少于 n 的点数（从小到大），排除 exclude 中的点数
+func (g *moveGenerator) ranksWithAtLeast(n int, exclude ...card.Rank) []card.Rank {
+	var ranks []card.Rank
+	for r := card.Rank3; r <= card.RankRedJoker; r++ {
+		if g.count(r) >= n && !slices.Contains(exclude, r) {
+			ranks = append(ranks, r)
+		}
+	}
+	return ranks
+}
+
+// genSimple 单张、对子、三张、炸弹：同一点数取 n 张
+func (g *moveGenerator) genSimple(handType HandType, n int) {
+	for _, r := range g.ranksWithAtLeast(n) {
+		g.add(handType, rankGroup{r, n})
+	}
+}
+
+// genTrioWithKickers 三带一 / 三带二
+func (g *moveGenerator) genTrioWithKickers(handType HandType, kickerCount int) {
+	for _, r := range g.ranksWithAtLeast(3) {
+		for _, k := range g.ranksWithAtLeast(kickerCount, r) {
+			g.add(handType, rankGroup{r, 3}, rankGroup{k, kickerCount})
+		}
+	}
+}
+
+// genChains 顺子、连对：每个点数取 n 张、至少 minLen 个连续点数
+func (g *moveGenerator) genChains(handType HandType, n, minLen int) {
+	for _, chain := range g.chains(n, minLen) {
+		g.add(handType, chainGroups(chain, n)...)
+	}
+}
+
+// genPlaneWithKickers 飞机带单、飞机带对
+func (g *moveGenerator) genPlaneWithKickers(handType HandType, kickerCount int) {
+	for _, body := range g.chains(3, 2) {
+		candidates := g.ranksWithAtLeast(kickerCount, body...)
+		forEachCombination(candidates, len(body), func(kickers []card.Rank) {
+			groups := chainGroups(body, 3)
+			for _, k := range kickers {
+				groups = append(groups, rankGroup{k, kickerCount})
+			}
+			g.add(handType, groups...)
+		})
+	}
+}
+
+// genFourWithTwo 四带二：两张不同的单牌与一个对子都算，按最小带牌点数排列
+func (g *moveGenerator) genFourWithTwo() {
+	for _, r := range g.ranksWithAtLeast(4) {
+		singles := g.ranksWithAtLeast(1, r)
+		pairs := g.ranksWithAtLeast(2, r)
+		for i, a := range singles {
+			if slices.Contains(pairs, a) {
+				g.add(FourWithTwo, rankGroup{r, 4}, rankGroup{a, 2})
+			}
+			for _, b := range singles[i+1:] {
+				g.add(FourWithTwo, rankGroup{r, 4}, rankGroup{a, 1}, rankGroup{b, 1})
+			}
+		}
+	}
+}
+
+// genFourWithTwoPairs 四带两对
+func (g *moveGenerator) genFourWithTwoPairs() {
+	for _, r := range g.ranksWithAtLeast(4) {
+		forEachCombination(g.ranksWithAtLeast(2, r), 2, func(kickers []card.Rank) {
+			g.add(FourWithTwoPairs, rankGroup{r, 4}, rankGroup{kickers[0], 2}, rankGroup{kickers[1], 2})
+		})
+	}
+}
+
+// genRocket 王炸
+func (g *moveGenerator) genRocket() {
+	if hasRocket(g.analysis) {
+		g.add(Rocket, rankGroup{card.RankBlackJoker, 1}, rankGroup{card.RankRedJoker, 1})
+	}
+}
+
+// chains 找出所有每个点数至少 n 张、长度不少于 minLen 的连续点数序列（不含 2 和王），
+// 按起始点数、再按长度从小到大排列
+func (g *moveGenerator) chains(n, minLen int) [][]card.Rank {
+	var result [][]card.Rank
+	for start := card.Rank3; start < card.Rank2; start++ {
+		for end := start; end < card.Rank2 && g.count(end) >= n; end++ {
+			if int(end-start)+1 >= minLen {
+				chain := make([]card.Rank, 0, int(end-start)+1)
+				for r := start; r <= end; r++ {
+					chain = append(chain, r)
+				}
+				result = append(result, chain)
+			}
+		}
+	}
+	return result
+}
+
+// chainGroups 连续点数序列中每个点数取 n 张
+func chainGroups(chain []card.Rank, n int) []rankGroup {
+	groups := make([]rankGroup, len(chain))
+	for i, r := range chain {
+		groups[i] = rankGroup{r, n}
+	}
+	return groups
+}
+
+// forEachCombination 按字典序枚举 ranks 中取 k 个的所有组合
+func forEachCombination(ranks []card.Rank, k int, fn func([]card.Rank)) {
+	if k <= 0 || k > len(ranks) {
+		return
+	}
+	picked := make([]card.Rank, 0, k)
+	var walk func(start int)
+	walk = func(start int) {
+		if len(picked) == k {
+			fn(picked)
+			return
+		}
+		for i := start; i <= len(ranks)-(k-len(picked)); i++ {
+			picked = append(picked, ranks[i])
+			walk(i + 1)
+			picked = picked[:len(picked)-1]
+		}
+	}
+	walk(0)
+}
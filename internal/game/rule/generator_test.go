@@ -0,0 +1,165 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// countByType 统计走法中各牌型的数量
+func countByType(moves []ParsedHand) map[HandType]int {
+	counts := make(map[HandType]int)
+	for _, m := range moves {
+		counts[m.Type]++
+	}
+	return counts
+}
+
+func TestGenerateMoves_NewRound(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		hand     []card.Card
+		expected map[HandType]int
+	}{
+		{
+			name:     "empty hand",
+			hand:     nil,
+			expected: map[HandType]int{},
+		},
+		{
+			name:     "singles and a pair",
+			hand:     testRuleCards(card.Rank3, card.Rank3, card.Rank5),
+			expected: map[HandType]int{Single: 2, Pair: 1},
+		},
+		{
+			name: "straight of six yields three straights",
+			hand: testRuleCards(card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank7, card.Rank8),
+			// 34567、345678、45678
+			expected: map[HandType]int{Single: 6, Straight: 3},
+		},
+		{
+			name: "pair straight",
+			hand: testRuleCards(card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank7, card.Rank7),
+			expected: map[HandType]int{
+				Single: 3, Pair: 3, PairStraight: 1,
+			},
+		},
+		{
+			name: "plane with kickers",
+			hand: testRuleCards(
+				card.Rank3, card.Rank3, card.Rank3,
+				card.Rank4, card.Rank4, card.Rank4,
+				card.Rank9, card.Rank9, card.RankJ, card.RankJ,
+			),
+			expected: map[HandType]int{
+				Single: 4, Pair: 4, Trio: 2,
+				TrioWithSingle: 6, TrioWithPair: 6,
+				Plane: 1, PlaneWithSingles: 1, PlaneWithPairs: 1,
+			},
+		},
+		{
+			name: "bomb with kickers and rocket",
+			hand: testRuleCards(
+				card.Rank8, card.Rank8, card.Rank8, card.Rank8,
+				card.Rank5, card.Rank5, card.Rank6, card.Rank6,
+				card.RankBlackJoker, card.RankRedJoker,
+			),
+			expected: map[HandType]int{
+				Single: 5, Pair: 3, Trio: 1,
+				TrioWithSingle: 4, TrioWithPair: 2,
+				Bomb: 1,
+				// 两张单牌 C(4,2)=6，加上 55、66 两个对子
+				FourWithTwo:      8,
+				FourWithTwoPairs: 1,
+				Rocket:           1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			moves := GenerateMoves(tc.hand, ParsedHand{})
+			assert.Equal(t, tc.expected, countByType(moves))
+		})
+	}
+}
+
+func TestGenerateMoves_AllLegal(t *testing.T) {
+	t.Parallel()
+
+	hand := testRuleCards(
+		card.RankRedJoker, card.Rank2, card.Rank2, card.RankA, card.RankK,
+		card.RankQ, card.RankJ, card.RankJ, card.RankJ, card.Rank10,
+		card.Rank10, card.Rank10, card.Rank9, card.Rank7, card.Rank7,
+		card.Rank7, card.Rank7, card.Rank4, card.Rank3, card.Rank3,
+	)
+
+	moves := GenerateMoves(hand, ParsedHand{})
+	require.NotEmpty(t, moves)
+
+	// 每一手都能被 ParseHand 原样识别
+	for _, m := range moves {
+		parsed, err := ParseHand(m.Cards)
+		require.NoError(t, err)
+		assert.Equal(t, m.Type, parsed.Type)
+		assert.Equal(t, m.KeyRank, parsed.KeyRank)
+		assert.Equal(t, m.Length, parsed.Length)
+	}
+
+	counts := countByType(moves)
+	for _, ht := range []HandType{Straight, Plane, PlaneWithSingles, PlaneWithPairs, Bomb, FourWithTwo, FourWithTwoPairs} {
+		assert.Positive(t, counts[ht], "应生成 %s", ht)
+	}
+}
+
+func TestGenerateMoves_BeatLast(t *testing.T) {
+	t.Parallel()
+
+	hand := testRuleCards(
+		card.Rank9, card.Rank10, card.RankJ, card.RankQ, card.RankK, card.RankA,
+		card.Rank5, card.Rank5, card.Rank5, card.Rank5,
+	)
+
+	testCases := []struct {
+		name     string
+		last     []card.Card
+		expected map[HandType]int
+	}{
+		{
+			name: "beat a straight with larger straights or a bomb",
+			last: testRuleCards(card.Rank7, card.Rank8, card.Rank9, card.Rank10, card.RankJ),
+			// 9-K、10-A，外加炸弹
+			expected: map[HandType]int{Straight: 2, Bomb: 1},
+		},
+		{
+			name:     "longer straight cannot be beaten by shorter",
+			last:     testRuleCards(card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank7, card.Rank8, card.Rank9),
+			expected: map[HandType]int{Bomb: 1},
+		},
+		{
+			name:     "larger bomb leaves nothing",
+			last:     testRuleCards(card.Rank6, card.Rank6, card.Rank6, card.Rank6),
+			expected: map[HandType]int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			last, err := ParseHand(tc.last)
+			require.NoError(t, err)
+
+			moves := GenerateMoves(hand, last)
+			assert.Equal(t, tc.expected, countByType(moves))
+			for _, m := range moves {
+				assert.True(t, CanBeat(m, last))
+			}
+		})
+	}
+}
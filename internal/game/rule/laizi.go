@@ -0,0 +1,268 @@
+package rule
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// SoftBombName 软炸弹（癞子参与组成的炸弹）在出牌播报中的名称
+const SoftBombName = "软炸弹"
+
+// Name 返回用于展示的牌型名称，软炸弹单独标出
+func (p ParsedHand) Name() string {
+	if p.Type == Bomb && p.Soft {
+		return SoftBombName
+	}
+	return p.Type.String()
+}
+
+// HandReading 一手牌的一种读法。同一组牌的各种读法在牌型、关键点数与软硬上互不相同，
+// 出牌请求、出牌播报与对局日志用它指明是哪一种读法，不依赖用于展示的牌型名称
+type HandReading struct {
+	Type    HandType
+	KeyRank card.Rank
+	Soft    bool
+}
+
+// Reading 返回这手牌的读法
+func (p ParsedHand) Reading() HandReading {
+	return HandReading{Type: p.Type, KeyRank: p.KeyRank, Soft: p.Soft}
+}
+
+// IsEmpty 是否未指定读法
+func (r HandReading) IsEmpty() bool {
+	return r.Type == Invalid
+}
+
+// IsLaizi 是否为癞子玩法
+func (rs RuleSet) IsLaizi() bool {
+	return rs.Wild != 0
+}
+
+// WithWild 返回指定癞子点数的房规副本，wild 为 0 表示不使用癞子
+func (rs RuleSet) WithWild(wild card.Rank) RuleSet {
+	rs.Wild = wild
+	return rs
+}
+
+// lowestRank 癞子可替代的最小点数
+func (rs RuleSet) lowestRank() card.Rank {
+	return max(rs.MinRank, card.Rank3)
+}
+
+// countWild 统计牌中癞子的数量
+func (rs RuleSet) countWild(cards []card.Card) int {
+	if !rs.IsLaizi() {
+		return 0
+	}
+	n := 0
+	for _, c := range cards {
+		if c.IsWild(rs.Wild) {
+			n++
+		}
+	}
+	return n
+}
+
+// Interpretations 列出一手牌在当前房规下的所有合法解释（癞子可替代本玩法牌中 3 到 2 的任意点数）。
+// 结果按 ParseHand 的牌型优先级排列（王炸、硬炸弹、软炸弹、四带、三带、飞机、顺子、连对、单对三），
+// 同类中关键点数大的在前；没有癞子或全是癞子（按本身点数算）时即为 ParseHands 中房规允许的读法。
+func (rs RuleSet) Interpretations(cards []card.Card) []ParsedHand {
+	wilds := rs.countWild(cards)
+	if wilds == 0 || wilds == len(cards) {
+		hands, _ := rs.naturalInterpretations(cards)
+		return slices.DeleteFunc(hands, func(h ParsedHand) bool { return !rs.Allows(h) })
+	}
+
+	var result []ParsedHand
+	forEachWildSubstitution(wilds, rs.lowestRank(), func(ranks []card.Rank) bool {
+		substituted, soft := rs.substitute(cards, ranks)
+		hands, _ := rs.naturalInterpretations(substituted)
+		for _, hand := range hands {
+			if !rs.Allows(hand) {
+				continue
+			}
+			hand.Cards = cards
+			hand.Soft = hand.Type == Bomb && soft
+			result = append(result, hand)
+		}
+		return true
+	})
+	return sortInterpretations(dedupInterpretations(result))
+}
+
+// interpKey 区分不同解释的字段（同一手牌的解释只在这些字段上不同）
+type interpKey struct {
+	handType HandType
+	keyRank  card.Rank
+	length   int
+	soft     bool
+}
+
+// dedupInterpretations 去掉重复的解释，保留先出现的一个
+func dedupInterpretations(hands []ParsedHand) []ParsedHand {
+	seen := make(map[interpKey]bool, len(hands))
+	return slices.DeleteFunc(hands, func(h ParsedHand) bool {
+		key := interpKey{h.Type, h.KeyRank, h.Length, h.Soft}
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return false
+	})
+}
+
+// sortInterpretations 按牌型优先级排列解释，同类中关键点数大的在前
+func sortInterpretations(hands []ParsedHand) []ParsedHand {
+	slices.SortStableFunc(hands, func(a, b ParsedHand) int {
+		if c := cmp.Compare(interpretationPriority(a), interpretationPriority(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.KeyRank, a.KeyRank)
+	})
+	return hands
+}
+
+// interpretationPriority 解释的优先级，与 ParseHand 的检查顺序一致，数值越小越优先
+func interpretationPriority(h ParsedHand) int {
+	switch h.Type {
+	case Rocket:
+		return 0
+	case Bomb:
+		if h.Soft {
+			return 2
+		}
+		return 1
+	case FourWithTwo, FourWithTwoPairs:
+		return 3
+	case TrioWithSingle, TrioWithPair:
+		return 4
+	case Plane, PlaneWithSingles, PlaneWithPairs:
+		return 5
+	case Straight:
+		return 6
+	case PairStraight:
+		return 7
+	default:
+		return 8
+	}
+}
+
+// ParseHandToBeat 在一手牌的所有解释中找出能压过 last 的一种：优先与上家同牌型，其次炸弹/王炸。
+// last 为空（新一轮）时返回优先级最高的解释。
+func (rs RuleSet) ParseHandToBeat(cards []card.Card, last ParsedHand) (ParsedHand, bool) {
+	if last.IsEmpty() {
+		hand, err := rs.ParseHand(cards)
+		return hand, err == nil
+	}
+	return rs.pickToBeat(rs.Interpretations(cards), last)
+}
+
+// ParseHandAs 按出牌者指定的读法解释一手牌，读法为空时同 ParseHandToBeat。
+// 这组牌有该读法时 valid 为 true，ok 表示按该读法能否压过 last。
+func (rs RuleSet) ParseHandAs(cards []card.Card, last ParsedHand, reading HandReading) (hand ParsedHand, valid, ok bool) {
+	if reading.IsEmpty() {
+		_, err := rs.ParseHand(cards)
+		hand, ok = rs.ParseHandToBeat(cards, last)
+		return hand, err == nil, ok
+	}
+	interps := rs.Interpretations(cards)
+	i := slices.IndexFunc(interps, func(h ParsedHand) bool { return h.Reading() == reading })
+	if i < 0 {
+		return ParsedHand{}, false, false
+	}
+	hand = interps[i]
+	return hand, true, last.IsEmpty() || rs.CanBeat(hand, last)
+}
+
+// PlayedHand 按记录的读法还原一手已打出的牌；读法为空或对不上时取优先级最高的解释，
+// 组不成任何牌型时返回 false
+func (rs RuleSet) PlayedHand(cards []card.Card, reading HandReading) (ParsedHand, bool) {
+	interps := rs.Interpretations(cards)
+	if len(interps) == 0 {
+		return ParsedHand{}, false
+	}
+	if i := slices.IndexFunc(interps, func(h ParsedHand) bool { return h.Reading() == reading }); i >= 0 {
+		return interps[i], true
+	}
+	return interps[0], true
+}
+
+// pickToBeat 在 interps 中找能压过 last 的解释，优先与上家同牌型
+func (rs RuleSet) pickToBeat(interps []ParsedHand, last ParsedHand) (ParsedHand, bool) {
+	for _, h := range interps {
+		if h.Type == last.Type && rs.CanBeat(h, last) {
+			return h, true
+		}
+	}
+	for _, h := range interps {
+		if rs.CanBeat(h, last) {
+			return h, true
+		}
+	}
+	return ParsedHand{}, false
+}
+
+// BombDoublings 打出这手牌带来的翻倍次数：炸弹、王炸各翻一倍，癞子玩法中的硬炸弹翻两倍
+func (rs RuleSet) BombDoublings(hand ParsedHand) int {
+	switch hand.Type {
+	case Rocket:
+		return 1
+	case Bomb:
+		if rs.IsLaizi() && !hand.Soft {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// substitute 将 cards 中的癞子依次替换为 ranks 中的点数，返回新切片；
+// soft 表示是否有癞子被当作其他点数使用
+func (rs RuleSet) substitute(cards []card.Card, ranks []card.Rank) (substituted []card.Card, soft bool) {
+	substituted = make([]card.Card, len(cards))
+	i := 0
+	for j, c := range cards {
+		if c.IsWild(rs.Wild) && i < len(ranks) {
+			if ranks[i] != rs.Wild {
+				soft = true
+			}
+			c.Rank = ranks[i]
+			i++
+		}
+		substituted[j] = c
+	}
+	return substituted, soft
+}
+
+// forEachWildSubstitution 枚举 n 张癞子可替代的点数组合（lowest 到 2 的可重复组合，不区分顺序），
+// fn 返回 false 时停止
+func forEachWildSubstitution(n int, lowest card.Rank, fn func([]card.Rank) bool) {
+	ranks := make([]card.Rank, n)
+	var walk func(pos int, from card.Rank) bool
+	walk = func(pos int, from card.Rank) bool {
+		if pos == n {
+			return fn(ranks)
+		}
+		for r := from; r <= card.Rank2; r++ {
+			ranks[pos] = r
+			if !walk(pos+1, r) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(0, lowest)
+}
+
+// rankSignature 按点数排序后的点数序列，用于去重
+func rankSignature(cards []card.Card) []card.Rank {
+	ranks := make([]card.Rank, len(cards))
+	for i, c := range cards {
+		ranks[i] = c.Rank
+	}
+	slices.Sort(ranks)
+	return ranks
+}
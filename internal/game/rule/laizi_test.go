@@ -0,0 +1,320 @@
+package rule
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// laizi 以 7 为癞子的房规
+var laizi = DefaultRuleSet.WithWild(card.Rank7)
+
+func TestRuleSet_ParseHandWithWild(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		cards    []card.Card
+		expected HandType
+		keyRank  card.Rank
+		soft     bool
+	}{
+		{
+			name:     "wild completes a pair",
+			cards:    testRuleCards(card.RankK, card.Rank7),
+			expected: Pair,
+			keyRank:  card.RankK,
+		},
+		{
+			name:     "wild fills a straight gap",
+			cards:    testRuleCards(card.Rank9, card.Rank10, card.Rank7, card.RankQ, card.RankK),
+			expected: Straight,
+			keyRank:  card.Rank9,
+		},
+		{
+			name:     "wild as itself in a straight",
+			cards:    testRuleCards(card.Rank5, card.Rank6, card.Rank7, card.Rank8, card.Rank9),
+			expected: Straight,
+			keyRank:  card.Rank5,
+		},
+		{
+			name:     "soft bomb",
+			cards:    testRuleCards(card.Rank5, card.Rank5, card.Rank5, card.Rank7),
+			expected: Bomb,
+			keyRank:  card.Rank5,
+			soft:     true,
+		},
+		{
+			name:     "four wilds form a hard bomb",
+			cards:    testRuleCards(card.Rank7, card.Rank7, card.Rank7, card.Rank7),
+			expected: Bomb,
+			keyRank:  card.Rank7,
+		},
+		{
+			name:     "wilds played alone keep their own rank",
+			cards:    testRuleCards(card.Rank7, card.Rank7),
+			expected: Pair,
+			keyRank:  card.Rank7,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			hand, err := laizi.ParseHand(tc.cards)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hand.Type)
+			assert.Equal(t, tc.keyRank, hand.KeyRank)
+			assert.Equal(t, tc.soft, hand.Soft)
+			assert.Equal(t, tc.cards, hand.Cards)
+		})
+	}
+
+	_, err := laizi.ParseHand(testRuleCards(card.Rank3, card.Rank7, card.RankK))
+	assert.Error(t, err)
+}
+
+func TestRuleSet_ParseHandToBeat(t *testing.T) {
+	t.Parallel()
+
+	// 5557 默认解释为软炸弹，但面对三带一时应优先按三带一出
+	cards := testRuleCards(card.Rank5, card.Rank5, card.Rank5, card.Rank7)
+	last, err := ParseHand(testRuleCards(card.Rank4, card.Rank4, card.Rank4, card.Rank3))
+	require.NoError(t, err)
+
+	hand, ok := laizi.ParseHandToBeat(cards, last)
+	require.True(t, ok)
+	assert.Equal(t, TrioWithSingle, hand.Type)
+	assert.Equal(t, card.Rank5, hand.KeyRank)
+
+	// 对 K 之上只能当对 A 或对 2
+	last, err = ParseHand(testRuleCards(card.RankK, card.RankK))
+	require.NoError(t, err)
+	hand, ok = laizi.ParseHandToBeat(testRuleCards(card.RankA, card.Rank7), last)
+	require.True(t, ok)
+	assert.Equal(t, card.RankA, hand.KeyRank)
+
+	_, ok = laizi.ParseHandToBeat(testRuleCards(card.Rank3, card.Rank7), last)
+	assert.False(t, ok)
+}
+
+func TestRuleSet_ParseHandAs(t *testing.T) {
+	t.Parallel()
+
+	rs := DefaultRuleSet
+	cards := testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4, card.Rank4)
+
+	// 新一轮：未指定时取四带两对，指定飞机带单则按飞机出
+	hand, valid, ok := rs.ParseHandAs(cards, ParsedHand{}, HandReading{})
+	require.True(t, valid)
+	require.True(t, ok)
+	assert.Equal(t, FourWithTwoPairs, hand.Type)
+
+	hand, valid, ok = rs.ParseHandAs(cards, ParsedHand{}, HandReading{Type: PlaneWithSingles, KeyRank: card.Rank3})
+	require.True(t, valid)
+	require.True(t, ok)
+	assert.Equal(t, PlaneWithSingles, hand.Type)
+	assert.Equal(t, card.Rank3, hand.KeyRank)
+
+	// 同一牌型按关键点数区分：3333 带 4444 与 4444 带 3333 是两种读法
+	hand, valid, ok = rs.ParseHandAs(cards, ParsedHand{}, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank3})
+	require.True(t, valid)
+	require.True(t, ok)
+	assert.Equal(t, card.Rank3, hand.KeyRank)
+
+	// 不存在的读法无效
+	_, valid, _ = rs.ParseHandAs(cards, ParsedHand{}, HandReading{Type: Straight, KeyRank: card.Rank3})
+	assert.False(t, valid)
+	_, valid, _ = rs.ParseHandAs(cards, ParsedHand{}, HandReading{Type: PlaneWithSingles, KeyRank: card.Rank4})
+	assert.False(t, valid)
+
+	// 压上家：指定的牌型压不过时不会换成其他读法
+	last, err := ParseHand(testRuleCards(card.Rank5, card.Rank5, card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank7, card.Rank7))
+	require.NoError(t, err)
+	_, valid, ok = rs.ParseHandAs(cards, last, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank4})
+	assert.True(t, valid)
+	assert.False(t, ok)
+
+	// 未指定时自动找能压过上家的读法：4 带 33 压过 3 带 55、66
+	last, err = ParseHand(testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3, card.Rank5, card.Rank5, card.Rank6, card.Rank6))
+	require.NoError(t, err)
+	hand, _, ok = rs.ParseHandAs(cards, last, HandReading{})
+	require.True(t, ok)
+	assert.Equal(t, FourWithTwoPairs, hand.Type)
+	assert.Equal(t, card.Rank4, hand.KeyRank)
+
+	// 指定的读法压不过时不会换成同牌型的另一种读法
+	_, valid, ok = rs.ParseHandAs(cards, last, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank3})
+	assert.True(t, valid)
+	assert.False(t, ok)
+}
+
+func TestRuleSet_PlayedHand(t *testing.T) {
+	t.Parallel()
+
+	rs := DefaultRuleSet
+	cards := testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4, card.Rank4)
+
+	hand, ok := rs.PlayedHand(cards, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank3})
+	require.True(t, ok)
+	assert.Equal(t, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank3}, hand.Reading())
+
+	// 未记录读法时取优先级最高的解释
+	hand, ok = rs.PlayedHand(cards, HandReading{})
+	require.True(t, ok)
+	assert.Equal(t, HandReading{Type: FourWithTwoPairs, KeyRank: card.Rank4}, hand.Reading())
+
+	_, ok = rs.PlayedHand(testRuleCards(card.Rank3, card.Rank5), HandReading{})
+	assert.False(t, ok)
+}
+
+func TestRuleSet_CanBeatBombsWithWild(t *testing.T) {
+	t.Parallel()
+
+	soft9, err := laizi.ParseHand(testRuleCards(card.Rank9, card.Rank9, card.Rank9, card.Rank7))
+	require.NoError(t, err)
+	softK, err := laizi.ParseHand(testRuleCards(card.RankK, card.RankK, card.Rank7, card.Rank7))
+	require.NoError(t, err)
+	hard3, err := laizi.ParseHand(testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3))
+	require.NoError(t, err)
+	hard4, err := laizi.ParseHand(testRuleCards(card.Rank4, card.Rank4, card.Rank4, card.Rank4))
+	require.NoError(t, err)
+
+	assert.True(t, laizi.CanBeat(hard3, soft9), "硬炸弹大过软炸弹")
+	assert.False(t, laizi.CanBeat(softK, hard3), "软炸弹压不过硬炸弹")
+	assert.True(t, laizi.CanBeat(softK, soft9))
+	assert.True(t, laizi.CanBeat(hard4, hard3))
+
+	assert.Equal(t, 1, laizi.BombDoublings(soft9))
+	assert.Equal(t, 2, laizi.BombDoublings(hard3))
+	assert.Equal(t, 1, DefaultRuleSet.BombDoublings(hard3))
+	assert.Equal(t, SoftBombName, soft9.Name())
+	assert.Equal(t, Bomb.String(), hard3.Name())
+}
+
+func TestRuleSet_GenerateMovesWithWild(t *testing.T) {
+	t.Parallel()
+
+	hand := testRuleCards(card.Rank9, card.Rank9, card.Rank9, card.Rank7, card.RankA)
+
+	last, err := ParseHand(testRuleCards(card.Rank8, card.Rank8, card.Rank8, card.Rank8))
+	require.NoError(t, err)
+	moves := laizi.GenerateMoves(hand, last)
+	assert.Empty(t, moves, "软炸弹压不过硬炸弹")
+	assert.False(t, laizi.CanBeatWithHand(hand, last))
+
+	last, err = ParseHand(testRuleCards(card.RankK, card.RankK))
+	require.NoError(t, err)
+	moves = laizi.GenerateMoves(hand, last)
+	require.NotEmpty(t, moves)
+	assert.Equal(t, Pair, moves[0].Type)
+	for _, m := range moves {
+		assert.True(t, laizi.CanBeat(m, last))
+	}
+	assert.True(t, laizi.CanBeatWithHand(hand, last))
+
+	// 提示优先同牌型：A + 癞子当对 A，而不是拆软炸弹
+	hint := laizi.FindSmallestBeatingCards(hand, last)
+	parsed, ok := laizi.ParseHandToBeat(hint, last)
+	require.True(t, ok)
+	assert.Equal(t, Pair, parsed.Type)
+	assert.Equal(t, card.RankA, parsed.KeyRank)
+}
+
+func TestRuleSet_GenerateMovesWithFourWilds(t *testing.T) {
+	t.Parallel()
+
+	hand := testRuleCards(
+		card.Rank7, card.Rank7, card.Rank7, card.Rank7,
+		card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank8, card.Rank9,
+		card.Rank10, card.RankJ, card.RankQ, card.RankK, card.RankA,
+		card.Rank2, card.Rank2, card.RankBlackJoker, card.RankRedJoker, card.Rank3,
+	)
+
+	start := time.Now()
+	moves := laizi.GenerateMoves(hand, ParsedHand{})
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	counts := countByType(moves)
+	for _, ht := range []HandType{Straight, PairStraight, Plane, Bomb, Rocket} {
+		assert.Positive(t, counts[ht], "应生成 %s", ht)
+	}
+}
+
+func TestRuleSet_GenerateMovesWildsAlone(t *testing.T) {
+	t.Parallel()
+
+	// 全是癞子时按本身点数算：77 只是对 7，不能当对 A 压对 K
+	hand := testRuleCards(card.Rank7, card.Rank7, card.Rank3)
+	last, err := ParseHand(testRuleCards(card.RankK, card.RankK))
+	require.NoError(t, err)
+	assert.Empty(t, laizi.GenerateMoves(hand, last))
+	assert.False(t, laizi.CanBeatWithHand(hand, last))
+	assert.Nil(t, laizi.FindSmallestBeatingCards(hand, last))
+
+	// 提示的每一手都能按同样的牌型与点数打出
+	moves := laizi.GenerateMoves(hand, ParsedHand{})
+	require.NotEmpty(t, moves)
+	for _, m := range moves {
+		assert.True(t, slices.ContainsFunc(laizi.Interpretations(m.Cards), func(h ParsedHand) bool {
+			return h.Type == m.Type && h.KeyRank == m.KeyRank && h.Length == m.Length && h.Soft == m.Soft
+		}), "%s %v", m.Name(), m.Cards)
+	}
+}
+
+func TestRuleSet_GenerateMovesWithWildTwoDecks(t *testing.T) {
+	t.Parallel()
+
+	rs := RuleSet{TwoDecks: true}.WithWild(card.Rank7)
+	hand := testRuleCards(card.Rank7, card.Rank7, card.Rank7, card.Rank9, card.Rank9, card.Rank9, card.Rank9, card.Rank3)
+
+	moves := rs.GenerateMoves(hand, ParsedHand{})
+	var bombs []ParsedHand
+	for _, m := range moves {
+		if m.Type == Bomb && m.KeyRank == card.Rank9 {
+			bombs = append(bombs, m)
+		}
+	}
+	// 四张 9 为硬炸弹，补上癞子为 5~7 张的软炸弹
+	require.Len(t, bombs, 4)
+	assert.False(t, bombs[0].Soft)
+	for i, b := range bombs[1:] {
+		assert.True(t, b.Soft)
+		assert.Equal(t, 5+i, b.Length)
+	}
+
+	last, err := rs.ParseHand(testRuleCards(card.Rank8, card.Rank8, card.Rank8, card.Rank8, card.Rank7))
+	require.NoError(t, err)
+	require.True(t, last.Soft)
+	assert.True(t, rs.CanBeatWithHand(hand, last), "6 张软炸弹压过 5 张软炸弹")
+
+	last, err = rs.ParseHand(testRuleCards(card.Rank8, card.Rank8, card.Rank8, card.Rank8, card.Rank8))
+	require.NoError(t, err)
+	assert.False(t, rs.CanBeatWithHand(hand, last), "软炸弹压不过硬炸弹")
+}
+
+func TestRuleSet_WildSkipsRemovedRanks(t *testing.T) {
+	t.Parallel()
+
+	// 二人玩法去掉 3 和 4：癞子 10 配 5678 只能当 9 组成 5~9 的顺子，不能当 4
+	rs := RuleSet{MinRank: card.Rank5}.WithWild(card.Rank10)
+	cards := testRuleCards(card.Rank5, card.Rank6, card.Rank7, card.Rank8, card.Rank10)
+
+	classic := DefaultRuleSet.WithWild(card.Rank10).Interpretations(cards)
+	require.Len(t, classic, 2)
+	interps := rs.Interpretations(cards)
+	require.Len(t, interps, 1)
+	assert.Equal(t, Straight, interps[0].Type)
+	assert.Equal(t, card.Rank5, interps[0].KeyRank)
+
+	moves := rs.GenerateMoves(cards, ParsedHand{})
+	require.NotEmpty(t, moves)
+	for _, m := range moves {
+		assert.GreaterOrEqual(t, m.KeyRank, card.Rank5, "%s %v", m.Name(), m.Cards)
+	}
+}
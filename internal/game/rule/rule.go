@@ -56,6 +56,7 @@ type ParsedHand struct {
 	KeyRank card.Rank   // 决定大小的关键牌的点数 (例如 3334 中的 3, 或 34567 中的 3)
 	Length  int         // 牌型的长度，主要用于顺子、连对、飞机
 	Cards   []card.Card // 这手牌包含的卡牌
+	Soft    bool        // 癞子玩法中由癞子参与组成的软炸弹
 }
 
 func (p ParsedHand) IsEmpty() bool {
@@ -148,10 +149,20 @@ var handCheckers = map[HandType]handChecker{
 	PlaneWithPairs:   func(a HandAnalysis, h ParsedHand) bool { return findWinningPlane(a, h, 2) },
 }
 
-// ParseHand 解析牌型
+// ParseHand 解析牌型，一手牌有多种读法时取优先级最高的一种（见 ParseHands）
 func ParseHand(cards []card.Card) (ParsedHand, error) {
+	hands, err := ParseHands(cards)
+	if err != nil {
+		return ParsedHand{}, err
+	}
+	return hands[0], nil
+}
+
+// ParseHands 列出一手牌的所有合法读法，按牌型优先级排列，同类中关键点数大的在前。
+// 例如 33334444 既是四带两对（4 带 33、3 带 44），也是飞机带单（333444 带 3、4）。
+func ParseHands(cards []card.Card) ([]ParsedHand, error) {
 	if len(cards) == 0 {
-		return ParsedHand{}, fmt.Errorf("不能出空牌")
+		return nil, fmt.Errorf("不能出空牌")
 	}
 
 	analysis := analyzeCards(cards)
@@ -168,13 +179,20 @@ func ParseHand(cards []card.Card) (ParsedHand, error) {
 		isSimpleType,      // 简单牌型（单、对、三）
 	}
 
+	var hands []ParsedHand
 	for _, check := range checks {
 		if hand, ok := check(analysis, cards); ok {
-			return hand, nil
+			hands = append(hands, hand)
 		}
 	}
+	// 带牌可以拆开重组的其他读法
+	hands = append(hands, fourWithPairsReadings(analysis, cards)...)
+	hands = append(hands, planeReadings(analysis, cards)...)
 
-	return ParsedHand{}, fmt.Errorf("不支持的牌型: %v", cards)
+	if len(hands) == 0 {
+		return nil, fmt.Errorf("不支持的牌型: %v", cards)
+	}
+	return sortInterpretations(dedupInterpretations(hands)), nil
 }
 
 // CanBeat 判断 newHand 是否能大过 lastHand
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
 )
@@ -129,6 +130,63 @@ func TestParseHand(t *testing.T) {
 	}
 }
 
+func TestParseHands(t *testing.T) {
+	t.Parallel()
+
+	type reading struct {
+		handType HandType
+		keyRank  card.Rank
+	}
+	testCases := []struct {
+		name     string
+		cards    []card.Card
+		expected []reading
+	}{
+		{
+			"33334444 four with pairs or plane",
+			testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4, card.Rank4),
+			[]reading{{FourWithTwoPairs, card.Rank4}, {FourWithTwoPairs, card.Rank3}, {PlaneWithSingles, card.Rank3}},
+		},
+		{
+			"Plane with a wing from its own rank",
+			testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4, card.Rank9),
+			[]reading{{PlaneWithSingles, card.Rank3}},
+		},
+		{
+			"Unambiguous hand has one reading",
+			testRuleCards(card.Rank5, card.Rank5, card.Rank5, card.Rank6, card.Rank6, card.Rank6, card.Rank7, card.Rank8),
+			[]reading{{PlaneWithSingles, card.Rank5}},
+		},
+		{
+			"Plane wings must differ",
+			testRuleCards(card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4, card.Rank5, card.Rank5),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			hands, err := ParseHands(tc.cards)
+			if tc.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			got := make([]reading, len(hands))
+			for i, h := range hands {
+				got[i] = reading{h.Type, h.KeyRank}
+			}
+			assert.Equal(t, tc.expected, got)
+
+			// ParseHand 取第一种读法
+			first, err := ParseHand(tc.cards)
+			require.NoError(t, err)
+			assert.Equal(t, hands[0], first)
+		})
+	}
+}
+
 func TestCanBeat(t *testing.T) {
 	t.Parallel()
 
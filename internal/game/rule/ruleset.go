@@ -0,0 +1,183 @@
+package rule
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// RuleSet 房规：在标准牌型规则之上做减法。零值即经典规则，与包级函数（ParseHand、CanBeat 等）行为一致。
+type RuleSet struct {
+	NoFourWithTwo      bool // 禁止四带二（两张单牌或一对）
+	NoFourWithTwoPairs bool // 禁止四带两对
+	NoPlaneWithPairs   bool // 禁止飞机带对
+	BombNoKickers      bool // 炸弹不能带牌：四带二、四带两对均不允许
+	MaxStraightLength  int  // 顺子最大长度，0 表示不限（最长 3 到 A 共 12 张）
+	TwoDecks           bool // 两副牌玩法：炸弹 4~8 张、张数多者大，四张王才算王炸
+
+	Wild    card.Rank // 本局癞子点数，0 表示非癞子玩法；由对局发牌后设置
+	MinRank card.Rank // 本玩法牌中最小的点数，癞子不能替代比它小的点数；0 表示从 3 开始（二人玩法去掉 3 和 4 时为 5）
+}
+
+// 预置房规名称
+const (
+	RuleSetClassic   = "classic"    // 经典规则
+	RuleSetNoKickers = "no_kickers" // 炸弹不带牌、飞机不带对
+	RuleSetShort     = "short"      // 不许四带二，顺子最长 8 张
+)
+
+// DefaultRuleSet 经典规则
+var DefaultRuleSet = RuleSet{}
+
+// ruleSetPresets 预置房规映射表
+var ruleSetPresets = map[string]RuleSet{
+	RuleSetClassic:   DefaultRuleSet,
+	RuleSetNoKickers: {BombNoKickers: true, NoPlaneWithPairs: true},
+	RuleSetShort:     {NoFourWithTwo: true, MaxStraightLength: 8},
+}
+
+// RuleSetByName 按名称查找预置房规，空名称视为经典规则
+func RuleSetByName(name string) (RuleSet, bool) {
+	if name == "" {
+		return DefaultRuleSet, true
+	}
+	rs, ok := ruleSetPresets[name]
+	return rs, ok
+}
+
+// Allows 判断房规是否允许该牌型
+func (rs RuleSet) Allows(hand ParsedHand) bool {
+	switch hand.Type {
+	case FourWithTwo:
+		return !rs.NoFourWithTwo && !rs.BombNoKickers
+	case FourWithTwoPairs:
+		return !rs.NoFourWithTwoPairs && !rs.BombNoKickers
+	case PlaneWithPairs:
+		return !rs.NoPlaneWithPairs
+	case Straight:
+		return rs.MaxStraightLength <= 0 || hand.Length <= rs.MaxStraightLength
+	}
+	return true
+}
+
+// ParseHand 按房规解析牌型，房规不允许的牌型返回错误。
+// 有多种读法时取房规允许的优先级最高的一种（见 Interpretations）。
+func (rs RuleSet) ParseHand(cards []card.Card) (ParsedHand, error) {
+	if rs.countWild(cards) > 0 {
+		if interps := rs.Interpretations(cards); len(interps) > 0 {
+			return interps[0], nil
+		}
+		return ParsedHand{}, fmt.Errorf("不支持的牌型: %v", cards)
+	}
+
+	hands, err := rs.naturalInterpretations(cards)
+	if err != nil {
+		return ParsedHand{}, err
+	}
+	for _, hand := range hands {
+		if rs.Allows(hand) {
+			return hand, nil
+		}
+	}
+	if hands[0].Type == Straight {
+		return ParsedHand{}, fmt.Errorf("当前规则顺子最多 %d 张", rs.MaxStraightLength)
+	}
+	return ParsedHand{}, fmt.Errorf("当前规则不允许%s", hands[0].Type)
+}
+
+// CanBeat 按房规判断 newHand 是否能大过 lastHand。
+// 癞子玩法中硬炸弹大过任意软炸弹；两副牌玩法中张数多的炸弹大；其余情况再比点数。
+func (rs RuleSet) CanBeat(newHand, lastHand ParsedHand) bool {
+	if !rs.Allows(newHand) {
+		return false
+	}
+	if newHand.Type == Bomb && lastHand.Type == Bomb {
+		if rs.IsLaizi() && newHand.Soft != lastHand.Soft {
+			return lastHand.Soft
+		}
+		if rs.TwoDecks && newHand.Length != lastHand.Length {
+			return newHand.Length > lastHand.Length
+		}
+	}
+	return CanBeat(newHand, lastHand)
+}
+
+// GenerateMoves 按房规列出所有能压过 last 的合法出牌。
+// 癞子玩法中手里有癞子时用癞子补足缺的牌，结果按牌型、关键点数、长度排列，同类中少用癞子的在前。
+// 压上家时只生成同牌型与炸弹、王炸，不必列出整手牌的全部出法。
+func (rs RuleSet) GenerateMoves(hand []card.Card, last ParsedHand) []ParsedHand {
+	if rs == DefaultRuleSet {
+		return GenerateMoves(hand, last)
+	}
+
+	var moves []ParsedHand
+	if last.IsEmpty() {
+		moves = rs.candidateMoves(hand)
+	} else {
+		moves = rs.candidateMoves(hand, last.Type, Bomb, Rocket)
+	}
+	// 炸弹大小因房规而异（软硬炸弹、炸弹张数），不能直接用标准规则筛选
+	allowed := moves[:0]
+	for _, m := range moves {
+		if rs.Allows(m) && (last.IsEmpty() || rs.CanBeat(m, last)) {
+			allowed = append(allowed, m)
+		}
+	}
+	if rs.countWild(hand) > 0 {
+		slices.SortStableFunc(allowed, func(a, b ParsedHand) int {
+			return cmp.Or(
+				cmp.Compare(a.Type, b.Type),
+				cmp.Compare(a.KeyRank, b.KeyRank),
+				cmp.Compare(a.Length, b.Length),
+				cmp.Compare(rs.countWild(a.Cards), rs.countWild(b.Cards)),
+			)
+		})
+	}
+	return allowed
+}
+
+// CanBeatWithHand 按房规检查整手牌中是否存在能打过 opponentHand 的组合
+func (rs RuleSet) CanBeatWithHand(playerHand []card.Card, opponentHand ParsedHand) bool {
+	if rs == DefaultRuleSet || opponentHand.IsEmpty() {
+		return CanBeatWithHand(playerHand, opponentHand)
+	}
+	return len(rs.GenerateMoves(playerHand, opponentHand)) > 0
+}
+
+// FindSmallestBeatingCards 按房规找到能打过 opponentHand 的最小牌组，找不到返回 nil。
+// 先按默认策略找（癞子当本身点数），不合房规或需动用炸弹时改从房规允许的出牌中挑：优先同牌型，其次最小的炸弹。
+func (rs RuleSet) FindSmallestBeatingCards(playerHand []card.Card, opponentHand ParsedHand) []card.Card {
+	cards := FindSmallestBeatingCards(playerHand, opponentHand)
+	if rs == DefaultRuleSet {
+		return cards
+	}
+	if cards != nil {
+		// 默认策略动用炸弹时不采纳：房规下炸弹大小不同，交给下面挑最小的一手
+		if hand, ok := rs.ParseHandToBeat(cards, opponentHand); ok && (opponentHand.IsEmpty() || hand.Type == opponentHand.Type) {
+			return cards
+		}
+	}
+
+	moves := rs.GenerateMoves(playerHand, opponentHand)
+	if len(moves) == 0 {
+		return nil
+	}
+	if opponentHand.IsEmpty() {
+		return moves[0].Cards
+	}
+	for _, m := range moves {
+		if m.Type == opponentHand.Type {
+			return m.Cards
+		}
+	}
+	// 只剩炸弹类可出时取最小的一手
+	weakest := moves[0]
+	for _, m := range moves[1:] {
+		if rs.CanBeat(weakest, m) {
+			weakest = m
+		}
+	}
+	return weakest.Cards
+}
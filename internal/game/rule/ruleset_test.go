@@ -0,0 +1,108 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+func TestRuleSetByName(t *testing.T) {
+	t.Parallel()
+
+	rs, ok := RuleSetByName("")
+	assert.True(t, ok)
+	assert.Equal(t, DefaultRuleSet, rs)
+
+	rs, ok = RuleSetByName(RuleSetNoKickers)
+	assert.True(t, ok)
+	assert.True(t, rs.BombNoKickers)
+
+	_, ok = RuleSetByName("unknown")
+	assert.False(t, ok)
+}
+
+func TestRuleSet_ParseHand(t *testing.T) {
+	t.Parallel()
+
+	fourWithTwo := testRuleCards(card.Rank4, card.Rank4, card.Rank4, card.Rank4, card.Rank5, card.Rank6)
+	fourWithTwoPairs := testRuleCards(card.RankJ, card.RankJ, card.RankJ, card.RankJ, card.RankQ, card.RankQ, card.RankK, card.RankK)
+	planeWithPairs := testRuleCards(
+		card.Rank3, card.Rank3, card.Rank3, card.Rank4, card.Rank4, card.Rank4,
+		card.Rank8, card.Rank8, card.Rank9, card.Rank9,
+	)
+	longStraight := testRuleCards(
+		card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank7,
+		card.Rank8, card.Rank9, card.Rank10, card.RankJ,
+	)
+	bomb := testRuleCards(card.Rank9, card.Rank9, card.Rank9, card.Rank9)
+
+	testCases := []struct {
+		name    string
+		rules   RuleSet
+		cards   []card.Card
+		allowed bool
+	}{
+		{"default allows four with two", DefaultRuleSet, fourWithTwo, true},
+		{"default allows long straight", DefaultRuleSet, longStraight, true},
+		{"no four with two", RuleSet{NoFourWithTwo: true}, fourWithTwo, false},
+		{"no four with two keeps two pairs", RuleSet{NoFourWithTwo: true}, fourWithTwoPairs, true},
+		{"no four with two pairs", RuleSet{NoFourWithTwoPairs: true}, fourWithTwoPairs, false},
+		{"bomb no kickers forbids four with two", RuleSet{BombNoKickers: true}, fourWithTwo, false},
+		{"bomb no kickers forbids four with two pairs", RuleSet{BombNoKickers: true}, fourWithTwoPairs, false},
+		{"bomb no kickers keeps plain bomb", RuleSet{BombNoKickers: true}, bomb, true},
+		{"no plane with pairs", RuleSet{NoPlaneWithPairs: true}, planeWithPairs, false},
+		{"straight over max length", RuleSet{MaxStraightLength: 8}, longStraight, false},
+		{"straight within max length", RuleSet{MaxStraightLength: 9}, longStraight, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tc.rules.ParseHand(tc.cards)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRuleSet_BeatAndAutoplay(t *testing.T) {
+	t.Parallel()
+
+	rules := RuleSet{BombNoKickers: true}
+
+	// 手里只有四条 + 两张单牌：经典规则可出四带二，房规下只能当炸弹出
+	hand := testRuleCards(card.Rank7, card.Rank7, card.Rank7, card.Rank7, card.Rank3, card.Rank5)
+	fourWithTwo, err := ParseHand(hand)
+	require.NoError(t, err)
+	assert.False(t, rules.CanBeat(fourWithTwo, ParsedHand{}))
+
+	for _, m := range rules.GenerateMoves(hand, ParsedHand{}) {
+		assert.NotEqual(t, FourWithTwo, m.Type)
+	}
+
+	// 上家出单张时，房规下仍然可以压（单张或炸弹）
+	single, err := ParseHand(testRuleCards(card.Rank4))
+	require.NoError(t, err)
+	assert.True(t, rules.CanBeatWithHand(hand, single))
+
+	got := rules.FindSmallestBeatingCards(hand, single)
+	require.NotNil(t, got)
+	parsed, err := rules.ParseHand(got)
+	require.NoError(t, err)
+	assert.True(t, rules.CanBeat(parsed, single))
+
+	// 顺子长度上限：上家 9 张顺子不可能出现，手里的长顺子也不会被生成
+	short := RuleSet{MaxStraightLength: 5}
+	straightHand := testRuleCards(card.Rank3, card.Rank4, card.Rank5, card.Rank6, card.Rank7, card.Rank8)
+	for _, m := range short.GenerateMoves(straightHand, ParsedHand{}) {
+		if m.Type == Straight {
+			assert.Equal(t, 5, m.Length)
+		}
+	}
+}
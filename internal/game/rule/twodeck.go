@@ -0,0 +1,96 @@
+package rule
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// 两副牌玩法的炸弹张数范围
+const (
+	minBombSize = 4
+	maxBombSize = 8
+)
+
+// naturalInterpretations 不考虑癞子按房规的牌型规则列出所有读法（不做房规允许与否的筛选）：
+// 两副牌玩法中识别 4~8 张炸弹与四王王炸
+func (rs RuleSet) naturalInterpretations(cards []card.Card) ([]ParsedHand, error) {
+	if !rs.TwoDecks {
+		return ParseHands(cards)
+	}
+	if hand, ok := parseTwoDeckBomb(cards); ok {
+		return []ParsedHand{hand}, nil
+	}
+	hands, err := ParseHands(cards)
+	if err != nil {
+		return nil, err
+	}
+	hands = slices.DeleteFunc(hands, func(h ParsedHand) bool { return h.Type == Rocket })
+	if len(hands) == 0 {
+		return nil, fmt.Errorf("两副牌玩法中四张王才算王炸")
+	}
+	return hands, nil
+}
+
+// parseTwoDeckBomb 两副牌的炸弹（4~8 张同点数，Length 为张数）与王炸（四张王）
+func parseTwoDeckBomb(cards []card.Card) (ParsedHand, bool) {
+	if len(cards) < minBombSize || len(cards) > maxBombSize {
+		return ParsedHand{}, false
+	}
+	analysis := analyzeCards(cards)
+	if len(cards) == 4 && analysis.counts[card.RankBlackJoker] == 2 && analysis.counts[card.RankRedJoker] == 2 {
+		return ParsedHand{Type: Rocket, KeyRank: card.RankRedJoker, Length: 4, Cards: cards}, true
+	}
+	if len(analysis.counts) != 1 {
+		return ParsedHand{}, false
+	}
+	rank := cards[0].Rank
+	if rank >= card.RankBlackJoker {
+		return ParsedHand{}, false
+	}
+	return ParsedHand{Type: Bomb, KeyRank: rank, Length: len(cards), Cards: cards}, true
+}
+
+// candidateMoves 按房规的牌型规则列出出牌（不做房规允许与否的筛选），癞子玩法中癞子补足任意点数；
+// types 为空时列出全部牌型，否则只列其中的牌型。两副牌玩法中去掉双王王炸，补上 5~8 张炸弹与四王王炸。
+func (rs RuleSet) candidateMoves(hand []card.Card, types ...HandType) []ParsedHand {
+	g := newMoveGenerator(hand, rs.Wild, rs.lowestRank())
+	g.generate(types...)
+	if !rs.TwoDecks {
+		return g.moves
+	}
+
+	moves := g.moves
+	g.moves = make([]ParsedHand, 0, len(moves))
+	for _, m := range moves {
+		switch m.Type {
+		case Rocket:
+			continue
+		case Bomb:
+			m.Length = minBombSize
+		}
+		g.moves = append(g.moves, m)
+	}
+
+	if len(types) == 0 || slices.Contains(types, Bomb) {
+		for r := card.Rank3; r <= card.Rank2; r++ {
+			for size := minBombSize + 1; size <= min(g.count(r), maxBombSize); size++ {
+				if cards, played, ok := g.take([]rankGroup{{r, size}}); ok {
+					g.push(ParsedHand{Type: Bomb, KeyRank: r, Length: size}, cards, played)
+				}
+			}
+		}
+	}
+	if (len(types) == 0 || slices.Contains(types, Rocket)) &&
+		g.analysis.counts[card.RankBlackJoker] >= 2 && g.analysis.counts[card.RankRedJoker] >= 2 {
+		jokers := append(findCardsWithRank(g.hand, card.RankBlackJoker, 2), findCardsWithRank(g.hand, card.RankRedJoker, 2)...)
+		g.moves = append(g.moves, ParsedHand{Type: Rocket, KeyRank: card.RankRedJoker, Length: 4, Cards: jokers})
+	}
+
+	slices.SortStableFunc(g.moves, func(a, b ParsedHand) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.KeyRank, b.KeyRank), cmp.Compare(a.Length, b.Length))
+	})
+	return g.moves
+}
@@ -0,0 +1,114 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+)
+
+// twoDecks 两副牌玩法的房规
+var twoDecks = RuleSet{TwoDecks: true}
+
+// repeatRank 同一点数取 n 张
+func repeatRank(r card.Rank, n int) []card.Card {
+	ranks := make([]card.Rank, n)
+	for i := range ranks {
+		ranks[i] = r
+	}
+	return testRuleCards(ranks...)
+}
+
+func TestRuleSet_ParseHandTwoDecks(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		cards    []card.Card
+		expected HandType
+		length   int
+		wantErr  bool
+	}{
+		{name: "four-card bomb", cards: repeatRank(card.Rank9, 4), expected: Bomb, length: 4},
+		{name: "six-card bomb", cards: repeatRank(card.Rank3, 6), expected: Bomb, length: 6},
+		{name: "eight-card bomb", cards: repeatRank(card.Rank2, 8), expected: Bomb, length: 8},
+		{
+			name:     "four jokers are the rocket",
+			cards:    testRuleCards(card.RankBlackJoker, card.RankBlackJoker, card.RankRedJoker, card.RankRedJoker),
+			expected: Rocket,
+			length:   4,
+		},
+		{
+			name:     "pair of black jokers",
+			cards:    testRuleCards(card.RankBlackJoker, card.RankBlackJoker),
+			expected: Pair,
+		},
+		{
+			name:    "two different jokers are not a rocket",
+			cards:   testRuleCards(card.RankBlackJoker, card.RankRedJoker),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			hand, err := twoDecks.ParseHand(tc.cards)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hand.Type)
+			assert.Equal(t, tc.length, hand.Length)
+		})
+	}
+
+	// 经典规则下五张同点数不是合法牌型
+	_, err := DefaultRuleSet.ParseHand(repeatRank(card.Rank3, 5))
+	assert.Error(t, err)
+}
+
+func TestRuleSet_CanBeatTwoDecks(t *testing.T) {
+	t.Parallel()
+
+	parse := func(cards []card.Card) ParsedHand {
+		hand, err := twoDecks.ParseHand(cards)
+		require.NoError(t, err)
+		return hand
+	}
+	bomb4A := parse(repeatRank(card.RankA, 4))
+	bomb5Three := parse(repeatRank(card.Rank3, 5))
+	bomb5Four := parse(repeatRank(card.Rank4, 5))
+	rocket := parse(testRuleCards(card.RankBlackJoker, card.RankBlackJoker, card.RankRedJoker, card.RankRedJoker))
+
+	assert.True(t, twoDecks.CanBeat(bomb5Three, bomb4A), "张数多的炸弹大")
+	assert.False(t, twoDecks.CanBeat(bomb4A, bomb5Three))
+	assert.True(t, twoDecks.CanBeat(bomb5Four, bomb5Three), "张数相同比点数")
+	assert.True(t, twoDecks.CanBeat(rocket, parse(repeatRank(card.Rank2, 8))))
+}
+
+func TestRuleSet_GenerateMovesTwoDecks(t *testing.T) {
+	t.Parallel()
+
+	hand := append(repeatRank(card.Rank6, 6),
+		testRuleCards(card.RankBlackJoker, card.RankBlackJoker, card.RankRedJoker, card.RankRedJoker)...)
+
+	last, err := twoDecks.ParseHand(repeatRank(card.RankK, 5))
+	require.NoError(t, err)
+
+	moves := twoDecks.GenerateMoves(hand, last)
+	lengths := make(map[HandType][]int)
+	for _, m := range moves {
+		assert.True(t, twoDecks.CanBeat(m, last))
+		lengths[m.Type] = append(lengths[m.Type], m.Length)
+	}
+	// 6 张的 6 炸与四王王炸能压五张 K 炸；双王不算王炸
+	assert.Equal(t, map[HandType][]int{Bomb: {6}, Rocket: {4}}, lengths)
+
+	// 提示取最小的一手：6 张炸弹而非王炸
+	hint := twoDecks.FindSmallestBeatingCards(hand, last)
+	assert.Len(t, hint, 6)
+}
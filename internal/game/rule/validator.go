@@ -1,6 +1,9 @@
 package rule
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
 )
 
@@ -110,3 +113,70 @@ func isSimpleType(analysis HandAnalysis, cards []card.Card) (ParsedHand, bool) {
 	}
 	return ParsedHand{}, false
 }
+
+// fourWithPairsReadings 四带两对的其他读法：任一个四张作主体，其余四张凑成两对即可（AAAABBBB 可读作 A 带两对或 B 带两对）
+func fourWithPairsReadings(analysis HandAnalysis, cards []card.Card) []ParsedHand {
+	if len(cards) != 8 {
+		return nil
+	}
+	var hands []ParsedHand
+	for _, r := range analysis.fours {
+		evenRest := true
+		for other, n := range analysis.counts {
+			if other != r && n%2 != 0 {
+				evenRest = false
+				break
+			}
+		}
+		if evenRest {
+			hands = append(hands, ParsedHand{Type: FourWithTwoPairs, KeyRank: r, Cards: cards})
+		}
+	}
+	return hands
+}
+
+// planeReadings 飞机的其他读法：在三张及以上的点数中任取连续一段作机身，其余牌作翅膀。
+// 翅膀可以与机身同点数（33334444 可读作 333444 带 3、4），但翅膀之间点数不能相同。
+func planeReadings(analysis HandAnalysis, cards []card.Card) []ParsedHand {
+	var bodies []card.Rank
+	for r, n := range analysis.counts {
+		if n >= 3 && r < card.Rank2 {
+			bodies = append(bodies, r)
+		}
+	}
+	slices.Sort(bodies)
+
+	var hands []ParsedHand
+	for i := range bodies {
+		for j := i + 1; j < len(bodies) && bodies[j] == bodies[j-1]+1; j++ {
+			planeLen := j - i + 1
+			wings := maps.Clone(analysis.counts)
+			for _, r := range bodies[i : j+1] {
+				if wings[r] -= 3; wings[r] == 0 {
+					delete(wings, r)
+				}
+			}
+			hand := ParsedHand{KeyRank: bodies[i], Length: planeLen, Cards: cards}
+			switch wingLen := len(cards) - planeLen*3; {
+			case wingLen == planeLen && len(wings) == planeLen:
+				hand.Type = PlaneWithSingles
+			case wingLen == planeLen*2 && len(wings) == planeLen && allCounts(wings, 2):
+				hand.Type = PlaneWithPairs
+			default:
+				continue
+			}
+			hands = append(hands, hand)
+		}
+	}
+	return hands
+}
+
+// allCounts 判断每种点数的数量是否都为 n
+func allCounts(counts map[card.Rank]int, n int) bool {
+	for _, c := range counts {
+		if c != n {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,546 @@
+package tournament
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/bot"
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+const (
+	tableSize  = 3                // 每桌人数，锦标赛只打经典三人玩法
+	handBreak  = 5 * time.Second  // 同一桌两局之间的间隔，留时间查看结算
+	roundBreak = 10 * time.Second // 一轮全部打完到下一轮开赛的间隔
+)
+
+// Tournament 一场淘汰赛：每轮把剩余玩家分桌，每桌打满局数后累计得分最高的真人晋级，直到决赛桌
+type Tournament struct {
+	ID      string
+	Name    string
+	StartAt time.Time
+	State   string // protocol.TournamentRegistering 等
+	Round   int    // 进行中的轮次，从 1 开始
+
+	eventIdx   int
+	event      config.TournamentEventConfig
+	registered []string                       // 报名玩家 ID，按报名顺序
+	entrants   []*protocol.TournamentStanding // 开赛后的全部参赛者
+	finalRound bool                           // 本轮只有一桌，即决赛
+	advancing  []string                       // 本轮已晋级的玩家
+	pending    int                            // 本轮尚未打完的桌数
+	boards     *duplicate.Set                 // 复式赛本轮各桌共用的牌，nil 表示本轮按各桌累计得分晋级
+	finished   []*table                       // 复式赛本轮已打完的桌，整轮结束后统一结算
+	timer      *time.Timer                    // 开赛或下一轮开赛的计时器
+}
+
+// table 锦标赛中的一桌，对应一个系列赛房间
+type table struct {
+	t        *Tournament
+	code     string
+	humans   []string                  // 本桌真人玩家 ID，其余座位为机器人
+	seats    []string                  // 按座位排列的全部玩家 ID（含机器人）
+	snapshot []protocol.SeriesStanding // 最近一局结束时的累计排名，局间散桌时按此结算
+}
+
+// Manager 锦标赛管理器
+type Manager struct {
+	roomManager *room.RoomManager
+	botEngine   bot.DecisionEngine
+	lookup      func(playerID string) types.ClientInterface
+	leaveQueue  func(client types.ClientInterface)
+	tournaments map[string]*Tournament
+	tables      map[string]*table // 房间号 → 桌
+	handBreak   time.Duration
+	roundBreak  time.Duration
+	mu          sync.Mutex
+}
+
+// ManagerDeps 锦标赛管理器依赖
+type ManagerDeps struct {
+	RoomManager *room.RoomManager
+	Config      config.TournamentConfig
+	BotEngine   bot.DecisionEngine                          // 补位机器人使用的引擎，nil 表示规则启发式引擎
+	Lookup      func(playerID string) types.ClientInterface // 查找在线玩家，不在线时返回 nil
+	LeaveQueue  func(client types.ClientInterface)          // 开赛时把玩家移出匹配队列
+}
+
+// NewManager 创建锦标赛管理器，并开放每项赛事下一场的报名
+func NewManager(deps ManagerDeps) *Manager {
+	m := &Manager{
+		roomManager: deps.RoomManager,
+		botEngine:   deps.BotEngine,
+		lookup:      deps.Lookup,
+		leaveQueue:  deps.LeaveQueue,
+		tournaments: make(map[string]*Tournament),
+		tables:      make(map[string]*table),
+		handBreak:   handBreak,
+		roundBreak:  roundBreak,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for i, ev := range deps.Config.Events {
+		if _, err := time.Parse("15:04", ev.Time); err != nil {
+			log.Printf("⚠️ 锦标赛 %q 的开赛时间 %q 无效，已跳过", ev.Name, ev.Time)
+			continue
+		}
+		m.schedule(i, ev, now)
+	}
+	return m
+}
+
+// nextStart 返回 after 之后的下一个开赛时刻，clock 为每天的 HH:MM
+func nextStart(clock string, after time.Time) time.Time {
+	c, _ := time.Parse("15:04", clock)
+	start := time.Date(after.Year(), after.Month(), after.Day(), c.Hour(), c.Minute(), 0, 0, after.Location())
+	if !start.After(after) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
+}
+
+// schedule 开放赛事在 after 之后下一场的报名，到点开赛（调用方需持有 m.mu）
+func (m *Manager) schedule(idx int, ev config.TournamentEventConfig, after time.Time) *Tournament {
+	startAt := nextStart(ev.Time, after)
+	t := &Tournament{
+		ID:       fmt.Sprintf("%d-%s", idx+1, startAt.Format("20060102")),
+		Name:     ev.Name,
+		StartAt:  startAt,
+		State:    protocol.TournamentRegistering,
+		eventIdx: idx,
+		event:    ev,
+	}
+	m.tournaments[t.ID] = t
+	t.timer = time.AfterFunc(time.Until(startAt), func() { m.start(t) })
+	log.Printf("🏆 锦标赛 %s 开放报名，%s 开赛", t.Name, startAt.Format("01-02 15:04"))
+	return t
+}
+
+// List 返回全部锦标赛，Registered 标记 playerID 是否报名
+func (m *Manager) List(playerID string) []protocol.TournamentInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	infos := make([]protocol.TournamentInfo, 0, len(m.tournaments))
+	for _, t := range m.tournaments {
+		info := protocol.TournamentInfo{
+			ID:      t.ID,
+			Name:    t.Name,
+			StartAt: t.StartAt.Unix(),
+			State:   t.State,
+			Round:   t.Round,
+			Hands:   t.event.Hands,
+		}
+		if t.State == protocol.TournamentRegistering {
+			info.Players = len(t.registered)
+			info.Registered = slices.Contains(t.registered, playerID)
+		} else {
+			info.Players = len(t.entrants)
+			info.Registered = t.entrant(playerID) != nil
+		}
+		infos = append(infos, info)
+	}
+	slices.SortFunc(infos, func(a, b protocol.TournamentInfo) int {
+		return cmp.Or(cmp.Compare(a.StartAt, b.StartAt), cmp.Compare(a.ID, b.ID))
+	})
+	return infos
+}
+
+// Register 报名或退出锦标赛，开赛后不能再变更
+func (m *Manager) Register(client types.ClientInterface, id string, register bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t := m.tournaments[id]
+	if t == nil {
+		return apperrors.ErrTournamentNotFound
+	}
+	if t.State != protocol.TournamentRegistering {
+		return apperrors.ErrTournamentStarted
+	}
+
+	i := slices.Index(t.registered, client.GetID())
+	switch {
+	case register && i == -1:
+		t.registered = append(t.registered, client.GetID())
+		log.Printf("🏆 玩家 %s 报名锦标赛 %s（%d 人）", client.GetName(), t.Name, len(t.registered))
+	case !register && i != -1:
+		t.registered = slices.Delete(t.registered, i, i+1)
+		log.Printf("🏆 玩家 %s 退出锦标赛 %s（%d 人）", client.GetName(), t.Name, len(t.registered))
+	}
+	return nil
+}
+
+// start 到点开赛：报名的玩家须在线且不在其他房间，人数不足时取消本场并开放下一场报名
+func (m *Manager) start(t *Tournament) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.tournaments[t.ID] != t || t.State != protocol.TournamentRegistering {
+		return
+	}
+
+	var ids []string
+	for _, id := range t.registered {
+		if c := m.lookup(id); c != nil && c.GetRoom() == "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) < t.event.MinPlayers {
+		log.Printf("🏆 锦标赛 %s 报名人数不足（%d/%d），已取消", t.Name, len(ids), t.event.MinPlayers)
+		msg := codec.NewErrorMessageWithText(protocol.ErrCodeUnknown, fmt.Sprintf("锦标赛「%s」报名人数不足，已取消", t.Name))
+		for _, id := range t.registered {
+			if c := m.lookup(id); c != nil {
+				c.SendMessage(msg)
+			}
+		}
+		m.close(t)
+		return
+	}
+
+	t.State = protocol.TournamentRunning
+	for _, id := range ids {
+		c := m.lookup(id)
+		if m.leaveQueue != nil {
+			m.leaveQueue(c)
+		}
+		t.entrants = append(t.entrants, &protocol.TournamentStanding{PlayerID: id, PlayerName: c.GetName()})
+	}
+	log.Printf("🏆 锦标赛 %s 开赛，%d 名玩家参赛", t.Name, len(ids))
+	m.startRound(t, ids)
+}
+
+// seatTables 把 n 名玩家分到尽量少的桌上，各桌真人数相差不超过 1，返回每桌的玩家下标
+func seatTables(n int) [][]int {
+	tables := make([][]int, (n+tableSize-1)/tableSize)
+	for i := range n {
+		tables[i%len(tables)] = append(tables[i%len(tables)], i)
+	}
+	return tables
+}
+
+// startRound 把 ids 中的玩家随机分桌开始新一轮，掉线或已进入其他房间的玩家视为弃权；
+// 不足两人时剩下的玩家夺冠（调用方需持有 m.mu）
+func (m *Manager) startRound(t *Tournament, ids []string) {
+	var players []types.ClientInterface
+	for _, id := range ids {
+		if c := m.lookup(id); c != nil && c.GetRoom() == "" {
+			players = append(players, c)
+		} else if e := t.entrant(id); e != nil {
+			e.Eliminated = true
+		}
+	}
+	if len(players) < 2 {
+		t.advancing = nil
+		for _, c := range players {
+			t.advancing = append(t.advancing, c.GetID())
+		}
+		m.complete(t)
+		return
+	}
+
+	t.Round++
+	t.advancing = nil
+	rand.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+	seats := seatTables(len(players))
+	t.finalRound = len(seats) == 1
+	// 复式赛需要至少两桌才能比较，决赛桌按累计得分决出冠军
+	t.boards, t.finished = nil, nil
+	if t.event.Duplicate && len(seats) > 1 {
+		t.boards = duplicate.NewSet(room.RoomOptions{}.Layout())
+	}
+
+	var tables []*table
+	for _, seat := range seats {
+		humans := make([]types.ClientInterface, len(seat))
+		for i, idx := range seat {
+			humans[i] = players[idx]
+			t.entrant(players[idx].GetID()).Round = t.Round
+		}
+		tb, err := m.openTable(t, humans)
+		if err != nil {
+			// 开桌失败时本桌玩家轮空晋级
+			log.Printf("⚠️ 锦标赛 %s 开桌失败: %v", t.Name, err)
+			for _, c := range humans {
+				t.advancing = append(t.advancing, c.GetID())
+			}
+			continue
+		}
+		if t.boards != nil {
+			t.boards.AddTable(tb.code, len(tables))
+		}
+		t.pending++
+		tables = append(tables, tb)
+	}
+	log.Printf("🏆 锦标赛 %s 第 %d 轮开始，共 %d 桌", t.Name, t.Round, len(tables))
+
+	if t.pending == 0 {
+		m.endRound(t)
+		return
+	}
+	// 开局时还要创建游戏会话，放到锁外进行
+	go func() {
+		for _, tb := range tables {
+			m.nextHand(tb)
+		}
+	}()
+}
+
+// openTable 为一桌真人创建系列赛房间，空位由机器人补齐（调用方需持有 m.mu）
+func (m *Manager) openTable(t *Tournament, humans []types.ClientInterface) (*table, error) {
+	clients := slices.Clone(humans)
+	for len(clients) < tableSize {
+		clients = append(clients, bot.NewBotClient(m.engine()))
+	}
+
+	r, err := m.roomManager.CreateRoom(clients[0], room.RoomOptions{Hands: min(t.event.Hands, room.MaxSeriesHands)})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clients[1:] {
+		if _, err := m.roomManager.JoinRoom(c, r.Code); err != nil {
+			m.roomManager.RemoveRoom(r.Code)
+			return nil, err
+		}
+	}
+	r.SetTournament(t.ID)
+	if t.boards != nil {
+		r.SetSeedSource(t.boards.Seed)
+	}
+	for _, c := range clients {
+		c.SendMessage(codec.MustNewMessage(protocol.MsgRoomJoined, protocol.RoomJoinedPayload{
+			RoomCode: r.Code,
+			Player:   r.GetPlayerInfo(c.GetID()),
+			Players:  r.GetAllPlayersInfo(),
+		}))
+	}
+
+	tb := &table{t: t, code: r.Code}
+	for _, c := range humans {
+		tb.humans = append(tb.humans, c.GetID())
+	}
+	for _, c := range clients {
+		tb.seats = append(tb.seats, c.GetID())
+	}
+	m.tables[r.Code] = tb
+	return tb, nil
+}
+
+// engine 补位机器人使用的引擎
+func (m *Manager) engine() bot.DecisionEngine {
+	if m.botEngine == nil {
+		return bot.NewHeuristicEngine()
+	}
+	return m.botEngine
+}
+
+// DealSource 返回复式赛桌的发牌钩子：同一轮各桌打同一组牌，按桌轮换座位；其他房间返回 nil
+func (m *Manager) DealSource(code string) func(hand, redeal int) *duplicate.Board {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tb := m.tables[code]
+	if tb == nil || tb.t.boards == nil {
+		return nil
+	}
+	boards := tb.t.boards
+	return func(hand, redeal int) *duplicate.Board { return boards.Deal(code, hand, redeal) }
+}
+
+// HandleHandEnd 每局结束后调用，返回该房间是否为锦标赛桌。本桌未打满局数时稍后自动开始下一局，
+// 打满后按累计得分决定晋级。调用时持有游戏会话的锁，不能同步开局
+func (m *Manager) HandleHandEnd(code string, final bool) bool {
+	r := m.roomManager.GetRoom(code)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tb := m.tables[code]
+	if tb == nil {
+		return false
+	}
+	if r != nil {
+		tb.snapshot = r.SeriesStandings()
+	}
+	if tb.t.boards != nil {
+		last := make(map[string]int, len(tb.snapshot))
+		for _, s := range tb.snapshot {
+			last[s.PlayerID] = s.Last
+		}
+		tb.t.boards.Record(code, tb.seats, last)
+	}
+	if final {
+		m.finishTable(tb)
+	} else {
+		time.AfterFunc(m.handBreak, func() { m.nextHand(tb) })
+	}
+	return true
+}
+
+// nextHand 开始本桌的下一局；局间有人离开导致散桌时，按已打局数的累计得分结算本桌
+func (m *Manager) nextHand(tb *table) {
+	err := m.roomManager.ForceStart(tb.code)
+	if err == nil {
+		return
+	}
+	log.Printf("⚠️ 锦标赛房间 %s 无法开始下一局，按当前成绩结算: %v", tb.code, err)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tables[tb.code] == tb {
+		m.finishTable(tb)
+	}
+}
+
+// finishTable 本桌打完：按累计得分结算；复式赛要等各桌都打完才能比较，先记下本桌（调用方需持有 m.mu）
+func (m *Manager) finishTable(tb *table) {
+	delete(m.tables, tb.code)
+	m.roomManager.RemoveRoom(tb.code)
+
+	t := tb.t
+	if t.boards != nil {
+		t.finished = append(t.finished, tb)
+	} else {
+		totals := make(map[string]int, len(tb.snapshot))
+		for _, s := range tb.snapshot {
+			totals[s.PlayerID] = s.Total
+		}
+		t.settle(tb, totals)
+	}
+
+	t.pending--
+	if t.pending == 0 {
+		m.endRound(t)
+	}
+}
+
+// settle 结算一桌：scores 计入各真人的总成绩，得分最高的晋级，其余淘汰；
+// 同分时按本桌累计排名先后，一局都没打完就散桌时按座位顺序
+func (t *Tournament) settle(tb *table, scores map[string]int) {
+	order := slices.Clone(tb.humans)
+	rank := func(id string) int {
+		i := slices.IndexFunc(tb.snapshot, func(s protocol.SeriesStanding) bool { return s.PlayerID == id })
+		if i == -1 {
+			return len(tb.snapshot)
+		}
+		return i
+	}
+	slices.SortStableFunc(order, func(a, b string) int { return cmp.Compare(rank(a), rank(b)) })
+
+	var winner string
+	for _, id := range order {
+		t.entrant(id).Total += scores[id]
+		if winner == "" || scores[id] > scores[winner] {
+			winner = id
+		}
+	}
+	for _, id := range tb.humans {
+		if id == winner {
+			t.advancing = append(t.advancing, id)
+		} else {
+			t.entrant(id).Eliminated = true
+		}
+	}
+}
+
+// endRound 一轮全部打完：公布排名，间隔片刻后晋级的玩家开始下一轮；决赛打完或只剩一人时比赛结束（调用方需持有 m.mu）
+func (m *Manager) endRound(t *Tournament) {
+	// 复式赛：每名玩家与其他桌拿同一手牌的玩家比较，按复式得分在各桌决出晋级者
+	if t.boards != nil {
+		scores := t.boards.Scores()
+		for _, tb := range t.finished {
+			t.settle(tb, scores)
+		}
+		t.boards, t.finished = nil, nil
+	}
+	if t.finalRound || len(t.advancing) < 2 {
+		m.complete(t)
+		return
+	}
+	m.broadcastStandings(t, false)
+	advancing := t.advancing
+	t.timer = time.AfterFunc(m.roundBreak, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.startRound(t, advancing)
+	})
+}
+
+// complete 比赛结束：仍未淘汰的玩家即冠军，公布最终排名（调用方需持有 m.mu）
+func (m *Manager) complete(t *Tournament) {
+	if len(t.advancing) > 0 {
+		for _, e := range t.entrants {
+			e.Eliminated = e.PlayerID != t.advancing[0]
+		}
+		log.Printf("🏆 锦标赛 %s 结束，冠军: %s", t.Name, t.entrant(t.advancing[0]).PlayerName)
+	}
+	m.broadcastStandings(t, true)
+	m.close(t)
+}
+
+// close 移除本场锦标赛并开放同一赛事下一场的报名（调用方需持有 m.mu）
+func (m *Manager) close(t *Tournament) {
+	delete(m.tournaments, t.ID)
+	after := time.Now()
+	if after.Before(t.StartAt) {
+		after = t.StartAt
+	}
+	m.schedule(t.eventIdx, t.event, after)
+}
+
+// broadcastStandings 向在线的参赛者推送排名（调用方需持有 m.mu）
+func (m *Manager) broadcastStandings(t *Tournament, final bool) {
+	msg := codec.MustNewMessage(protocol.MsgTournamentStandings, protocol.TournamentStandingsPayload{
+		TournamentID: t.ID,
+		Name:         t.Name,
+		Round:        t.Round,
+		Standings:    t.standings(),
+		Final:        final,
+	})
+	for _, e := range t.entrants {
+		if c := m.lookup(e.PlayerID); c != nil {
+			c.SendMessage(msg)
+		}
+	}
+}
+
+// entrant 返回参赛者的成绩，不是参赛者（含机器人）时为 nil
+func (t *Tournament) entrant(playerID string) *protocol.TournamentStanding {
+	for _, e := range t.entrants {
+		if e.PlayerID == playerID {
+			return e
+		}
+	}
+	return nil
+}
+
+// standings 排名：未淘汰的在前，其余按打到的轮次与累计得分排序
+func (t *Tournament) standings() []protocol.TournamentStanding {
+	standings := make([]protocol.TournamentStanding, len(t.entrants))
+	for i, e := range t.entrants {
+		standings[i] = *e
+	}
+	slices.SortStableFunc(standings, func(a, b protocol.TournamentStanding) int {
+		if a.Eliminated != b.Eliminated {
+			if a.Eliminated {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(cmp.Compare(b.Round, a.Round), cmp.Compare(b.Total, a.Total))
+	})
+	return standings
+}
@@ -0,0 +1,375 @@
+package tournament
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/server/session"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+func TestNextStart(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 19, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC), nextStart("20:00", now))
+	assert.Equal(t, time.Date(2026, 10, 17, 19, 30, 0, 0, time.UTC), nextStart("19:30", now), "正好到点时排到第二天")
+	assert.Equal(t, time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC), nextStart("08:00", now))
+}
+
+func TestSeatTables(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		want [][]int
+	}{
+		{2, [][]int{{0, 1}}},
+		{3, [][]int{{0, 1, 2}}},
+		{4, [][]int{{0, 2}, {1, 3}}},
+		{7, [][]int{{0, 3, 6}, {1, 4}, {2, 5}}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d人", tt.n), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, seatTables(tt.n))
+		})
+	}
+}
+
+// newTestManager 创建不自动开赛的管理器与 n 名在线玩家，返回已开放报名的一场锦标赛
+func newTestManager(t *testing.T, n int) (*Manager, *Tournament, []*testutil.SimpleClient) {
+	t.Helper()
+	clients := make([]*testutil.SimpleClient, n)
+	online := make(map[string]types.ClientInterface, n)
+	for i := range clients {
+		clients[i] = testutil.NewSimpleClient(fmt.Sprintf("p%d", i+1), fmt.Sprintf("Player%d", i+1))
+		online[clients[i].ID] = clients[i]
+	}
+
+	m := NewManager(ManagerDeps{
+		RoomManager: room.NewRoomManager(storage.NewRedisStore(nil), config.GameConfig{RoomTimeout: 10}),
+		Lookup:      func(id string) types.ClientInterface { return online[id] },
+	})
+	m.handBreak = time.Millisecond
+	m.roundBreak = time.Millisecond
+
+	m.mu.Lock()
+	tour := m.schedule(0, config.TournamentEventConfig{Name: "测试赛", Time: "20:00", Hands: 2, MinPlayers: 2}, time.Now())
+	m.mu.Unlock()
+	tour.timer.Stop()
+	t.Cleanup(func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, t := range m.tournaments {
+			t.timer.Stop()
+		}
+	})
+	return m, tour, clients
+}
+
+// playHand 等本桌第 hand 局开局后模拟该局结束，scores 按真人玩家 ID 给出；
+// 打满局数时与游戏会话一样让玩家离开房间
+func playHand(t *testing.T, m *Manager, code string, hand int, clients []*testutil.SimpleClient, scores map[string]int) {
+	t.Helper()
+	r := m.roomManager.GetRoom(code)
+	require.NotNil(t, r)
+
+	m.mu.Lock()
+	humans := m.tables[code].humans
+	m.mu.Unlock()
+	var seated []*testutil.SimpleClient
+	for _, c := range clients {
+		if slices.Contains(humans, c.ID) {
+			seated = append(seated, c)
+		}
+	}
+	require.Eventually(t, func() bool {
+		msg := lastOf(seated[0], protocol.MsgGameStart)
+		if msg == nil {
+			return false
+		}
+		start, err := codec.ParsePayload[protocol.GameStartPayload](msg)
+		return err == nil && start.Hand == hand
+	}, time.Second, time.Millisecond, "等待开局")
+
+	var result []protocol.PlayerScore
+	for _, c := range seated {
+		result = append(result, protocol.PlayerScore{PlayerID: c.ID, PlayerName: c.Name, Score: scores[c.ID]})
+	}
+	standings := r.FinishHand(result)
+	if standings.Final {
+		for _, c := range seated {
+			c.SetRoom("")
+		}
+	}
+	assert.True(t, m.HandleHandEnd(code, standings.Final))
+}
+
+// tableOf 返回玩家所在的锦标赛桌
+func tableOf(m *Manager, playerID string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for code, tb := range m.tables {
+		for _, id := range tb.humans {
+			if id == playerID {
+				return code
+			}
+		}
+	}
+	return ""
+}
+
+func TestManager_Register(t *testing.T) {
+	t.Parallel()
+
+	m, tour, clients := newTestManager(t, 2)
+
+	require.NoError(t, m.Register(clients[0], tour.ID, true))
+	require.NoError(t, m.Register(clients[0], tour.ID, true), "重复报名不重复计数")
+	require.NoError(t, m.Register(clients[1], tour.ID, true))
+	require.NoError(t, m.Register(clients[1], tour.ID, false))
+
+	list := m.List(clients[0].ID)
+	require.Len(t, list, 1)
+	assert.Equal(t, 1, list[0].Players)
+	assert.True(t, list[0].Registered)
+	assert.False(t, m.List(clients[1].ID)[0].Registered)
+
+	assert.ErrorIs(t, m.Register(clients[0], "nope", true), apperrors.ErrTournamentNotFound)
+
+	// 报名人数不足时取消，并开放下一场报名
+	m.start(tour)
+	msgs := clients[0].SentMessages()
+	assert.Equal(t, protocol.MsgError, msgs[len(msgs)-1].Type)
+	list = m.List(clients[0].ID)
+	require.Len(t, list, 1)
+	assert.NotEqual(t, tour.ID, list[0].ID)
+	assert.ErrorIs(t, m.Register(clients[0], tour.ID, true), apperrors.ErrTournamentNotFound)
+}
+
+func TestManager_Knockout(t *testing.T) {
+	t.Parallel()
+
+	m, tour, clients := newTestManager(t, 4)
+	var removedMu sync.Mutex
+	removed := make(map[string]bool)
+	m.roomManager.SetOnRoomRemoved(func(code string) {
+		removedMu.Lock()
+		defer removedMu.Unlock()
+		removed[code] = true
+	})
+	for _, c := range clients {
+		require.NoError(t, m.Register(c, tour.ID, true))
+	}
+	m.start(tour)
+	assert.ErrorIs(t, m.Register(clients[0], tour.ID, false), apperrors.ErrTournamentStarted)
+
+	// 第一轮：4 人分两桌，每桌 2 名真人加 1 个机器人
+	m.mu.Lock()
+	require.Len(t, m.tables, 2)
+	for code, tb := range m.tables {
+		assert.Len(t, tb.humans, 2)
+		assert.Len(t, m.roomManager.GetRoom(code).Players, 3)
+		assert.Equal(t, tour.ID, m.roomManager.GetRoom(code).ToRoomData().Tournament, "锦标赛标记随房间保存")
+	}
+	m.mu.Unlock()
+
+	// 每桌打满两局，编号小的玩家累计得分更高而晋级
+	m.mu.Lock()
+	codes := slices.Collect(maps.Keys(m.tables))
+	var finalists []string
+	for _, tb := range m.tables {
+		finalists = append(finalists, slices.Min(tb.humans))
+	}
+	m.mu.Unlock()
+	scores := map[string]int{"p1": 4, "p2": 3, "p3": 2, "p4": 1}
+	for _, code := range codes {
+		playHand(t, m, code, 1, clients, scores)
+		playHand(t, m, code, 2, clients, scores)
+		assert.Nil(t, m.roomManager.GetRoom(code), "本桌结束后移除房间")
+		removedMu.Lock()
+		assert.True(t, removed[code], "移除房间时通知释放游戏会话")
+		removedMu.Unlock()
+	}
+
+	// 第二轮即决赛：晋级的两人同桌
+	var final string
+	require.Eventually(t, func() bool { final = tableOf(m, "p1"); return final != "" }, time.Second, time.Millisecond)
+	m.mu.Lock()
+	humans := m.tables[final].humans
+	round := tour.Round
+	m.mu.Unlock()
+	assert.ElementsMatch(t, finalists, humans)
+	assert.Equal(t, 2, round)
+
+	standings, err := codec.ParsePayload[protocol.TournamentStandingsPayload](lastOf(clients[3], protocol.MsgTournamentStandings))
+	require.NoError(t, err)
+	assert.False(t, standings.Final)
+	assert.True(t, standings.Standings[3].Eliminated, "p4 得分最低，第一轮即被淘汰")
+
+	// 决赛中另一名玩家反超 p1 夺冠
+	champion := slices.Max(finalists)
+	playHand(t, m, final, 1, clients, map[string]int{champion: 10})
+	playHand(t, m, final, 2, clients, map[string]int{champion: 10})
+
+	standings, err = codec.ParsePayload[protocol.TournamentStandingsPayload](lastOf(clients[0], protocol.MsgTournamentStandings))
+	require.NoError(t, err)
+	assert.True(t, standings.Final)
+	assert.Equal(t, champion, standings.Standings[0].PlayerID, "决赛得分最高者夺冠")
+	assert.False(t, standings.Standings[0].Eliminated)
+	for _, s := range standings.Standings[1:] {
+		assert.True(t, s.Eliminated)
+	}
+	assert.Equal(t, 2, standings.Standings[1].Round)
+	assert.Equal(t, "p1", standings.Standings[1].PlayerID)
+
+	// 比赛结束后开放下一场报名
+	list := m.List("p1")
+	require.Len(t, list, 1)
+	assert.Equal(t, protocol.TournamentRegistering, list[0].State)
+	assert.NotEqual(t, tour.ID, list[0].ID)
+}
+
+func TestManager_OfflineBetweenHands(t *testing.T) {
+	t.Parallel()
+
+	m, tour, clients := newTestManager(t, 2)
+	var mu sync.Mutex
+	var sessions []*session.GameSession
+	m.roomManager.SetOnGameStart(func(r *room.Room) {
+		gs := session.NewGameSession(r, nil, config.GameConfig{})
+		mu.Lock()
+		defer mu.Unlock()
+		sessions = append(sessions, gs)
+	})
+	started := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(sessions)
+	}
+
+	for _, c := range clients {
+		require.NoError(t, m.Register(c, tour.ID, true))
+	}
+	m.start(tour)
+	code := tableOf(m, "p1")
+	require.NotEmpty(t, code)
+	require.Eventually(t, func() bool { return started() == 1 }, time.Second, time.Millisecond)
+
+	// p2 在第一局中掉线，第二局照常开局：按离线入座并托管，由决策引擎代打
+	m.roomManager.NotifyPlayerOffline(clients[1])
+	playHand(t, m, code, 1, clients, nil)
+	require.Eventually(t, func() bool { return started() == 2 }, time.Second, time.Millisecond)
+
+	mu.Lock()
+	players := sessions[1].GetPlayersForSerialization()
+	mu.Unlock()
+	i := slices.IndexFunc(players, func(p *session.GamePlayer) bool { return p.ID == "p2" })
+	require.GreaterOrEqual(t, i, 0)
+	assert.Equal(t, "Player2", players[i].Name)
+	assert.False(t, players[i].IsBot)
+	assert.True(t, players[i].IsOffline)
+	assert.True(t, players[i].Trustee)
+}
+
+func TestManager_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	m, tour, clients := newTestManager(t, 6)
+	tour.event.Duplicate = true
+	for _, c := range clients {
+		require.NoError(t, m.Register(c, tour.ID, true))
+	}
+	m.start(tour)
+
+	// 6 人分两桌，两桌打同一副牌，第二桌的手牌轮换一个座位
+	m.mu.Lock()
+	require.Len(t, m.tables, 2)
+	codes := slices.Collect(maps.Keys(m.tables))
+	m.mu.Unlock()
+	layout := room.RoomOptions{}.Layout()
+	first, second := m.DealSource(codes[0])(1, 0), m.DealSource(codes[1])(1, 0)
+	require.NotNil(t, first)
+	if first.Shift != 0 {
+		codes[0], codes[1] = codes[1], codes[0]
+		first, second = second, first
+	}
+	require.Equal(t, duplicate.Rotate(first.Deck, layout.Players, layout.HandSize, 1), second.Deck)
+	// 两桌由拿同一手牌的玩家先叫
+	assert.Equal(t, first.FirstBidder, (second.FirstBidder+1)%layout.Players)
+
+	// 各桌开局公布的是复式牌组本局共用种子的承诺
+	m.mu.Lock()
+	commit := fairness.Commit(tour.boards.Seed(1))
+	m.mu.Unlock()
+	for _, code := range codes {
+		r := m.roomManager.GetRoom(code)
+		assert.Eventually(t, func() bool { return r.SeedCommit() == commit }, time.Second, time.Millisecond)
+	}
+
+	// 各桌按拿到的原始手牌得分：第 0 手牌是好牌，两桌拿到它的玩家都赢得最多，
+	// 但第一桌拿第 2 手牌的玩家比第二桌拿同一手牌的玩家少输，复式得分最高
+	holdingScores := [][]int{{6, -3, -3}, {10, -4, -6}}
+	winners := make([]string, 2)
+	for shift, code := range codes {
+		m.mu.Lock()
+		seats := m.tables[code].seats
+		m.mu.Unlock()
+		scores := make(map[string]int)
+		for seat, id := range seats {
+			holding := (seat + shift) % layout.Players
+			scores[id] = holdingScores[shift][holding]
+			if holding == []int{2, 0}[shift] {
+				winners[shift] = id
+			}
+		}
+		playHand(t, m, code, 1, clients, scores)
+		m.DealSource(code)(2, 0)
+		playHand(t, m, code, 2, clients, scores)
+	}
+
+	var final string
+	require.Eventually(t, func() bool { final = tableOf(m, winners[0]); return final != "" }, time.Second, time.Millisecond)
+	m.mu.Lock()
+	humans := m.tables[final].humans
+	m.mu.Unlock()
+	assert.ElementsMatch(t, winners, humans)
+	assert.Nil(t, m.DealSource(final), "决赛只有一桌，不打复式")
+
+	standings, err := codec.ParsePayload[protocol.TournamentStandingsPayload](lastOf(clients[0], protocol.MsgTournamentStandings))
+	require.NoError(t, err)
+	totals := make(map[string]int)
+	for _, s := range standings.Standings {
+		totals[s.PlayerID] = s.Total
+	}
+	assert.Equal(t, 6, totals[winners[0]], "两局各比第二桌同一手牌多 3 分")
+	assert.Equal(t, 8, totals[winners[1]], "两局各比第一桌同一手牌多 4 分")
+}
+
+// lastOf 返回客户端最近收到的指定类型消息
+func lastOf(c *testutil.SimpleClient, typ protocol.MessageType) *protocol.Message {
+	msgs := c.SentMessages()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].Type == typ {
+			return msgs[i]
+		}
+	}
+	return nil
+}
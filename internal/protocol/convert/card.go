@@ -2,6 +2,7 @@ package convert
 
 import (
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 )
 
@@ -40,3 +41,21 @@ func InfosToCards(infos []protocol.CardInfo) []card.Card {
 	}
 	return cards
 }
+
+// ReadingToInfo 将 rule.HandReading 转换为 protocol.HandReading
+func ReadingToInfo(r rule.HandReading) protocol.HandReading {
+	return protocol.HandReading{
+		HandKind: int(r.Type),
+		KeyRank:  int(r.KeyRank),
+		Soft:     r.Soft,
+	}
+}
+
+// InfoToReading 将 protocol.HandReading 转换为 rule.HandReading
+func InfoToReading(info protocol.HandReading) rule.HandReading {
+	return rule.HandReading{
+		Type:    rule.HandType(info.HandKind),
+		KeyRank: card.Rank(info.KeyRank),
+		Soft:    info.Soft,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 )
 
@@ -55,3 +56,12 @@ func TestEmptyCards(t *testing.T) {
 	cards := InfosToCards([]protocol.CardInfo{})
 	assert.Empty(t, cards)
 }
+
+func TestReadingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := rule.HandReading{Type: rule.Bomb, KeyRank: card.Rank7, Soft: true}
+	info := ReadingToInfo(original)
+	assert.Equal(t, protocol.HandReading{HandKind: int(rule.Bomb), KeyRank: int(card.Rank7), Soft: true}, info)
+	assert.Equal(t, original, InfoToReading(info))
+}
@@ -50,6 +50,7 @@ func PlayerInfoToProto(p *protocol.PlayerInfo) *pb.PlayerInfo {
 		IsLandlord: p.IsLandlord,
 		CardsCount: int64(p.CardsCount),
 		Online:     p.Online,
+		Trustee:    p.Trustee,
 	}
 }
 
@@ -70,6 +71,7 @@ func ProtoToPlayerInfo(pb *pb.PlayerInfo) protocol.PlayerInfo {
 		IsLandlord: pb.IsLandlord,
 		CardsCount: int(pb.CardsCount),
 		Online:     pb.Online,
+		Trustee:    pb.Trustee,
 	}
 }
 
@@ -85,29 +87,47 @@ func ProtoToPlayerInfos(pbs []*pb.PlayerInfo) []protocol.PlayerInfo {
 
 func GameStateDTOToProto(gs *protocol.GameStateDTO) *pb.GameStateDTO {
 	return &pb.GameStateDTO{
-		Phase:        gs.Phase,
-		Players:      PlayerInfosToProto(gs.Players),
-		Hand:         CardsToProto(gs.Hand),
-		BottomCards:  CardsToProto(gs.BottomCards),
-		CurrentTurn:  gs.CurrentTurn,
-		LastPlayed:   CardsToProto(gs.LastPlayed),
-		LastPlayerId: gs.LastPlayerID,
-		MustPlay:     gs.MustPlay,
-		CanBeat:      gs.CanBeat,
+		Phase:         gs.Phase,
+		Players:       PlayerInfosToProto(gs.Players),
+		Hand:          CardsToProto(gs.Hand),
+		BottomCards:   CardsToProto(gs.BottomCards),
+		CurrentTurn:   gs.CurrentTurn,
+		LastPlayed:    CardsToProto(gs.LastPlayed),
+		LastPlayerId:  gs.LastPlayerID,
+		LastHandType:  gs.LastHandType,
+		MustPlay:      gs.MustPlay,
+		CanBeat:       gs.CanBeat,
+		WildRank:      int64(gs.WildRank),
+		Mode:          gs.Mode,
+		RevealedHands: PlayerHandsToProto(gs.RevealedHands),
+		Multiplier:    int64(gs.Multiplier),
+		Breakdown:     MultiplierBreakdownToProto(gs.Breakdown),
+		TimeBank:      int64(gs.TimeBank),
+		LastHandKind:  int64(gs.LastReading.HandKind),
+		LastKeyRank:   int64(gs.LastReading.KeyRank),
+		LastSoft:      gs.LastReading.Soft,
 	}
 }
 
 func ProtoToGameStateDTO(pb *pb.GameStateDTO) *protocol.GameStateDTO {
 	return &protocol.GameStateDTO{
-		Phase:        pb.Phase,
-		Players:      ProtoToPlayerInfos(pb.Players),
-		Hand:         ProtoToCards(pb.Hand),
-		BottomCards:  ProtoToCards(pb.BottomCards),
-		CurrentTurn:  pb.CurrentTurn,
-		LastPlayed:   ProtoToCards(pb.LastPlayed),
-		LastPlayerID: pb.LastPlayerId,
-		MustPlay:     pb.MustPlay,
-		CanBeat:      pb.CanBeat,
+		Phase:         pb.Phase,
+		Players:       ProtoToPlayerInfos(pb.Players),
+		Hand:          ProtoToCards(pb.Hand),
+		BottomCards:   ProtoToCards(pb.BottomCards),
+		CurrentTurn:   pb.CurrentTurn,
+		LastPlayed:    ProtoToCards(pb.LastPlayed),
+		LastPlayerID:  pb.LastPlayerId,
+		LastHandType:  pb.LastHandType,
+		MustPlay:      pb.MustPlay,
+		CanBeat:       pb.CanBeat,
+		WildRank:      int(pb.WildRank),
+		Mode:          pb.Mode,
+		RevealedHands: ProtoToPlayerHands(pb.RevealedHands),
+		Multiplier:    int(pb.Multiplier),
+		Breakdown:     ProtoToMultiplierBreakdown(pb.Breakdown),
+		TimeBank:      int(pb.TimeBank),
+		LastReading:   protocol.HandReading{HandKind: int(pb.LastHandKind), KeyRank: int(pb.LastKeyRank), Soft: pb.LastSoft},
 	}
 }
 
@@ -147,6 +167,8 @@ func PlayerScoresToProto(scores []protocol.PlayerScore) []*pb.PlayerScore {
 			PlayerName: s.PlayerName,
 			IsLandlord: s.IsLandlord,
 			Score:      int64(s.Score),
+			Multiplier: int64(s.Multiplier),
+			Double:     int64(s.Double),
 		}
 	}
 	return result
@@ -160,11 +182,75 @@ func ProtoToPlayerScores(pbs []*pb.PlayerScore) []protocol.PlayerScore {
 			PlayerName: pb.PlayerName,
 			IsLandlord: pb.IsLandlord,
 			Score:      int(pb.Score),
+			Multiplier: int(pb.Multiplier),
+			Double:     int(pb.Double),
 		}
 	}
 	return result
 }
 
+// --- SeriesStanding conversion ---
+
+func SeriesStandingsToProto(standings []protocol.SeriesStanding) []*pb.SeriesStanding {
+	result := make([]*pb.SeriesStanding, len(standings))
+	for i, s := range standings {
+		result[i] = &pb.SeriesStanding{
+			PlayerId:   s.PlayerID,
+			PlayerName: s.PlayerName,
+			Total:      int64(s.Total),
+			Last:       int64(s.Last),
+			Wins:       int64(s.Wins),
+		}
+	}
+	return result
+}
+
+func ProtoToSeriesStandings(pbs []*pb.SeriesStanding) []protocol.SeriesStanding {
+	result := make([]protocol.SeriesStanding, len(pbs))
+	for i, pb := range pbs {
+		result[i] = protocol.SeriesStanding{
+			PlayerID:   pb.PlayerId,
+			PlayerName: pb.PlayerName,
+			Total:      int(pb.Total),
+			Last:       int(pb.Last),
+			Wins:       int(pb.Wins),
+		}
+	}
+	return result
+}
+
+// --- MultiplierBreakdown conversion ---
+
+func MultiplierBreakdownToProto(b *protocol.MultiplierBreakdown) *pb.MultiplierBreakdown {
+	if b == nil {
+		return nil
+	}
+	return &pb.MultiplierBreakdown{
+		Base:        int64(b.Base),
+		GrabCount:   int64(b.GrabCount),
+		ShowHand:    int64(b.ShowHand),
+		BottomBonus: int64(b.BottomBonus),
+		BombCount:   int64(b.BombCount),
+		Spring:      b.Spring,
+		AntiSpring:  b.AntiSpring,
+	}
+}
+
+func ProtoToMultiplierBreakdown(pb *pb.MultiplierBreakdown) *protocol.MultiplierBreakdown {
+	if pb == nil {
+		return nil
+	}
+	return &protocol.MultiplierBreakdown{
+		Base:        int(pb.Base),
+		GrabCount:   int(pb.GrabCount),
+		ShowHand:    int(pb.ShowHand),
+		BottomBonus: int(pb.BottomBonus),
+		BombCount:   int(pb.BombCount),
+		Spring:      pb.Spring,
+		AntiSpring:  pb.AntiSpring,
+	}
+}
+
 // --- Leaderboard conversion ---
 
 func LeaderboardEntriesToProto(entries []protocol.LeaderboardEntry) []*pb.LeaderboardEntry {
@@ -222,3 +308,119 @@ func ProtoToRoomListItems(pbs []*pb.RoomListItem) []protocol.RoomListItem {
 	}
 	return result
 }
+
+// --- Tournament conversion ---
+
+func TournamentInfosToProto(infos []protocol.TournamentInfo) []*pb.TournamentInfo {
+	result := make([]*pb.TournamentInfo, len(infos))
+	for i, t := range infos {
+		result[i] = &pb.TournamentInfo{
+			Id:         t.ID,
+			Name:       t.Name,
+			StartAt:    t.StartAt,
+			Players:    int64(t.Players),
+			Registered: t.Registered,
+			State:      t.State,
+			Round:      int64(t.Round),
+			Hands:      int64(t.Hands),
+		}
+	}
+	return result
+}
+
+func ProtoToTournamentInfos(pbs []*pb.TournamentInfo) []protocol.TournamentInfo {
+	result := make([]protocol.TournamentInfo, len(pbs))
+	for i, pb := range pbs {
+		result[i] = protocol.TournamentInfo{
+			ID:         pb.Id,
+			Name:       pb.Name,
+			StartAt:    pb.StartAt,
+			Players:    int(pb.Players),
+			Registered: pb.Registered,
+			State:      pb.State,
+			Round:      int(pb.Round),
+			Hands:      int(pb.Hands),
+		}
+	}
+	return result
+}
+
+func TournamentStandingsToProto(standings []protocol.TournamentStanding) []*pb.TournamentStanding {
+	result := make([]*pb.TournamentStanding, len(standings))
+	for i, s := range standings {
+		result[i] = &pb.TournamentStanding{
+			PlayerId:   s.PlayerID,
+			PlayerName: s.PlayerName,
+			Total:      int64(s.Total),
+			Round:      int64(s.Round),
+			Eliminated: s.Eliminated,
+		}
+	}
+	return result
+}
+
+func ProtoToTournamentStandings(pbs []*pb.TournamentStanding) []protocol.TournamentStanding {
+	result := make([]protocol.TournamentStanding, len(pbs))
+	for i, pb := range pbs {
+		result[i] = protocol.TournamentStanding{
+			PlayerID:   pb.PlayerId,
+			PlayerName: pb.PlayerName,
+			Total:      int(pb.Total),
+			Round:      int(pb.Round),
+			Eliminated: pb.Eliminated,
+		}
+	}
+	return result
+}
+
+func ShuffleProofToProto(p *protocol.ShuffleProof) *pb.ShuffleProof {
+	if p == nil {
+		return nil
+	}
+	return &pb.ShuffleProof{
+		Mode:        p.Mode,
+		ServerSeed:  p.ServerSeed,
+		ClientSeeds: p.ClientSeeds,
+		Round:       int64(p.Round),
+		Deck:        CardsToProto(p.Deck),
+		Shift:       int64(p.Shift),
+	}
+}
+
+func ProtoToShuffleProof(pb *pb.ShuffleProof) *protocol.ShuffleProof {
+	if pb == nil {
+		return nil
+	}
+	return &protocol.ShuffleProof{
+		Mode:        pb.Mode,
+		ServerSeed:  pb.ServerSeed,
+		ClientSeeds: pb.ClientSeeds,
+		Round:       int(pb.Round),
+		Deck:        ProtoToCards(pb.Deck),
+		Shift:       int(pb.Shift),
+	}
+}
+
+// --- Int slice conversion ---
+
+func IntsToProto(vals []int) []int64 {
+	if len(vals) == 0 {
+		return nil
+	}
+	result := make([]int64, len(vals))
+	for i, v := range vals {
+		result[i] = int64(v)
+	}
+	return result
+}
+
+func ProtoToInts(vals []int64) []int {
+	if len(vals) == 0 {
+		return nil
+	}
+	result := make([]int, len(vals))
+	for i, v := range vals {
+		result[i] = int(v)
+	}
+	return result
+}
@@ -38,7 +38,7 @@ func TestPlayerInfosRoundTrip(t *testing.T) {
 	players := []protocol.PlayerInfo{
 		{ID: "p1", Name: "Player1", Seat: 0, Ready: true, IsLandlord: false, CardsCount: 17, Online: true},
 		{ID: "p2", Name: "Player2", Seat: 1, Ready: true, IsLandlord: true, CardsCount: 20, Online: true},
-		{ID: "p3", Name: "Player3", Seat: 2, Ready: true, IsLandlord: false, CardsCount: 17, Online: false},
+		{ID: "p3", Name: "Player3", Seat: 2, Ready: true, IsLandlord: false, CardsCount: 17, Online: false, Trustee: true},
 	}
 
 	protos := PlayerInfosToProto(players)
@@ -73,6 +73,7 @@ func TestGameStateDTORoundTrip(t *testing.T) {
 		LastPlayerID: "p2",
 		MustPlay:     true,
 		CanBeat:      true,
+		TimeBank:     42,
 	}
 
 	proto := GameStateDTOToProto(gs)
@@ -83,6 +84,7 @@ func TestGameStateDTORoundTrip(t *testing.T) {
 	assert.Equal(t, gs.LastPlayerID, result.LastPlayerID)
 	assert.Equal(t, gs.MustPlay, result.MustPlay)
 	assert.Equal(t, gs.CanBeat, result.CanBeat)
+	assert.Equal(t, gs.TimeBank, result.TimeBank)
 	assert.Len(t, result.Players, len(gs.Players))
 	assert.Len(t, result.Hand, len(gs.Hand))
 	assert.Len(t, result.BottomCards, len(gs.BottomCards))
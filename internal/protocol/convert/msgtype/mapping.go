@@ -26,6 +26,15 @@ var stringToProtoMap = map[string]pb.MessageType{
 	"get_online_count":       pb.MessageType_MSG_GET_ONLINE_COUNT,
 	"get_maintenance_status": pb.MessageType_MSG_GET_MAINTENANCE_STATUS,
 	"chat":                   pb.MessageType_MSG_CHAT,
+	"client_seed":            pb.MessageType_MSG_CLIENT_SEED,
+	"double":                 pb.MessageType_MSG_DOUBLE,
+	"show_hand":              pb.MessageType_MSG_SHOW_HAND,
+	"spectate_room":          pb.MessageType_MSG_SPECTATE_ROOM,
+	"stop_spectating":        pb.MessageType_MSG_STOP_SPECTATING,
+	"trustee":                pb.MessageType_MSG_TRUSTEE,
+	"rematch_request":        pb.MessageType_MSG_REMATCH_REQUEST,
+	"get_tournaments":        pb.MessageType_MSG_GET_TOURNAMENTS,
+	"tournament_register":    pb.MessageType_MSG_TOURNAMENT_REGISTER,
 	"connected":              pb.MessageType_MSG_CONNECTED,
 	"reconnected":            pb.MessageType_MSG_RECONNECTED,
 	"pong":                   pb.MessageType_MSG_PONG,
@@ -53,6 +62,17 @@ var stringToProtoMap = map[string]pb.MessageType{
 	"room_list_result":       pb.MessageType_MSG_ROOM_LIST_RESULT,
 	"maintenance_status":     pb.MessageType_MSG_MAINTENANCE_STATUS,
 	"maintenance":            pb.MessageType_MSG_MAINTENANCE,
+	"double_turn":            pb.MessageType_MSG_DOUBLE_TURN,
+	"double_result":          pb.MessageType_MSG_DOUBLE_RESULT,
+	"hand_revealed":          pb.MessageType_MSG_HAND_REVEALED,
+	"multiplier_update":      pb.MessageType_MSG_MULTIPLIER_UPDATE,
+	"spectate_started":       pb.MessageType_MSG_SPECTATE_STARTED,
+	"spectator_hands":        pb.MessageType_MSG_SPECTATOR_HANDS,
+	"trustee_changed":        pb.MessageType_MSG_TRUSTEE_CHANGED,
+	"series_standings":       pb.MessageType_MSG_SERIES_STANDINGS,
+	"rematch_status":         pb.MessageType_MSG_REMATCH_STATUS,
+	"tournament_list":        pb.MessageType_MSG_TOURNAMENT_LIST,
+	"tournament_standings":   pb.MessageType_MSG_TOURNAMENT_STANDINGS,
 	"error":                  pb.MessageType_MSG_ERROR,
 	"practice_match":         pb.MessageType_MSG_PRACTICE_MATCH,
 }
@@ -77,6 +97,15 @@ var protoToStringMap = map[pb.MessageType]string{
 	pb.MessageType_MSG_GET_ONLINE_COUNT:       "get_online_count",
 	pb.MessageType_MSG_GET_MAINTENANCE_STATUS: "get_maintenance_status",
 	pb.MessageType_MSG_CHAT:                   "chat",
+	pb.MessageType_MSG_CLIENT_SEED:            "client_seed",
+	pb.MessageType_MSG_DOUBLE:                 "double",
+	pb.MessageType_MSG_SHOW_HAND:              "show_hand",
+	pb.MessageType_MSG_SPECTATE_ROOM:          "spectate_room",
+	pb.MessageType_MSG_STOP_SPECTATING:        "stop_spectating",
+	pb.MessageType_MSG_TRUSTEE:                "trustee",
+	pb.MessageType_MSG_REMATCH_REQUEST:        "rematch_request",
+	pb.MessageType_MSG_GET_TOURNAMENTS:        "get_tournaments",
+	pb.MessageType_MSG_TOURNAMENT_REGISTER:    "tournament_register",
 	pb.MessageType_MSG_CONNECTED:              "connected",
 	pb.MessageType_MSG_RECONNECTED:            "reconnected",
 	pb.MessageType_MSG_PONG:                   "pong",
@@ -104,6 +133,17 @@ var protoToStringMap = map[pb.MessageType]string{
 	pb.MessageType_MSG_ROOM_LIST_RESULT:       "room_list_result",
 	pb.MessageType_MSG_MAINTENANCE_STATUS:     "maintenance_status",
 	pb.MessageType_MSG_MAINTENANCE:            "maintenance",
+	pb.MessageType_MSG_DOUBLE_TURN:            "double_turn",
+	pb.MessageType_MSG_DOUBLE_RESULT:          "double_result",
+	pb.MessageType_MSG_HAND_REVEALED:          "hand_revealed",
+	pb.MessageType_MSG_MULTIPLIER_UPDATE:      "multiplier_update",
+	pb.MessageType_MSG_SPECTATE_STARTED:       "spectate_started",
+	pb.MessageType_MSG_SPECTATOR_HANDS:        "spectator_hands",
+	pb.MessageType_MSG_TRUSTEE_CHANGED:        "trustee_changed",
+	pb.MessageType_MSG_SERIES_STANDINGS:       "series_standings",
+	pb.MessageType_MSG_REMATCH_STATUS:         "rematch_status",
+	pb.MessageType_MSG_TOURNAMENT_LIST:        "tournament_list",
+	pb.MessageType_MSG_TOURNAMENT_STANDINGS:   "tournament_standings",
 	pb.MessageType_MSG_ERROR:                  "error",
 	pb.MessageType_MSG_PRACTICE_MATCH:         "practice_match",
 }
@@ -53,6 +53,38 @@ func decodeClientPayload(msgType protocol.MessageType, data []byte, target any)
 			Timestamp: pbMsg.Timestamp,
 		}
 		return true, nil
+	case protocol.MsgCreateRoom:
+		var pbMsg pb.CreateRoomPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.CreateRoomPayload) = protocol.CreateRoomPayload{
+			RuleSet:  pbMsg.RuleSet,
+			Laizi:    pbMsg.Laizi,
+			Mode:     pbMsg.Mode,
+			BidMode:  pbMsg.BidMode,
+			Doubling: pbMsg.Doubling,
+			Hands:    int(pbMsg.Hands),
+		}
+		return true, nil
+	case protocol.MsgClientSeed:
+		var pbMsg pb.ClientSeedPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.ClientSeedPayload) = protocol.ClientSeedPayload{
+			Seed: pbMsg.Seed,
+		}
+		return true, nil
+	case protocol.MsgQuickMatch:
+		var pbMsg pb.QuickMatchPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.QuickMatchPayload) = protocol.QuickMatchPayload{
+			Mode: pbMsg.Mode,
+		}
+		return true, nil
 	case protocol.MsgJoinRoom:
 		var pbMsg pb.JoinRoomPayload
 		if err := proto.Unmarshal(data, &pbMsg); err != nil {
@@ -62,13 +94,61 @@ func decodeClientPayload(msgType protocol.MessageType, data []byte, target any)
 			RoomCode: pbMsg.RoomCode,
 		}
 		return true, nil
+	case protocol.MsgSpectateRoom:
+		var pbMsg pb.SpectateRoomPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.SpectateRoomPayload) = protocol.SpectateRoomPayload{
+			RoomCode: pbMsg.RoomCode,
+		}
+		return true, nil
 	case protocol.MsgBid:
 		var pbMsg pb.BidPayload
 		if err := proto.Unmarshal(data, &pbMsg); err != nil {
 			return true, err
 		}
 		*target.(*protocol.BidPayload) = protocol.BidPayload{
-			Bid: pbMsg.Bid,
+			Bid:   pbMsg.Bid,
+			Score: int(pbMsg.Score),
+		}
+		return true, nil
+	case protocol.MsgDouble:
+		var pbMsg pb.DoublePayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.DoublePayload) = protocol.DoublePayload{
+			Level: int(pbMsg.Level),
+		}
+		return true, nil
+	case protocol.MsgTrustee:
+		var pbMsg pb.TrusteePayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.TrusteePayload) = protocol.TrusteePayload{
+			Enabled: pbMsg.Enabled,
+		}
+		return true, nil
+	case protocol.MsgRematchRequest:
+		var pbMsg pb.RematchRequestPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.RematchRequestPayload) = protocol.RematchRequestPayload{
+			RoomCode: pbMsg.RoomCode,
+			Accept:   pbMsg.Accept,
+		}
+		return true, nil
+	case protocol.MsgTournamentRegister:
+		var pbMsg pb.TournamentRegisterPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.TournamentRegisterPayload) = protocol.TournamentRegisterPayload{
+			TournamentID: pbMsg.TournamentId,
+			Register:     pbMsg.Register,
 		}
 		return true, nil
 	case protocol.MsgPlayCards:
@@ -77,7 +157,8 @@ func decodeClientPayload(msgType protocol.MessageType, data []byte, target any)
 			return true, err
 		}
 		*target.(*protocol.PlayCardsPayload) = protocol.PlayCardsPayload{
-			Cards: convert.ProtoToCards(pbMsg.Cards),
+			Cards:   convert.ProtoToCards(pbMsg.Cards),
+			Reading: protocol.HandReading{HandKind: int(pbMsg.HandKind), KeyRank: int(pbMsg.KeyRank), Soft: pbMsg.Soft},
 		}
 		return true, nil
 	case protocol.MsgGetLeaderboard:
@@ -310,8 +391,9 @@ func decodeRoomStateMessages(msgType protocol.MessageType, data []byte, target a
 			return true, err
 		}
 		*target.(*protocol.RoomCreatedPayload) = protocol.RoomCreatedPayload{
-			RoomCode: pbMsg.RoomCode,
-			Player:   convert.ProtoToPlayerInfo(pbMsg.Player),
+			RoomCode:   pbMsg.RoomCode,
+			Player:     convert.ProtoToPlayerInfo(pbMsg.Player),
+			SeedCommit: pbMsg.SeedCommit,
 		}
 		return true, nil
 	case protocol.MsgRoomJoined:
@@ -320,9 +402,10 @@ func decodeRoomStateMessages(msgType protocol.MessageType, data []byte, target a
 			return true, err
 		}
 		*target.(*protocol.RoomJoinedPayload) = protocol.RoomJoinedPayload{
-			RoomCode: pbMsg.RoomCode,
-			Player:   convert.ProtoToPlayerInfo(pbMsg.Player),
-			Players:  convert.ProtoToPlayerInfos(pbMsg.Players),
+			RoomCode:   pbMsg.RoomCode,
+			Player:     convert.ProtoToPlayerInfo(pbMsg.Player),
+			Players:    convert.ProtoToPlayerInfos(pbMsg.Players),
+			SeedCommit: pbMsg.SeedCommit,
 		}
 		return true, nil
 	case protocol.MsgRoomListResult:
@@ -334,6 +417,43 @@ func decodeRoomStateMessages(msgType protocol.MessageType, data []byte, target a
 			Rooms: convert.ProtoToRoomListItems(pbMsg.Rooms),
 		}
 		return true, nil
+	case protocol.MsgTournamentList:
+		var pbMsg pb.TournamentListPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.TournamentListPayload) = protocol.TournamentListPayload{
+			Tournaments: convert.ProtoToTournamentInfos(pbMsg.Tournaments),
+		}
+		return true, nil
+	case protocol.MsgTournamentStandings:
+		var pbMsg pb.TournamentStandingsPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.TournamentStandingsPayload) = protocol.TournamentStandingsPayload{
+			TournamentID: pbMsg.TournamentId,
+			Name:         pbMsg.Name,
+			Round:        int(pbMsg.Round),
+			Standings:    convert.ProtoToTournamentStandings(pbMsg.Standings),
+			Final:        pbMsg.Final,
+		}
+		return true, nil
+	case protocol.MsgSpectateStarted:
+		var pbMsg pb.SpectateStartedPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		var gameState *protocol.GameStateDTO
+		if pbMsg.GameState != nil {
+			gameState = convert.ProtoToGameStateDTO(pbMsg.GameState)
+		}
+		*target.(*protocol.SpectateStartedPayload) = protocol.SpectateStartedPayload{
+			RoomCode:  pbMsg.RoomCode,
+			GameState: gameState,
+			HandDelay: int(pbMsg.HandDelay),
+		}
+		return true, nil
 	}
 	return false, nil
 }
@@ -355,7 +475,13 @@ func decodeBiddingMessages(msgType protocol.MessageType, data []byte, target any
 			return true, err
 		}
 		*target.(*protocol.GameStartPayload) = protocol.GameStartPayload{
-			Players: convert.ProtoToPlayerInfos(pbMsg.Players),
+			Players:    convert.ProtoToPlayerInfos(pbMsg.Players),
+			RuleSet:    pbMsg.RuleSet,
+			Mode:       pbMsg.Mode,
+			SeedCommit: pbMsg.SeedCommit,
+			BidMode:    pbMsg.BidMode,
+			Hand:       int(pbMsg.Hand),
+			Hands:      int(pbMsg.Hands),
 		}
 		return true, nil
 	case protocol.MsgDealCards:
@@ -366,6 +492,7 @@ func decodeBiddingMessages(msgType protocol.MessageType, data []byte, target any
 		*target.(*protocol.DealCardsPayload) = protocol.DealCardsPayload{
 			Cards:       convert.ProtoToCards(pbMsg.Cards),
 			BottomCards: convert.ProtoToCards(pbMsg.BottomCards),
+			WildRank:    int(pbMsg.WildRank),
 		}
 		return true, nil
 	case protocol.MsgBidTurn:
@@ -378,6 +505,7 @@ func decodeBiddingMessages(msgType protocol.MessageType, data []byte, target any
 			Timeout:    int(pbMsg.Timeout),
 			IsGrab:     pbMsg.IsGrab,
 			Multiplier: int(pbMsg.Multiplier),
+			Options:    convert.ProtoToInts(pbMsg.Options),
 		}
 		return true, nil
 	case protocol.MsgBidResult:
@@ -391,6 +519,52 @@ func decodeBiddingMessages(msgType protocol.MessageType, data []byte, target any
 			Bid:        pbMsg.Bid,
 			IsGrab:     pbMsg.IsGrab,
 			Multiplier: int(pbMsg.Multiplier),
+			Score:      int(pbMsg.Score),
+		}
+		return true, nil
+	case protocol.MsgDoubleTurn:
+		var pbMsg pb.DoubleTurnPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.DoubleTurnPayload) = protocol.DoubleTurnPayload{
+			Timeout:    int(pbMsg.Timeout),
+			Multiplier: int(pbMsg.Multiplier),
+			Pending:    pbMsg.Pending,
+		}
+		return true, nil
+	case protocol.MsgDoubleResult:
+		var pbMsg pb.DoubleResultPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.DoubleResultPayload) = protocol.DoubleResultPayload{
+			PlayerID:   pbMsg.PlayerId,
+			PlayerName: pbMsg.PlayerName,
+			Level:      int(pbMsg.Level),
+		}
+		return true, nil
+	case protocol.MsgHandRevealed:
+		var pbMsg pb.HandRevealedPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.HandRevealedPayload) = protocol.HandRevealedPayload{
+			PlayerID:   pbMsg.PlayerId,
+			PlayerName: pbMsg.PlayerName,
+			Cards:      convert.ProtoToCards(pbMsg.Cards),
+			Multiplier: int(pbMsg.Multiplier),
+		}
+		return true, nil
+	case protocol.MsgTrusteeChanged:
+		var pbMsg pb.TrusteeChangedPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.TrusteeChangedPayload) = protocol.TrusteeChangedPayload{
+			PlayerID:   pbMsg.PlayerId,
+			PlayerName: pbMsg.PlayerName,
+			Enabled:    pbMsg.Enabled,
 		}
 		return true, nil
 	case protocol.MsgLandlord:
@@ -399,10 +573,12 @@ func decodeBiddingMessages(msgType protocol.MessageType, data []byte, target any
 			return true, err
 		}
 		*target.(*protocol.LandlordPayload) = protocol.LandlordPayload{
-			PlayerID:    pbMsg.PlayerId,
-			PlayerName:  pbMsg.PlayerName,
-			BottomCards: convert.ProtoToCards(pbMsg.BottomCards),
-			Multiplier:  int(pbMsg.Multiplier),
+			PlayerID:      pbMsg.PlayerId,
+			PlayerName:    pbMsg.PlayerName,
+			BottomCards:   convert.ProtoToCards(pbMsg.BottomCards),
+			Multiplier:    int(pbMsg.Multiplier),
+			BottomBonus:   int(pbMsg.BottomBonus),
+			BottomPattern: pbMsg.BottomPattern,
 		}
 		return true, nil
 	}
@@ -422,6 +598,7 @@ func decodePlayingMessages(msgType protocol.MessageType, data []byte, target any
 			Timeout:  int(pbMsg.Timeout),
 			MustPlay: pbMsg.MustPlay,
 			CanBeat:  pbMsg.CanBeat,
+			TimeBank: int(pbMsg.TimeBank),
 		}
 		return true, nil
 	case protocol.MsgCardPlayed:
@@ -435,6 +612,7 @@ func decodePlayingMessages(msgType protocol.MessageType, data []byte, target any
 			Cards:      convert.ProtoToCards(pbMsg.Cards),
 			CardsLeft:  int(pbMsg.CardsLeft),
 			HandType:   pbMsg.HandType,
+			Reading:    protocol.HandReading{HandKind: int(pbMsg.HandKind), KeyRank: int(pbMsg.KeyRank), Soft: pbMsg.Soft},
 		}
 		return true, nil
 	case protocol.MsgPlayerPass:
@@ -447,18 +625,64 @@ func decodePlayingMessages(msgType protocol.MessageType, data []byte, target any
 			PlayerName: pbMsg.PlayerName,
 		}
 		return true, nil
+	case protocol.MsgMultiplierUpdate:
+		var pbMsg pb.MultiplierUpdatePayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.MultiplierUpdatePayload) = protocol.MultiplierUpdatePayload{
+			Multiplier: int(pbMsg.Multiplier),
+			Breakdown:  convert.ProtoToMultiplierBreakdown(pbMsg.Breakdown),
+		}
+		return true, nil
+	case protocol.MsgSpectatorHands:
+		var pbMsg pb.SpectatorHandsPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.SpectatorHandsPayload) = protocol.SpectatorHandsPayload{
+			Hands: convert.ProtoToPlayerHands(pbMsg.Hands),
+		}
+		return true, nil
 	case protocol.MsgGameOver:
 		var pbMsg pb.GameOverPayload
 		if err := proto.Unmarshal(data, &pbMsg); err != nil {
 			return true, err
 		}
 		*target.(*protocol.GameOverPayload) = protocol.GameOverPayload{
-			WinnerID:    pbMsg.WinnerId,
-			WinnerName:  pbMsg.WinnerName,
-			IsLandlord:  pbMsg.IsLandlord,
-			PlayerHands: convert.ProtoToPlayerHands(pbMsg.PlayerHands),
-			Multiplier:  int(pbMsg.Multiplier),
-			Scores:      convert.ProtoToPlayerScores(pbMsg.Scores),
+			WinnerID:       pbMsg.WinnerId,
+			WinnerName:     pbMsg.WinnerName,
+			IsLandlord:     pbMsg.IsLandlord,
+			PlayerHands:    convert.ProtoToPlayerHands(pbMsg.PlayerHands),
+			Multiplier:     int(pbMsg.Multiplier),
+			Scores:         convert.ProtoToPlayerScores(pbMsg.Scores),
+			Proof:          convert.ProtoToShuffleProof(pbMsg.Proof),
+			Breakdown:      convert.ProtoToMultiplierBreakdown(pbMsg.Breakdown),
+			NextSeedCommit: pbMsg.NextSeedCommit,
+		}
+		return true, nil
+	case protocol.MsgSeriesStandings:
+		var pbMsg pb.SeriesStandingsPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.SeriesStandingsPayload) = protocol.SeriesStandingsPayload{
+			Hand:      int(pbMsg.Hand),
+			Hands:     int(pbMsg.Hands),
+			Standings: convert.ProtoToSeriesStandings(pbMsg.Standings),
+			Final:     pbMsg.Final,
+		}
+		return true, nil
+	case protocol.MsgRematchStatus:
+		var pbMsg pb.RematchStatusPayload
+		if err := proto.Unmarshal(data, &pbMsg); err != nil {
+			return true, err
+		}
+		*target.(*protocol.RematchStatusPayload) = protocol.RematchStatusPayload{
+			RoomCode: pbMsg.RoomCode,
+			Agreed:   pbMsg.Agreed,
+			Timeout:  int(pbMsg.Timeout),
+			Result:   pbMsg.Result,
 		}
 		return true, nil
 	}
@@ -57,20 +57,71 @@ func encodeClientRequests(msgType protocol.MessageType, payload any) (proto.Mess
 		return &pb.PingPayload{
 			Timestamp: p.Timestamp,
 		}, true
+	case protocol.MsgCreateRoom:
+		p := payload.(protocol.CreateRoomPayload)
+		return &pb.CreateRoomPayload{
+			RuleSet:  p.RuleSet,
+			Laizi:    p.Laizi,
+			Mode:     p.Mode,
+			BidMode:  p.BidMode,
+			Doubling: p.Doubling,
+			Hands:    int64(p.Hands),
+		}, true
+	case protocol.MsgClientSeed:
+		p := payload.(protocol.ClientSeedPayload)
+		return &pb.ClientSeedPayload{
+			Seed: p.Seed,
+		}, true
+	case protocol.MsgQuickMatch:
+		p := payload.(protocol.QuickMatchPayload)
+		return &pb.QuickMatchPayload{
+			Mode: p.Mode,
+		}, true
 	case protocol.MsgJoinRoom:
 		p := payload.(protocol.JoinRoomPayload)
 		return &pb.JoinRoomPayload{
 			RoomCode: p.RoomCode,
 		}, true
+	case protocol.MsgSpectateRoom:
+		p := payload.(protocol.SpectateRoomPayload)
+		return &pb.SpectateRoomPayload{
+			RoomCode: p.RoomCode,
+		}, true
 	case protocol.MsgBid:
 		p := payload.(protocol.BidPayload)
 		return &pb.BidPayload{
-			Bid: p.Bid,
+			Bid:   p.Bid,
+			Score: int64(p.Score),
+		}, true
+	case protocol.MsgDouble:
+		p := payload.(protocol.DoublePayload)
+		return &pb.DoublePayload{
+			Level: int64(p.Level),
+		}, true
+	case protocol.MsgTrustee:
+		p := payload.(protocol.TrusteePayload)
+		return &pb.TrusteePayload{
+			Enabled: p.Enabled,
+		}, true
+	case protocol.MsgRematchRequest:
+		p := payload.(protocol.RematchRequestPayload)
+		return &pb.RematchRequestPayload{
+			RoomCode: p.RoomCode,
+			Accept:   p.Accept,
+		}, true
+	case protocol.MsgTournamentRegister:
+		p := payload.(protocol.TournamentRegisterPayload)
+		return &pb.TournamentRegisterPayload{
+			TournamentId: p.TournamentID,
+			Register:     p.Register,
 		}, true
 	case protocol.MsgPlayCards:
 		p := payload.(protocol.PlayCardsPayload)
 		return &pb.PlayCardsPayload{
-			Cards: convert.CardsToProto(p.Cards),
+			Cards:    convert.CardsToProto(p.Cards),
+			HandKind: int64(p.Reading.HandKind),
+			KeyRank:  int64(p.Reading.KeyRank),
+			Soft:     p.Reading.Soft,
 		}, true
 	case protocol.MsgGetLeaderboard:
 		p := payload.(protocol.GetLeaderboardPayload)
@@ -182,15 +233,17 @@ func encodeServerRoomMessages(msgType protocol.MessageType, payload any) (proto.
 	case protocol.MsgRoomCreated:
 		p := payload.(protocol.RoomCreatedPayload)
 		return &pb.RoomCreatedPayload{
-			RoomCode: p.RoomCode,
-			Player:   convert.PlayerInfoToProto(&p.Player),
+			RoomCode:   p.RoomCode,
+			Player:     convert.PlayerInfoToProto(&p.Player),
+			SeedCommit: p.SeedCommit,
 		}, true
 	case protocol.MsgRoomJoined:
 		p := payload.(protocol.RoomJoinedPayload)
 		return &pb.RoomJoinedPayload{
-			RoomCode: p.RoomCode,
-			Player:   convert.PlayerInfoToProto(&p.Player),
-			Players:  convert.PlayerInfosToProto(p.Players),
+			RoomCode:   p.RoomCode,
+			Player:     convert.PlayerInfoToProto(&p.Player),
+			Players:    convert.PlayerInfosToProto(p.Players),
+			SeedCommit: p.SeedCommit,
 		}, true
 	case protocol.MsgPlayerJoined:
 		p := payload.(protocol.PlayerJoinedPayload)
@@ -214,6 +267,31 @@ func encodeServerRoomMessages(msgType protocol.MessageType, payload any) (proto.
 		return &pb.RoomListResultPayload{
 			Rooms: convert.RoomListItemsToProto(p.Rooms),
 		}, true
+	case protocol.MsgTournamentList:
+		p := payload.(protocol.TournamentListPayload)
+		return &pb.TournamentListPayload{
+			Tournaments: convert.TournamentInfosToProto(p.Tournaments),
+		}, true
+	case protocol.MsgTournamentStandings:
+		p := payload.(protocol.TournamentStandingsPayload)
+		return &pb.TournamentStandingsPayload{
+			TournamentId: p.TournamentID,
+			Name:         p.Name,
+			Round:        int64(p.Round),
+			Standings:    convert.TournamentStandingsToProto(p.Standings),
+			Final:        p.Final,
+		}, true
+	case protocol.MsgSpectateStarted:
+		p := payload.(protocol.SpectateStartedPayload)
+		var gameState *pb.GameStateDTO
+		if p.GameState != nil {
+			gameState = convert.GameStateDTOToProto(p.GameState)
+		}
+		return &pb.SpectateStartedPayload{
+			RoomCode:  p.RoomCode,
+			GameState: gameState,
+			HandDelay: int64(p.HandDelay),
+		}, true
 	}
 	return nil, false
 }
@@ -224,13 +302,20 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 	case protocol.MsgGameStart:
 		p := payload.(protocol.GameStartPayload)
 		return &pb.GameStartPayload{
-			Players: convert.PlayerInfosToProto(p.Players),
+			Players:    convert.PlayerInfosToProto(p.Players),
+			RuleSet:    p.RuleSet,
+			Mode:       p.Mode,
+			SeedCommit: p.SeedCommit,
+			BidMode:    p.BidMode,
+			Hand:       int64(p.Hand),
+			Hands:      int64(p.Hands),
 		}, true
 	case protocol.MsgDealCards:
 		p := payload.(protocol.DealCardsPayload)
 		return &pb.DealCardsPayload{
 			Cards:       convert.CardsToProto(p.Cards),
 			BottomCards: convert.CardsToProto(p.BottomCards),
+			WildRank:    int64(p.WildRank),
 		}, true
 	case protocol.MsgBidTurn:
 		p := payload.(protocol.BidTurnPayload)
@@ -239,6 +324,7 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 			Timeout:    int64(p.Timeout),
 			IsGrab:     p.IsGrab,
 			Multiplier: int64(p.Multiplier),
+			Options:    convert.IntsToProto(p.Options),
 		}, true
 	case protocol.MsgBidResult:
 		p := payload.(protocol.BidResultPayload)
@@ -248,14 +334,46 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 			Bid:        p.Bid,
 			IsGrab:     p.IsGrab,
 			Multiplier: int64(p.Multiplier),
+			Score:      int64(p.Score),
+		}, true
+	case protocol.MsgDoubleTurn:
+		p := payload.(protocol.DoubleTurnPayload)
+		return &pb.DoubleTurnPayload{
+			Timeout:    int64(p.Timeout),
+			Multiplier: int64(p.Multiplier),
+			Pending:    p.Pending,
+		}, true
+	case protocol.MsgDoubleResult:
+		p := payload.(protocol.DoubleResultPayload)
+		return &pb.DoubleResultPayload{
+			PlayerId:   p.PlayerID,
+			PlayerName: p.PlayerName,
+			Level:      int64(p.Level),
+		}, true
+	case protocol.MsgHandRevealed:
+		p := payload.(protocol.HandRevealedPayload)
+		return &pb.HandRevealedPayload{
+			PlayerId:   p.PlayerID,
+			PlayerName: p.PlayerName,
+			Cards:      convert.CardsToProto(p.Cards),
+			Multiplier: int64(p.Multiplier),
+		}, true
+	case protocol.MsgTrusteeChanged:
+		p := payload.(protocol.TrusteeChangedPayload)
+		return &pb.TrusteeChangedPayload{
+			PlayerId:   p.PlayerID,
+			PlayerName: p.PlayerName,
+			Enabled:    p.Enabled,
 		}, true
 	case protocol.MsgLandlord:
 		p := payload.(protocol.LandlordPayload)
 		return &pb.LandlordPayload{
-			PlayerId:    p.PlayerID,
-			PlayerName:  p.PlayerName,
-			BottomCards: convert.CardsToProto(p.BottomCards),
-			Multiplier:  int64(p.Multiplier),
+			PlayerId:      p.PlayerID,
+			PlayerName:    p.PlayerName,
+			BottomCards:   convert.CardsToProto(p.BottomCards),
+			Multiplier:    int64(p.Multiplier),
+			BottomBonus:   int64(p.BottomBonus),
+			BottomPattern: p.BottomPattern,
 		}, true
 	case protocol.MsgPlayTurn:
 		p := payload.(protocol.PlayTurnPayload)
@@ -264,6 +382,7 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 			Timeout:  int64(p.Timeout),
 			MustPlay: p.MustPlay,
 			CanBeat:  p.CanBeat,
+			TimeBank: int64(p.TimeBank),
 		}, true
 	case protocol.MsgCardPlayed:
 		p := payload.(protocol.CardPlayedPayload)
@@ -273,6 +392,9 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 			Cards:      convert.CardsToProto(p.Cards),
 			CardsLeft:  int64(p.CardsLeft),
 			HandType:   p.HandType,
+			HandKind:   int64(p.Reading.HandKind),
+			KeyRank:    int64(p.Reading.KeyRank),
+			Soft:       p.Reading.Soft,
 		}, true
 	case protocol.MsgPlayerPass:
 		p := payload.(protocol.PlayerPassPayload)
@@ -280,15 +402,45 @@ func encodeServerGameMessages(msgType protocol.MessageType, payload any) (proto.
 			PlayerId:   p.PlayerID,
 			PlayerName: p.PlayerName,
 		}, true
+	case protocol.MsgMultiplierUpdate:
+		p := payload.(protocol.MultiplierUpdatePayload)
+		return &pb.MultiplierUpdatePayload{
+			Multiplier: int64(p.Multiplier),
+			Breakdown:  convert.MultiplierBreakdownToProto(p.Breakdown),
+		}, true
+	case protocol.MsgSpectatorHands:
+		p := payload.(protocol.SpectatorHandsPayload)
+		return &pb.SpectatorHandsPayload{
+			Hands: convert.PlayerHandsToProto(p.Hands),
+		}, true
 	case protocol.MsgGameOver:
 		p := payload.(protocol.GameOverPayload)
 		return &pb.GameOverPayload{
-			WinnerId:    p.WinnerID,
-			WinnerName:  p.WinnerName,
-			IsLandlord:  p.IsLandlord,
-			PlayerHands: convert.PlayerHandsToProto(p.PlayerHands),
-			Multiplier:  int64(p.Multiplier),
-			Scores:      convert.PlayerScoresToProto(p.Scores),
+			WinnerId:       p.WinnerID,
+			WinnerName:     p.WinnerName,
+			IsLandlord:     p.IsLandlord,
+			PlayerHands:    convert.PlayerHandsToProto(p.PlayerHands),
+			Multiplier:     int64(p.Multiplier),
+			Scores:         convert.PlayerScoresToProto(p.Scores),
+			Proof:          convert.ShuffleProofToProto(p.Proof),
+			Breakdown:      convert.MultiplierBreakdownToProto(p.Breakdown),
+			NextSeedCommit: p.NextSeedCommit,
+		}, true
+	case protocol.MsgSeriesStandings:
+		p := payload.(protocol.SeriesStandingsPayload)
+		return &pb.SeriesStandingsPayload{
+			Hand:      int64(p.Hand),
+			Hands:     int64(p.Hands),
+			Standings: convert.SeriesStandingsToProto(p.Standings),
+			Final:     p.Final,
+		}, true
+	case protocol.MsgRematchStatus:
+		p := payload.(protocol.RematchStatusPayload)
+		return &pb.RematchStatusPayload{
+			RoomCode: p.RoomCode,
+			Agreed:   p.Agreed,
+			Timeout:  int64(p.Timeout),
+			Result:   p.Result,
 		}, true
 	}
 	return nil, false
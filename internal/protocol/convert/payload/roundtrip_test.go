@@ -72,9 +72,79 @@ func TestPayloadRoundTrip_ClientRequests(t *testing.T) {
 		assert.Equal(t, original.RoomCode, result.RoomCode)
 	})
 
+	t.Run("Trustee", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.TrusteePayload{Enabled: true}
+
+		data, err := EncodePayload(protocol.MsgTrustee, original)
+		require.NoError(t, err)
+
+		var result protocol.TrusteePayload
+		err = DecodePayload(protocol.MsgTrustee, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("RematchRequest", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.RematchRequestPayload{RoomCode: "123456", Accept: true}
+
+		data, err := EncodePayload(protocol.MsgRematchRequest, original)
+		require.NoError(t, err)
+
+		var result protocol.RematchRequestPayload
+		err = DecodePayload(protocol.MsgRematchRequest, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("CreateRoom", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.CreateRoomPayload{RuleSet: "short", Laizi: true, Mode: "four", BidMode: "score", Doubling: true, Hands: 5}
+
+		data, err := EncodePayload(protocol.MsgCreateRoom, original)
+		require.NoError(t, err)
+
+		var result protocol.CreateRoomPayload
+		err = DecodePayload(protocol.MsgCreateRoom, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("QuickMatch", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.QuickMatchPayload{Mode: "four"}
+
+		data, err := EncodePayload(protocol.MsgQuickMatch, original)
+		require.NoError(t, err)
+
+		var result protocol.QuickMatchPayload
+		err = DecodePayload(protocol.MsgQuickMatch, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("ClientSeed", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.ClientSeedPayload{Seed: "0123abcd"}
+
+		data, err := EncodePayload(protocol.MsgClientSeed, original)
+		require.NoError(t, err)
+
+		var result protocol.ClientSeedPayload
+		err = DecodePayload(protocol.MsgClientSeed, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
 	t.Run("Bid", func(t *testing.T) {
 		t.Parallel()
-		original := protocol.BidPayload{Bid: true}
+		original := protocol.BidPayload{Bid: true, Score: 2}
 
 		data, err := EncodePayload(protocol.MsgBid, original)
 		require.NoError(t, err)
@@ -84,11 +154,29 @@ func TestPayloadRoundTrip_ClientRequests(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.True(t, result.Bid)
+		assert.Equal(t, 2, result.Score)
+	})
+
+	t.Run("Double", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.DoublePayload{Level: 1}
+
+		data, err := EncodePayload(protocol.MsgDouble, original)
+		require.NoError(t, err)
+
+		var result protocol.DoublePayload
+		err = DecodePayload(protocol.MsgDouble, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
 	})
 
 	t.Run("PlayCards", func(t *testing.T) {
 		t.Parallel()
-		original := protocol.PlayCardsPayload{Cards: []protocol.CardInfo{{Suit: 0, Rank: 3, Color: 0}}}
+		original := protocol.PlayCardsPayload{
+			Cards:   []protocol.CardInfo{{Suit: 0, Rank: 3, Color: 0}},
+			Reading: protocol.HandReading{HandKind: 15, KeyRank: 3, Soft: true},
+		}
 
 		data, err := EncodePayload(protocol.MsgPlayCards, original)
 		require.NoError(t, err)
@@ -99,6 +187,7 @@ func TestPayloadRoundTrip_ClientRequests(t *testing.T) {
 
 		require.Len(t, result.Cards, 1)
 		assert.Equal(t, 3, result.Cards[0].Rank)
+		assert.Equal(t, original.Reading, result.Reading)
 	})
 
 	t.Run("GetLeaderboard", func(t *testing.T) {
@@ -181,6 +270,7 @@ func TestPayloadRoundTrip_ServerResponses(t *testing.T) {
 				Name: "Player1",
 				Seat: 0,
 			},
+			SeedCommit: "c0ffee",
 		}
 
 		data, err := EncodePayload(protocol.MsgRoomCreated, original)
@@ -192,6 +282,7 @@ func TestPayloadRoundTrip_ServerResponses(t *testing.T) {
 
 		assert.Equal(t, original.RoomCode, result.RoomCode)
 		assert.Equal(t, original.Player.ID, result.Player.ID)
+		assert.Equal(t, original.SeedCommit, result.SeedCommit)
 	})
 
 	t.Run("Error", func(t *testing.T) {
@@ -224,6 +315,12 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 				{ID: "p2", Name: "Player2", Seat: 1},
 				{ID: "p3", Name: "Player3", Seat: 2},
 			},
+			RuleSet:    "no_kickers",
+			Mode:       "four",
+			SeedCommit: "deadbeef",
+			BidMode:    "score",
+			Hand:       2,
+			Hands:      5,
 		}
 
 		data, err := EncodePayload(protocol.MsgGameStart, original)
@@ -235,6 +332,10 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 
 		require.Len(t, result.Players, 3)
 		assert.Equal(t, "p1", result.Players[0].ID)
+		assert.Equal(t, original.RuleSet, result.RuleSet)
+		assert.Equal(t, original.Mode, result.Mode)
+		assert.Equal(t, original.SeedCommit, result.SeedCommit)
+		assert.Equal(t, original.BidMode, result.BidMode)
 	})
 
 	t.Run("DealCards", func(t *testing.T) {
@@ -247,6 +348,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			BottomCards: []protocol.CardInfo{
 				{Suit: 2, Rank: 5, Color: 0},
 			},
+			WildRank: 7,
 		}
 
 		data, err := EncodePayload(protocol.MsgDealCards, original)
@@ -258,6 +360,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 
 		assert.Len(t, result.Cards, 2)
 		assert.Len(t, result.BottomCards, 1)
+		assert.Equal(t, 7, result.WildRank)
 	})
 
 	t.Run("BidTurn", func(t *testing.T) {
@@ -267,6 +370,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			Timeout:    30,
 			IsGrab:     true,
 			Multiplier: 2,
+			Options:    []int{2, 3},
 		}
 
 		data, err := EncodePayload(protocol.MsgBidTurn, original)
@@ -280,6 +384,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 		assert.Equal(t, original.Timeout, result.Timeout)
 		assert.True(t, result.IsGrab)
 		assert.Equal(t, 2, result.Multiplier)
+		assert.Equal(t, original.Options, result.Options)
 	})
 
 	t.Run("BidResult", func(t *testing.T) {
@@ -290,6 +395,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			Bid:        true,
 			IsGrab:     true,
 			Multiplier: 4,
+			Score:      3,
 		}
 
 		data, err := EncodePayload(protocol.MsgBidResult, original)
@@ -303,15 +409,125 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 		assert.True(t, result.Bid)
 		assert.True(t, result.IsGrab)
 		assert.Equal(t, 4, result.Multiplier)
+		assert.Equal(t, 3, result.Score)
+	})
+
+	t.Run("DoubleTurn", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.DoubleTurnPayload{Timeout: 10, Multiplier: 3, Pending: []string{"p1", "p3"}}
+
+		data, err := EncodePayload(protocol.MsgDoubleTurn, original)
+		require.NoError(t, err)
+
+		var result protocol.DoubleTurnPayload
+		err = DecodePayload(protocol.MsgDoubleTurn, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("DoubleResult", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.DoubleResultPayload{PlayerID: "p1", PlayerName: "Player1", Level: 2}
+
+		data, err := EncodePayload(protocol.MsgDoubleResult, original)
+		require.NoError(t, err)
+
+		var result protocol.DoubleResultPayload
+		err = DecodePayload(protocol.MsgDoubleResult, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("HandRevealed", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.HandRevealedPayload{
+			PlayerID:   "p2",
+			PlayerName: "Player2",
+			Cards:      []protocol.CardInfo{{Suit: 0, Rank: 14}, {Suit: 2, Rank: 3}},
+			Multiplier: 5,
+		}
+
+		data, err := EncodePayload(protocol.MsgHandRevealed, original)
+		require.NoError(t, err)
+
+		var result protocol.HandRevealedPayload
+		err = DecodePayload(protocol.MsgHandRevealed, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("TrusteeChanged", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.TrusteeChangedPayload{
+			PlayerID:   "p3",
+			PlayerName: "Player3",
+			Enabled:    true,
+		}
+
+		data, err := EncodePayload(protocol.MsgTrusteeChanged, original)
+		require.NoError(t, err)
+
+		var result protocol.TrusteeChangedPayload
+		err = DecodePayload(protocol.MsgTrusteeChanged, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("SeriesStandings", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.SeriesStandingsPayload{
+			Hand:  3,
+			Hands: 3,
+			Standings: []protocol.SeriesStanding{
+				{PlayerID: "p2", PlayerName: "Player2", Total: 12, Last: 4, Wins: 2},
+				{PlayerID: "p1", PlayerName: "Player1", Total: -4, Last: -8, Wins: 1},
+				{PlayerID: "p3", PlayerName: "Player3", Total: -8, Last: 4, Wins: 1},
+			},
+			Final: true,
+		}
+
+		data, err := EncodePayload(protocol.MsgSeriesStandings, original)
+		require.NoError(t, err)
+
+		var result protocol.SeriesStandingsPayload
+		err = DecodePayload(protocol.MsgSeriesStandings, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("RematchStatus", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.RematchStatusPayload{
+			RoomCode: "123456",
+			Agreed:   []string{"p1", "p3"},
+			Timeout:  12,
+			Result:   protocol.RematchPending,
+		}
+
+		data, err := EncodePayload(protocol.MsgRematchStatus, original)
+		require.NoError(t, err)
+
+		var result protocol.RematchStatusPayload
+		err = DecodePayload(protocol.MsgRematchStatus, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
 	})
 
 	t.Run("Landlord", func(t *testing.T) {
 		t.Parallel()
 		original := protocol.LandlordPayload{
-			PlayerID:    "p1",
-			PlayerName:  "Player1",
-			BottomCards: []protocol.CardInfo{{Suit: 0, Rank: 3, Color: 0}},
-			Multiplier:  3,
+			PlayerID:      "p1",
+			PlayerName:    "Player1",
+			BottomCards:   []protocol.CardInfo{{Suit: 0, Rank: 3, Color: 0}},
+			Multiplier:    9,
+			BottomBonus:   3,
+			BottomPattern: "同花",
 		}
 
 		data, err := EncodePayload(protocol.MsgLandlord, original)
@@ -323,7 +539,26 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 
 		assert.Equal(t, original.PlayerID, result.PlayerID)
 		assert.Len(t, result.BottomCards, 1)
-		assert.Equal(t, 3, result.Multiplier)
+		assert.Equal(t, 9, result.Multiplier)
+		assert.Equal(t, 3, result.BottomBonus)
+		assert.Equal(t, "同花", result.BottomPattern)
+	})
+
+	t.Run("MultiplierUpdate", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.MultiplierUpdatePayload{
+			Multiplier: 60,
+			Breakdown:  &protocol.MultiplierBreakdown{Base: 3, ShowHand: 5, BottomBonus: 2, BombCount: 1},
+		}
+
+		data, err := EncodePayload(protocol.MsgMultiplierUpdate, original)
+		require.NoError(t, err)
+
+		var result protocol.MultiplierUpdatePayload
+		err = DecodePayload(protocol.MsgMultiplierUpdate, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
 	})
 
 	t.Run("PlayTurn", func(t *testing.T) {
@@ -333,6 +568,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			Timeout:  30,
 			MustPlay: true,
 			CanBeat:  false,
+			TimeBank: 45,
 		}
 
 		data, err := EncodePayload(protocol.MsgPlayTurn, original)
@@ -345,6 +581,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 		assert.Equal(t, original.PlayerID, result.PlayerID)
 		assert.True(t, result.MustPlay)
 		assert.False(t, result.CanBeat)
+		assert.Equal(t, 45, result.TimeBank)
 	})
 
 	t.Run("CardPlayed", func(t *testing.T) {
@@ -355,6 +592,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			Cards:      []protocol.CardInfo{{Suit: 0, Rank: 3, Color: 0}},
 			CardsLeft:  16,
 			HandType:   "Single",
+			Reading:    protocol.HandReading{HandKind: 1, KeyRank: 3},
 		}
 
 		data, err := EncodePayload(protocol.MsgCardPlayed, original)
@@ -367,6 +605,7 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 		assert.Equal(t, original.PlayerID, result.PlayerID)
 		assert.Equal(t, 16, result.CardsLeft)
 		assert.Equal(t, "Single", result.HandType)
+		assert.Equal(t, original.Reading, result.Reading)
 	})
 
 	t.Run("PlayerPass", func(t *testing.T) {
@@ -397,9 +636,19 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 			},
 			Multiplier: 8,
 			Scores: []protocol.PlayerScore{
-				{PlayerID: "p1", PlayerName: "Player1", IsLandlord: true, Score: 16},
-				{PlayerID: "p2", PlayerName: "Player2", IsLandlord: false, Score: -8},
+				{PlayerID: "p1", PlayerName: "Player1", IsLandlord: true, Score: 16, Multiplier: 16},
+				{PlayerID: "p2", PlayerName: "Player2", IsLandlord: false, Score: -8, Multiplier: 8, Double: 1},
+			},
+			Proof: &protocol.ShuffleProof{
+				Mode:        "four",
+				ServerSeed:  "abcd",
+				ClientSeeds: []string{"s1", "", "s3"},
+				Round:       2,
+				Deck:        []protocol.CardInfo{{Suit: 1, Rank: 5, Color: 1}},
+				Shift:       1,
 			},
+			Breakdown:      &protocol.MultiplierBreakdown{Base: 1, GrabCount: 1, ShowHand: 1, BottomBonus: 1, BombCount: 2, Spring: true},
+			NextSeedCommit: "c0ffee",
 		}
 
 		data, err := EncodePayload(protocol.MsgGameOver, original)
@@ -413,10 +662,14 @@ func TestPayloadRoundTrip_GameMessages(t *testing.T) {
 		assert.True(t, result.IsLandlord)
 		assert.Len(t, result.PlayerHands, 1)
 		assert.Equal(t, 8, result.Multiplier)
+		assert.Equal(t, original.Breakdown, result.Breakdown)
 		require.Len(t, result.Scores, 2)
 		assert.Equal(t, 16, result.Scores[0].Score)
 		assert.True(t, result.Scores[0].IsLandlord)
 		assert.Equal(t, -8, result.Scores[1].Score)
+		assert.Equal(t, original.Scores, result.Scores)
+		assert.Equal(t, original.Proof, result.Proof)
+		assert.Equal(t, original.NextSeedCommit, result.NextSeedCommit)
 	})
 }
 
@@ -432,6 +685,7 @@ func TestPayloadRoundTrip_RoomMessages(t *testing.T) {
 				{ID: "p1", Name: "Player1"},
 				{ID: "p2", Name: "Player2"},
 			},
+			SeedCommit: "c0ffee",
 		}
 
 		data, err := EncodePayload(protocol.MsgRoomJoined, original)
@@ -443,6 +697,7 @@ func TestPayloadRoundTrip_RoomMessages(t *testing.T) {
 
 		assert.Equal(t, original.RoomCode, result.RoomCode)
 		assert.Len(t, result.Players, 2)
+		assert.Equal(t, original.SeedCommit, result.SeedCommit)
 	})
 
 	t.Run("PlayerJoined", func(t *testing.T) {
@@ -586,10 +841,17 @@ func TestPayloadRoundTrip_ReconnectionMessages(t *testing.T) {
 				Players: []protocol.PlayerInfo{
 					{ID: "p1", Name: "Player1", Seat: 0, IsLandlord: true},
 				},
-				Hand:        []protocol.CardInfo{{Suit: 0, Rank: 3}},
-				BottomCards: []protocol.CardInfo{{Suit: 1, Rank: 5}},
-				CurrentTurn: "p1",
-				MustPlay:    true,
+				Hand:         []protocol.CardInfo{{Suit: 0, Rank: 3}},
+				BottomCards:  []protocol.CardInfo{{Suit: 1, Rank: 5}},
+				CurrentTurn:  "p1",
+				MustPlay:     true,
+				WildRank:     9,
+				Mode:         "four",
+				LastHandType: "四带两对",
+				LastReading:  protocol.HandReading{HandKind: 18, KeyRank: 3},
+				RevealedHands: []protocol.PlayerHand{
+					{PlayerID: "p2", PlayerName: "Player2", Cards: []protocol.CardInfo{{Suit: 2, Rank: 7}}},
+				},
 			},
 		}
 
@@ -605,6 +867,11 @@ func TestPayloadRoundTrip_ReconnectionMessages(t *testing.T) {
 		require.NotNil(t, result.GameState)
 		assert.Equal(t, "playing", result.GameState.Phase)
 		assert.True(t, result.GameState.MustPlay)
+		assert.Equal(t, 9, result.GameState.WildRank)
+		assert.Equal(t, "four", result.GameState.Mode)
+		assert.Equal(t, "四带两对", result.GameState.LastHandType)
+		assert.Equal(t, original.GameState.LastReading, result.GameState.LastReading)
+		assert.Equal(t, original.GameState.RevealedHands, result.GameState.RevealedHands)
 	})
 
 	t.Run("PlayerOffline", func(t *testing.T) {
@@ -644,6 +911,76 @@ func TestPayloadRoundTrip_ReconnectionMessages(t *testing.T) {
 	})
 }
 
+func TestPayloadRoundTrip_SpectatorMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("SpectateRoom", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.SpectateRoomPayload{RoomCode: "123456"}
+
+		data, err := EncodePayload(protocol.MsgSpectateRoom, original)
+		require.NoError(t, err)
+
+		var result protocol.SpectateRoomPayload
+		err = DecodePayload(protocol.MsgSpectateRoom, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+
+	t.Run("SpectateStarted", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.SpectateStartedPayload{
+			RoomCode: "123456",
+			GameState: &protocol.GameStateDTO{
+				Phase: "playing",
+				Players: []protocol.PlayerInfo{
+					{ID: "p1", Name: "Player1", Seat: 0, IsLandlord: true, CardsCount: 20},
+					{ID: "p2", Name: "Player2", Seat: 1, CardsCount: 17},
+				},
+				BottomCards: []protocol.CardInfo{{Suit: 1, Rank: 5}},
+				CurrentTurn: "p2",
+				LastPlayed:  []protocol.CardInfo{{Suit: 2, Rank: 9}},
+				Multiplier:  2,
+			},
+			HandDelay: 30,
+		}
+
+		data, err := EncodePayload(protocol.MsgSpectateStarted, original)
+		require.NoError(t, err)
+
+		var result protocol.SpectateStartedPayload
+		err = DecodePayload(protocol.MsgSpectateStarted, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, "123456", result.RoomCode)
+		assert.Equal(t, 30, result.HandDelay)
+		require.NotNil(t, result.GameState)
+		assert.Empty(t, result.GameState.Hand)
+		assert.Equal(t, original.GameState.Players, result.GameState.Players)
+		assert.Equal(t, original.GameState.LastPlayed, result.GameState.LastPlayed)
+	})
+
+	t.Run("SpectatorHands", func(t *testing.T) {
+		t.Parallel()
+		original := protocol.SpectatorHandsPayload{
+			Hands: []protocol.PlayerHand{
+				{PlayerID: "p1", PlayerName: "Player1", Cards: []protocol.CardInfo{{Suit: 0, Rank: 3}}},
+				{PlayerID: "p2", PlayerName: "Player2", Cards: []protocol.CardInfo{{Suit: 3, Rank: 14}}},
+			},
+		}
+
+		data, err := EncodePayload(protocol.MsgSpectatorHands, original)
+		require.NoError(t, err)
+
+		var result protocol.SpectatorHandsPayload
+		err = DecodePayload(protocol.MsgSpectatorHands, data, &result)
+		require.NoError(t, err)
+
+		assert.Equal(t, original, result)
+	})
+}
+
 func TestPayloadRoundTrip_MaintenanceMessages(t *testing.T) {
 	t.Parallel()
 
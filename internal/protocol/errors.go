@@ -2,34 +2,50 @@ package protocol
 
 // 错误码
 const (
-	ErrCodeUnknown           = 1000
-	ErrCodeInvalidMsg        = 1001
-	ErrCodeRateLimit         = 1002 // 速率限制
-	ErrCodeRoomNotFound      = 2001
-	ErrCodeRoomFull          = 2002
-	ErrCodeNotInRoom         = 2003
-	ErrCodeGameStarted       = 2004 // 游戏已开始
-	ErrCodeGameNotStart      = 3001
-	ErrCodeNotYourTurn       = 3002
-	ErrCodeInvalidCards      = 3003
-	ErrCodeCannotBeat        = 3004
-	ErrCodeMustPlay          = 3005
-	ErrCodeServerMaintenance = 5003 // 服务器维护中
+	ErrCodeUnknown            = 1000
+	ErrCodeInvalidMsg         = 1001
+	ErrCodeRateLimit          = 1002 // 速率限制
+	ErrCodeInvalidSeed        = 1003 // 客户端种子为空、过长或含不可见字符
+	ErrCodeRoomNotFound       = 2001
+	ErrCodeRoomFull           = 2002
+	ErrCodeNotInRoom          = 2003
+	ErrCodeGameStarted        = 2004 // 游戏已开始
+	ErrCodeRematchClosed      = 2005 // 再来一局投票已结束
+	ErrCodeTournamentNotFound = 2006
+	ErrCodeTournamentStarted  = 2007 // 锦标赛已开赛，不能再报名或退出
+	ErrCodeInOtherRoom        = 2008 // 已进入其他房间，不能再回原房间再来一局
+	ErrCodeGameNotStart       = 3001
+	ErrCodeNotYourTurn        = 3002
+	ErrCodeInvalidCards       = 3003
+	ErrCodeCannotBeat         = 3004
+	ErrCodeMustPlay           = 3005
+	ErrCodeInvalidBid         = 3006 // 叫分不合法
+	ErrCodeInvalidDouble      = 3007 // 加倍选择不合法或已选择过
+	ErrCodeCannotShowHand     = 3008 // 当前不能明牌
+	ErrCodeServerMaintenance  = 5003 // 服务器维护中
 )
 
 // ErrorMessages 错误码对应的消息
 var ErrorMessages = map[int]string{
-	ErrCodeUnknown:           "未知错误",
-	ErrCodeInvalidMsg:        "无效的消息格式",
-	ErrCodeRateLimit:         "请求过于频繁",
-	ErrCodeRoomNotFound:      "房间不存在",
-	ErrCodeRoomFull:          "房间已满",
-	ErrCodeNotInRoom:         "您不在房间中",
-	ErrCodeGameStarted:       "游戏已开始",
-	ErrCodeGameNotStart:      "游戏尚未开始",
-	ErrCodeNotYourTurn:       "还没轮到您",
-	ErrCodeInvalidCards:      "无效的牌型",
-	ErrCodeCannotBeat:        "您的牌大不过上家",
-	ErrCodeMustPlay:          "您必须出牌",
-	ErrCodeServerMaintenance: "服务器维护中",
+	ErrCodeUnknown:            "未知错误",
+	ErrCodeInvalidMsg:         "无效的消息格式",
+	ErrCodeRateLimit:          "请求过于频繁",
+	ErrCodeInvalidSeed:        "无效的洗牌种子",
+	ErrCodeRoomNotFound:       "房间不存在",
+	ErrCodeRoomFull:           "房间已满",
+	ErrCodeNotInRoom:          "您不在房间中",
+	ErrCodeGameStarted:        "游戏已开始",
+	ErrCodeRematchClosed:      "再来一局已结束",
+	ErrCodeTournamentNotFound: "锦标赛不存在",
+	ErrCodeTournamentStarted:  "锦标赛已开赛",
+	ErrCodeInOtherRoom:        "您已在其他房间中",
+	ErrCodeGameNotStart:       "游戏尚未开始",
+	ErrCodeNotYourTurn:        "还没轮到您",
+	ErrCodeInvalidCards:       "无效的牌型",
+	ErrCodeCannotBeat:         "您的牌大不过上家",
+	ErrCodeMustPlay:           "您必须出牌",
+	ErrCodeInvalidBid:         "只能叫比当前更高的分数",
+	ErrCodeInvalidDouble:      "无效的加倍选择",
+	ErrCodeCannotShowHand:     "现在不能明牌",
+	ErrCodeServerMaintenance:  "服务器维护中",
 }
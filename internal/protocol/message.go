@@ -14,8 +14,9 @@ type MessageType string
 // 客户端 → 服务端 消息类型
 const (
 	// 连接操作
-	MsgReconnect MessageType = "reconnect" // 断线重连
-	MsgPing      MessageType = "ping"      // 心跳 ping
+	MsgReconnect  MessageType = "reconnect"   // 断线重连
+	MsgPing       MessageType = "ping"        // 心跳 ping
+	MsgClientSeed MessageType = "client_seed" // 上报洗牌用的客户端种子
 
 	// 房间操作
 	MsgCreateRoom    MessageType = "create_room"    // 创建房间
@@ -28,8 +29,22 @@ const (
 
 	// 游戏操作
 	MsgBid       MessageType = "bid"        // 叫地主
+	MsgDouble    MessageType = "double"     // 加倍
+	MsgShowHand  MessageType = "show_hand"  // 明牌
 	MsgPlayCards MessageType = "play_cards" // 出牌
 	MsgPass      MessageType = "pass"       // 不出
+	MsgTrustee   MessageType = "trustee"    // 开启/取消托管
+
+	// 再来一局
+	MsgRematchRequest MessageType = "rematch_request" // 对局结束后同意/拒绝再来一局
+
+	// 锦标赛
+	MsgGetTournaments     MessageType = "get_tournaments"     // 获取锦标赛列表
+	MsgTournamentRegister MessageType = "tournament_register" // 报名/退出锦标赛
+
+	// 观战
+	MsgSpectateRoom   MessageType = "spectate_room"   // 观战进行中的对局
+	MsgStopSpectating MessageType = "stop_spectating" // 退出观战
 
 	// 排行榜
 	MsgGetStats             MessageType = "get_stats"              // 获取个人统计
@@ -59,22 +74,37 @@ const (
 	MsgMatchFound   MessageType = "match_found"   // 匹配成功
 
 	// 游戏流程
-	MsgGameStart   MessageType = "game_start"   // 游戏开始
-	MsgDealCards   MessageType = "deal_cards"   // 发牌
-	MsgBidTurn     MessageType = "bid_turn"     // 轮到叫地主
-	MsgBidResult   MessageType = "bid_result"   // 叫地主结果
-	MsgLandlord    MessageType = "landlord"     // 地主确定
-	MsgPlayTurn    MessageType = "play_turn"    // 轮到出牌
-	MsgCardPlayed  MessageType = "card_played"  // 有人出牌
-	MsgPlayerPass  MessageType = "player_pass"  // 有人不出
-	MsgGameOver    MessageType = "game_over"    // 游戏结束
-	MsgRoundResult MessageType = "round_result" // 本轮结果
+	MsgGameStart        MessageType = "game_start"        // 游戏开始
+	MsgDealCards        MessageType = "deal_cards"        // 发牌
+	MsgBidTurn          MessageType = "bid_turn"          // 轮到叫地主
+	MsgBidResult        MessageType = "bid_result"        // 叫地主结果
+	MsgLandlord         MessageType = "landlord"          // 地主确定
+	MsgDoubleTurn       MessageType = "double_turn"       // 进入加倍阶段
+	MsgDoubleResult     MessageType = "double_result"     // 加倍结果
+	MsgHandRevealed     MessageType = "hand_revealed"     // 明牌玩家的手牌
+	MsgMultiplierUpdate MessageType = "multiplier_update" // 倍数变化
+	MsgPlayTurn         MessageType = "play_turn"         // 轮到出牌
+	MsgCardPlayed       MessageType = "card_played"       // 有人出牌
+	MsgPlayerPass       MessageType = "player_pass"       // 有人不出
+	MsgTrusteeChanged   MessageType = "trustee_changed"   // 玩家托管状态变化
+	MsgGameOver         MessageType = "game_over"         // 游戏结束
+	MsgRoundResult      MessageType = "round_result"      // 本轮结果
+	MsgSeriesStandings  MessageType = "series_standings"  // 系列赛排名（每局结束后）
+	MsgRematchStatus    MessageType = "rematch_status"    // 再来一局投票状态
+
+	// 观战
+	MsgSpectateStarted MessageType = "spectate_started" // 开始观战，附当前局面
+	MsgSpectatorHands  MessageType = "spectator_hands"  // 延迟公开给观战者的各家手牌
 
 	// 排行榜
 	MsgStatsResult       MessageType = "stats_result"       // 个人统计结果
 	MsgLeaderboardResult MessageType = "leaderboard_result" // 排行榜结果
 	MsgRoomListResult    MessageType = "room_list_result"   // 房间列表结果
 
+	// 锦标赛
+	MsgTournamentList      MessageType = "tournament_list"      // 锦标赛列表
+	MsgTournamentStandings MessageType = "tournament_standings" // 锦标赛排名（每轮结束后）
+
 	// 系统通知
 	MsgMaintenancePush MessageType = "maintenance_push" // 主动推送
 	MsgMaintenancePull MessageType = "maintenance_pull" // 被动拉取
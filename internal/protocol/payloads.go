@@ -13,19 +13,76 @@ type PingPayload struct {
 	Timestamp int64 `json:"timestamp"` // 客户端时间戳（毫秒）
 }
 
+// CreateRoomPayload 创建房间请求（可选，不带 payload 时按服务端默认规则建房）
+type CreateRoomPayload struct {
+	RuleSet  string `json:"rule_set,omitempty"` // 房规名称，见 rule.RuleSetByName
+	Laizi    bool   `json:"laizi,omitempty"`    // 癞子玩法：发牌后随机一个点数作为癞子
+	Mode     string `json:"mode,omitempty"`     // 人数玩法模式，空为经典三人，"four" 为四人两副牌
+	BidMode  string `json:"bid_mode,omitempty"` // 叫地主方式，空为叫抢地主，"score" 为叫分
+	Doubling bool   `json:"doubling,omitempty"` // 定地主后进入加倍阶段
+	Hands    int    `json:"hands,omitempty"`    // 系列赛局数，0 或 1 表示单局
+}
+
+// QuickMatchPayload 快速匹配请求（可选，不带 payload 时匹配经典三人局）
+type QuickMatchPayload struct {
+	Mode string `json:"mode,omitempty"` // 人数玩法模式，同 CreateRoomPayload.Mode
+}
+
+// ClientSeedPayload 上报洗牌用的客户端种子，与服务端种子共同决定下一局牌序
+type ClientSeedPayload struct {
+	Seed string `json:"seed"`
+}
+
 // JoinRoomPayload 加入房间请求
 type JoinRoomPayload struct {
 	RoomCode string `json:"room_code"`
 }
 
+// SpectateRoomPayload 观战请求
+type SpectateRoomPayload struct {
+	RoomCode string `json:"room_code"`
+}
+
 // BidPayload 叫地主请求
 type BidPayload struct {
-	Bid bool `json:"bid"` // true = 叫地主, false = 不叫
+	Bid   bool `json:"bid"`             // true = 叫地主, false = 不叫
+	Score int  `json:"score,omitempty"` // 叫分模式下叫的分数（1~3），0 表示不叫
+}
+
+// DoublePayload 加倍请求
+type DoublePayload struct {
+	Level int `json:"level"` // 0 = 不加倍, 1 = 加倍, 2 = 超级加倍
+}
+
+// TrusteePayload 开启/取消托管
+type TrusteePayload struct {
+	Enabled bool `json:"enabled"`
+}
+
+// RematchRequestPayload 对局结束后同意/拒绝再来一局
+type RematchRequestPayload struct {
+	RoomCode string `json:"room_code"` // 刚结束对局的房间号
+	Accept   bool   `json:"accept"`
+}
+
+// TournamentRegisterPayload 报名/退出锦标赛
+type TournamentRegisterPayload struct {
+	TournamentID string `json:"tournament_id"`
+	Register     bool   `json:"register"` // true = 报名, false = 退出
 }
 
 // PlayCardsPayload 出牌请求
 type PlayCardsPayload struct {
-	Cards []CardInfo `json:"cards"`
+	Cards   []CardInfo  `json:"cards"`
+	Reading HandReading `json:"reading"` // 指定的读法（一手牌有多种读法时），零值表示由服务端选择
+}
+
+// HandReading 一手牌的读法：同一组牌的不同读法在牌型、关键点数与软硬上互不相同，
+// 用它而不是牌型的显示名称指明按哪种读法出牌
+type HandReading struct {
+	HandKind int  `json:"hand_kind,omitempty"` // 牌型（rule.HandType 的取值），0 表示未指定
+	KeyRank  int  `json:"key_rank,omitempty"`  // 关键点数
+	Soft     bool `json:"soft,omitempty"`      // 是否为癞子组成的软炸弹
 }
 
 // GetLeaderboardPayload 获取排行榜请求
@@ -54,15 +111,30 @@ type ReconnectedPayload struct {
 
 // GameStateDTO 游戏状态数据传输对象（用于重连恢复）
 type GameStateDTO struct {
-	Phase        string       `json:"phase"`          // bidding/playing
-	Players      []PlayerInfo `json:"players"`        // 所有玩家信息
-	Hand         []CardInfo   `json:"hand"`           // 自己的手牌
-	BottomCards  []CardInfo   `json:"bottom_cards"`   // 底牌
-	CurrentTurn  string       `json:"current_turn"`   // 当前回合玩家 ID
-	LastPlayed   []CardInfo   `json:"last_played"`    // 上家出的牌
-	LastPlayerID string       `json:"last_player_id"` // 上家 ID
-	MustPlay     bool         `json:"must_play"`      // 是否必须出牌
-	CanBeat      bool         `json:"can_beat"`       // 是否能打过
+	Phase         string               `json:"phase"`                    // bidding/playing
+	Players       []PlayerInfo         `json:"players"`                  // 所有玩家信息
+	Hand          []CardInfo           `json:"hand"`                     // 自己的手牌
+	BottomCards   []CardInfo           `json:"bottom_cards"`             // 底牌
+	CurrentTurn   string               `json:"current_turn"`             // 当前回合玩家 ID
+	LastPlayed    []CardInfo           `json:"last_played"`              // 上家出的牌
+	LastPlayerID  string               `json:"last_player_id"`           // 上家 ID
+	LastHandType  string               `json:"last_hand_type,omitempty"` // 上家出牌的牌型名称
+	LastReading   HandReading          `json:"last_reading"`             // 上家出牌的读法
+	MustPlay      bool                 `json:"must_play"`                // 是否必须出牌
+	CanBeat       bool                 `json:"can_beat"`                 // 是否能打过
+	WildRank      int                  `json:"wild_rank,omitempty"`      // 癞子点数，0 表示非癞子玩法
+	Mode          string               `json:"mode,omitempty"`           // 人数玩法模式
+	RevealedHands []PlayerHand         `json:"revealed_hands,omitempty"` // 明牌玩家的手牌
+	Multiplier    int                  `json:"multiplier"`               // 当前倍数
+	Breakdown     *MultiplierBreakdown `json:"breakdown,omitempty"`      // 当前倍数构成
+	TimeBank      int                  `json:"time_bank,omitempty"`      // 当前出牌玩家剩余的备用时间（秒）
+}
+
+// SpectateStartedPayload 开始观战响应
+type SpectateStartedPayload struct {
+	RoomCode  string        `json:"room_code"`
+	GameState *GameStateDTO `json:"game_state"`           // 当前局面，不含任何玩家的手牌
+	HandDelay int           `json:"hand_delay,omitempty"` // 各家手牌延迟公开的秒数，0 表示不公开
 }
 
 // PongPayload 心跳响应
@@ -91,15 +163,17 @@ type OnlineCountPayload struct {
 
 // RoomCreatedPayload 房间创建成功响应
 type RoomCreatedPayload struct {
-	RoomCode string     `json:"room_code"`
-	Player   PlayerInfo `json:"player"`
+	RoomCode   string     `json:"room_code"`
+	Player     PlayerInfo `json:"player"`
+	SeedCommit string     `json:"seed_commit,omitempty"` // 下一局服务端种子的承诺，客户端收到后再上报自己的种子
 }
 
 // RoomJoinedPayload 加入房间成功响应
 type RoomJoinedPayload struct {
-	RoomCode string       `json:"room_code"`
-	Player   PlayerInfo   `json:"player"`
-	Players  []PlayerInfo `json:"players"` // 房间内所有玩家
+	RoomCode   string       `json:"room_code"`
+	Player     PlayerInfo   `json:"player"`
+	Players    []PlayerInfo `json:"players"`               // 房间内所有玩家
+	SeedCommit string       `json:"seed_commit,omitempty"` // 下一局服务端种子的承诺，客户端收到后再上报自己的种子
 }
 
 // PlayerJoinedPayload 其他玩家加入通知
@@ -121,55 +195,97 @@ type PlayerReadyPayload struct {
 
 // GameStartPayload 游戏开始通知
 type GameStartPayload struct {
-	Players []PlayerInfo `json:"players"` // 按座位顺序排列
+	Players    []PlayerInfo `json:"players"`               // 按座位顺序排列
+	RuleSet    string       `json:"rule_set,omitempty"`    // 本局房规名称
+	Mode       string       `json:"mode,omitempty"`        // 人数玩法模式
+	SeedCommit string       `json:"seed_commit,omitempty"` // 服务端种子的 SHA-256 承诺值，结算时揭示
+	BidMode    string       `json:"bid_mode,omitempty"`    // 叫地主方式
+	Hand       int          `json:"hand,omitempty"`        // 系列赛中的第几局，从 1 开始
+	Hands      int          `json:"hands,omitempty"`       // 系列赛总局数，0 表示单局
 }
 
 // DealCardsPayload 发牌通知
 type DealCardsPayload struct {
-	Cards       []CardInfo `json:"cards"`        // 玩家自己的手牌
-	BottomCards []CardInfo `json:"bottom_cards"` // 底牌（地主确定后才显示具体内容）
+	Cards       []CardInfo `json:"cards"`               // 玩家自己的手牌
+	BottomCards []CardInfo `json:"bottom_cards"`        // 底牌（地主确定后才显示具体内容）
+	WildRank    int        `json:"wild_rank,omitempty"` // 本局癞子点数，0 表示非癞子玩法
 }
 
 // BidTurnPayload 轮到叫地主通知
 type BidTurnPayload struct {
 	PlayerID   string `json:"player_id"`
-	Timeout    int    `json:"timeout"`    // 超时时间（秒）
-	IsGrab     bool   `json:"is_grab"`    // true=抢地主阶段, false=叫地主阶段
-	Multiplier int    `json:"multiplier"` // 当前倍数
+	Timeout    int    `json:"timeout"`           // 超时时间（秒）
+	IsGrab     bool   `json:"is_grab"`           // true=抢地主阶段, false=叫地主阶段
+	Multiplier int    `json:"multiplier"`        // 当前倍数
+	Options    []int  `json:"options,omitempty"` // 叫分模式下可叫的分数（不含不叫），叫抢模式为空
 }
 
 // BidResultPayload 叫地主结果通知
 type BidResultPayload struct {
 	PlayerID   string `json:"player_id"`
 	PlayerName string `json:"player_name"`
-	Bid        bool   `json:"bid"`        // 是否叫/抢
-	IsGrab     bool   `json:"is_grab"`    // 该决策是否处于抢地主阶段
-	Multiplier int    `json:"multiplier"` // 决策后的当前倍数
+	Bid        bool   `json:"bid"`             // 是否叫/抢
+	IsGrab     bool   `json:"is_grab"`         // 该决策是否处于抢地主阶段
+	Multiplier int    `json:"multiplier"`      // 决策后的当前倍数
+	Score      int    `json:"score,omitempty"` // 叫分模式下叫的分数，0 表示不叫
+}
+
+// DoubleTurnPayload 加倍阶段开始通知，所有玩家同时选择
+type DoubleTurnPayload struct {
+	Timeout    int      `json:"timeout"`           // 超时时间（秒）
+	Multiplier int      `json:"multiplier"`        // 当前底倍
+	Pending    []string `json:"pending,omitempty"` // 尚未选择的玩家 ID
+}
+
+// DoubleResultPayload 玩家加倍选择通知
+type DoubleResultPayload struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Level      int    `json:"level"` // 0 = 不加倍, 1 = 加倍, 2 = 超级加倍
+}
+
+// HandRevealedPayload 明牌玩家的当前手牌，明牌时及之后每次出牌后广播
+type HandRevealedPayload struct {
+	PlayerID   string     `json:"player_id"`
+	PlayerName string     `json:"player_name"`
+	Cards      []CardInfo `json:"cards"`
+	Multiplier int        `json:"multiplier"` // 该玩家明牌的倍数
+}
+
+// TrusteeChangedPayload 玩家托管状态变化
+type TrusteeChangedPayload struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Enabled    bool   `json:"enabled"`
 }
 
 // LandlordPayload 地主确定通知
 type LandlordPayload struct {
-	PlayerID    string     `json:"player_id"`
-	PlayerName  string     `json:"player_name"`
-	BottomCards []CardInfo `json:"bottom_cards"` // 底牌
-	Multiplier  int        `json:"multiplier"`   // 底倍（叫抢结束后的倍数）
+	PlayerID      string     `json:"player_id"`
+	PlayerName    string     `json:"player_name"`
+	BottomCards   []CardInfo `json:"bottom_cards"`             // 底牌
+	Multiplier    int        `json:"multiplier"`               // 底倍（叫抢倍数 × 底牌翻倍）
+	BottomBonus   int        `json:"bottom_bonus"`             // 底牌翻倍倍数，无翻倍时为 1
+	BottomPattern string     `json:"bottom_pattern,omitempty"` // 触发翻倍的底牌牌型名称，无翻倍时为空
 }
 
 // PlayTurnPayload 轮到出牌通知
 type PlayTurnPayload struct {
 	PlayerID string `json:"player_id"`
-	Timeout  int    `json:"timeout"`   // 超时时间（秒）
-	MustPlay bool   `json:"must_play"` // 是否必须出牌（新一轮开始时为 true）
-	CanBeat  bool   `json:"can_beat"`  // 是否有牌能打过上家
+	Timeout  int    `json:"timeout"`             // 超时时间（秒）
+	MustPlay bool   `json:"must_play"`           // 是否必须出牌（新一轮开始时为 true）
+	CanBeat  bool   `json:"can_beat"`            // 是否有牌能打过上家
+	TimeBank int    `json:"time_bank,omitempty"` // 出牌玩家剩余的备用时间（秒），Timeout 用完后开始消耗，0 表示没有
 }
 
 // CardPlayedPayload 出牌通知
 type CardPlayedPayload struct {
-	PlayerID   string     `json:"player_id"`
-	PlayerName string     `json:"player_name"`
-	Cards      []CardInfo `json:"cards"`
-	CardsLeft  int        `json:"cards_left"` // 剩余手牌数
-	HandType   string     `json:"hand_type"`  // 牌型名称
+	PlayerID   string      `json:"player_id"`
+	PlayerName string      `json:"player_name"`
+	Cards      []CardInfo  `json:"cards"`
+	CardsLeft  int         `json:"cards_left"` // 剩余手牌数
+	HandType   string      `json:"hand_type"`  // 牌型名称
+	Reading    HandReading `json:"reading"`    // 这手牌的读法
 }
 
 // PlayerPassPayload 不出通知
@@ -180,12 +296,80 @@ type PlayerPassPayload struct {
 
 // GameOverPayload 游戏结束通知
 type GameOverPayload struct {
-	WinnerID    string        `json:"winner_id"`
-	WinnerName  string        `json:"winner_name"`
-	IsLandlord  bool          `json:"is_landlord"`  // 获胜者是否是地主
-	PlayerHands []PlayerHand  `json:"player_hands"` // 所有玩家剩余手牌
-	Multiplier  int           `json:"multiplier"`   // 最终倍数
-	Scores      []PlayerScore `json:"scores"`       // 每位玩家本局得分
+	WinnerID    string               `json:"winner_id"`
+	WinnerName  string               `json:"winner_name"`
+	IsLandlord  bool                 `json:"is_landlord"`         // 获胜者是否是地主
+	PlayerHands []PlayerHand         `json:"player_hands"`        // 所有玩家剩余手牌
+	Multiplier  int                  `json:"multiplier"`          // 最终倍数
+	Scores      []PlayerScore        `json:"scores"`              // 每位玩家本局得分
+	Proof       *ShuffleProof        `json:"proof,omitempty"`     // 发牌公平性证明
+	Breakdown   *MultiplierBreakdown `json:"breakdown,omitempty"` // 最终倍数的构成
+	// NextSeedCommit 房间下一局服务端种子的承诺，客户端收到后再上报下一局的种子
+	NextSeedCommit string `json:"next_seed_commit,omitempty"`
+}
+
+// SeriesStanding 系列赛中一名玩家的累计成绩
+type SeriesStanding struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Total      int    `json:"total"` // 累计得分
+	Last       int    `json:"last"`  // 最近一局得分
+	Wins       int    `json:"wins"`  // 获胜局数
+}
+
+// SeriesStandingsPayload 系列赛排名，每局结束后推送；Final 为 true 时系列赛结束（打满局数或有人离开）
+type SeriesStandingsPayload struct {
+	Hand      int              `json:"hand"`      // 已完成的局数
+	Hands     int              `json:"hands"`     // 系列赛总局数
+	Standings []SeriesStanding `json:"standings"` // 按累计得分从高到低
+	Final     bool             `json:"final"`
+}
+
+// 再来一局投票结果
+const (
+	RematchPending = "pending" // 等待其他玩家表态
+	RematchStarted = "started" // 全员同意，原房间开始新的一局
+	RematchFailed  = "failed"  // 有人拒绝或超时，同意的玩家回到匹配队列
+)
+
+// RematchStatusPayload 再来一局投票状态，有人表态或投票结束时推送给原房间的玩家
+type RematchStatusPayload struct {
+	RoomCode string   `json:"room_code"`
+	Agreed   []string `json:"agreed"`  // 已同意的玩家 ID
+	Timeout  int      `json:"timeout"` // 投票剩余秒数
+	Result   string   `json:"result"`  // RematchPending 等
+}
+
+// MultiplierUpdatePayload 倍数变化通知（叫抢、明牌、底牌、炸弹、春天）
+type MultiplierUpdatePayload struct {
+	Multiplier int                  `json:"multiplier"` // 当前倍数
+	Breakdown  *MultiplierBreakdown `json:"breakdown"`  // 倍数构成
+}
+
+// SpectatorHandsPayload 延迟公开给观战者的各家手牌（观战延迟大于 0 时推送）
+type SpectatorHandsPayload struct {
+	Hands []PlayerHand `json:"hands"`
+}
+
+// MultiplierBreakdown 倍数构成，各项相乘即为当前倍数（不含按玩家结算的加倍）
+type MultiplierBreakdown struct {
+	Base        int  `json:"base"`         // 底分：叫分模式为所叫分数，叫抢模式为 1
+	GrabCount   int  `json:"grab_count"`   // 抢地主次数，每次 ×2
+	ShowHand    int  `json:"show_hand"`    // 明牌倍数，未明牌为 1
+	BottomBonus int  `json:"bottom_bonus"` // 底牌翻倍倍数，未翻倍为 1
+	BombCount   int  `json:"bomb_count"`   // 炸弹/王炸翻倍次数（癞子玩法硬炸弹计两次）
+	Spring      bool `json:"spring"`       // 春天 ×2
+	AntiSpring  bool `json:"anti_spring"`  // 反春天 ×2
+}
+
+// ShuffleProof 结算时揭示的洗牌证明，配合开局的 SeedCommit 可重算并核对发牌
+type ShuffleProof struct {
+	Mode        string     `json:"mode,omitempty"`  // 人数玩法模式，决定初始牌序
+	ServerSeed  string     `json:"server_seed"`     // 服务端种子（十六进制）
+	ClientSeeds []string   `json:"client_seeds"`    // 按座位排列的客户端种子
+	Round       int        `json:"round"`           // 发牌轮次（流局重发时递增）
+	Deck        []CardInfo `json:"deck"`            // 洗牌后的完整牌序
+	Shift       int        `json:"shift,omitempty"` // 复式赛本桌座位 s 拿到牌序中座位 (s+Shift)%人数 的手牌
 }
 
 // PlayerHand 玩家手牌信息（用于游戏结束展示）
@@ -201,6 +385,8 @@ type PlayerScore struct {
 	PlayerName string `json:"player_name"`
 	IsLandlord bool   `json:"is_landlord"` // 是否是地主
 	Score      int    `json:"score"`       // 本局得分（输为负，赢为正）
+	Multiplier int    `json:"multiplier"`  // 计入加倍后该玩家的结算倍数（地主为对各农民倍数之和）
+	Double     int    `json:"double"`      // 该玩家的加倍选择
 }
 
 // MaintenancePayload 维护模式通知
@@ -265,6 +451,47 @@ type RoomListItem struct {
 	MaxPlayers  int    `json:"max_players"`
 }
 
+// 锦标赛状态
+const (
+	TournamentRegistering = "registering" // 报名中
+	TournamentRunning     = "running"     // 比赛中
+)
+
+// TournamentInfo 锦标赛列表项
+type TournamentInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	StartAt    int64  `json:"start_at"`   // 开赛时间（Unix 秒）
+	Players    int    `json:"players"`    // 报名人数
+	Registered bool   `json:"registered"` // 请求者是否已报名
+	State      string `json:"state"`      // TournamentRegistering 等
+	Round      int    `json:"round"`      // 进行中的轮次
+	Hands      int    `json:"hands"`      // 每轮每桌局数
+}
+
+// TournamentListPayload 锦标赛列表
+type TournamentListPayload struct {
+	Tournaments []TournamentInfo `json:"tournaments"`
+}
+
+// TournamentStanding 锦标赛中一名选手的成绩
+type TournamentStanding struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Total      int    `json:"total"`      // 各轮累计得分
+	Round      int    `json:"round"`      // 打到第几轮
+	Eliminated bool   `json:"eliminated"` // 是否已被淘汰
+}
+
+// TournamentStandingsPayload 锦标赛排名，每轮结束后推送给全部参赛者；Final 为 true 时决赛桌已结束
+type TournamentStandingsPayload struct {
+	TournamentID string               `json:"tournament_id"`
+	Name         string               `json:"name"`
+	Round        int                  `json:"round"`     // 刚结束的轮次
+	Standings    []TournamentStanding `json:"standings"` // 未淘汰的在前，其余按打到的轮次与累计得分排序
+	Final        bool                 `json:"final"`
+}
+
 // ChatPayload 聊天消息
 type ChatPayload struct {
 	SenderID   string `json:"sender_id,omitempty"`   // 发送者 ID (服务端填充)
@@ -281,12 +508,13 @@ type ChatPayload struct {
 type PlayerInfo struct {
 	ID         string `json:"id"`
 	Name       string `json:"name"`
-	Seat       int    `json:"seat"`             // 座位号 0-2
-	Ready      bool   `json:"ready"`            // 是否准备
-	IsLandlord bool   `json:"is_landlord"`      // 是否是地主
-	CardsCount int    `json:"cards_count"`      // 手牌数量
-	Online     bool   `json:"online"`           // 是否在线
-	IsBot      bool   `json:"is_bot,omitempty"` // 是否是机器人
+	Seat       int    `json:"seat"`              // 座位号，从 0 开始
+	Ready      bool   `json:"ready"`             // 是否准备
+	IsLandlord bool   `json:"is_landlord"`       // 是否是地主
+	CardsCount int    `json:"cards_count"`       // 手牌数量
+	Online     bool   `json:"online"`            // 是否在线
+	IsBot      bool   `json:"is_bot,omitempty"`  // 是否是机器人
+	Trustee    bool   `json:"trustee,omitempty"` // 是否托管
 }
 
 // CardInfo 牌信息
@@ -119,6 +119,181 @@ func (x *PingPayload) GetTimestamp() int64 {
 	return 0
 }
 
+// CreateRoomPayload 创建房间请求
+type CreateRoomPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleSet       string                 `protobuf:"bytes,1,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 房规名称
+	Laizi         bool                   `protobuf:"varint,2,opt,name=laizi,proto3" json:"laizi,omitempty"`                   // 癞子玩法
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                      // 人数玩法模式
+	BidMode       string                 `protobuf:"bytes,4,opt,name=bid_mode,json=bidMode,proto3" json:"bid_mode,omitempty"` // 叫地主方式
+	Doubling      bool                   `protobuf:"varint,5,opt,name=doubling,proto3" json:"doubling,omitempty"`             // 定地主后进入加倍阶段
+	Hands         int64                  `protobuf:"varint,6,opt,name=hands,proto3" json:"hands,omitempty"`                   // 系列赛局数，0 或 1 表示单局
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomPayload) Reset() {
+	*x = CreateRoomPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomPayload) ProtoMessage() {}
+
+func (x *CreateRoomPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomPayload.ProtoReflect.Descriptor instead.
+func (*CreateRoomPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRoomPayload) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *CreateRoomPayload) GetLaizi() bool {
+	if x != nil {
+		return x.Laizi
+	}
+	return false
+}
+
+func (x *CreateRoomPayload) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateRoomPayload) GetBidMode() string {
+	if x != nil {
+		return x.BidMode
+	}
+	return ""
+}
+
+func (x *CreateRoomPayload) GetDoubling() bool {
+	if x != nil {
+		return x.Doubling
+	}
+	return false
+}
+
+func (x *CreateRoomPayload) GetHands() int64 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
+// QuickMatchPayload 快速匹配请求
+type QuickMatchPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"` // 人数玩法模式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickMatchPayload) Reset() {
+	*x = QuickMatchPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickMatchPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickMatchPayload) ProtoMessage() {}
+
+func (x *QuickMatchPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickMatchPayload.ProtoReflect.Descriptor instead.
+func (*QuickMatchPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuickMatchPayload) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// ClientSeedPayload 上报洗牌用的客户端种子
+type ClientSeedPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seed          string                 `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientSeedPayload) Reset() {
+	*x = ClientSeedPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientSeedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientSeedPayload) ProtoMessage() {}
+
+func (x *ClientSeedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientSeedPayload.ProtoReflect.Descriptor instead.
+func (*ClientSeedPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClientSeedPayload) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
 // JoinRoomPayload 加入房间请求
 type JoinRoomPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -129,7 +304,7 @@ type JoinRoomPayload struct {
 
 func (x *JoinRoomPayload) Reset() {
 	*x = JoinRoomPayload{}
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[2]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +316,7 @@ func (x *JoinRoomPayload) String() string {
 func (*JoinRoomPayload) ProtoMessage() {}
 
 func (x *JoinRoomPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[2]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +329,7 @@ func (x *JoinRoomPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinRoomPayload.ProtoReflect.Descriptor instead.
 func (*JoinRoomPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{2}
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *JoinRoomPayload) GetRoomCode() string {
@@ -164,17 +339,63 @@ func (x *JoinRoomPayload) GetRoomCode() string {
 	return ""
 }
 
+// SpectateRoomPayload 观战请求
+type SpectateRoomPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRoomPayload) Reset() {
+	*x = SpectateRoomPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRoomPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRoomPayload) ProtoMessage() {}
+
+func (x *SpectateRoomPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRoomPayload.ProtoReflect.Descriptor instead.
+func (*SpectateRoomPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SpectateRoomPayload) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
 // BidPayload 叫地主请求
 type BidPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bid           bool                   `protobuf:"varint,1,opt,name=bid,proto3" json:"bid,omitempty"` // true = 叫地主, false = 不叫
+	Bid           bool                   `protobuf:"varint,1,opt,name=bid,proto3" json:"bid,omitempty"`     // true = 叫地主, false = 不叫
+	Score         int64                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"` // 叫分模式下叫的分数，0 表示不叫
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BidPayload) Reset() {
 	*x = BidPayload{}
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[3]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -186,7 +407,7 @@ func (x *BidPayload) String() string {
 func (*BidPayload) ProtoMessage() {}
 
 func (x *BidPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[3]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +420,7 @@ func (x *BidPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BidPayload.ProtoReflect.Descriptor instead.
 func (*BidPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{3}
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BidPayload) GetBid() bool {
@@ -209,17 +430,223 @@ func (x *BidPayload) GetBid() bool {
 	return false
 }
 
+func (x *BidPayload) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// DoublePayload 加倍请求
+type DoublePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         int64                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"` // 0 = 不加倍, 1 = 加倍, 2 = 超级加倍
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoublePayload) Reset() {
+	*x = DoublePayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoublePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoublePayload) ProtoMessage() {}
+
+func (x *DoublePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoublePayload.ProtoReflect.Descriptor instead.
+func (*DoublePayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DoublePayload) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// TrusteePayload 开启/取消托管
+type TrusteePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrusteePayload) Reset() {
+	*x = TrusteePayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrusteePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrusteePayload) ProtoMessage() {}
+
+func (x *TrusteePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrusteePayload.ProtoReflect.Descriptor instead.
+func (*TrusteePayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TrusteePayload) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// RematchRequestPayload 对局结束后同意/拒绝再来一局
+type RematchRequestPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"` // 刚结束对局的房间号
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RematchRequestPayload) Reset() {
+	*x = RematchRequestPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RematchRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchRequestPayload) ProtoMessage() {}
+
+func (x *RematchRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchRequestPayload.ProtoReflect.Descriptor instead.
+func (*RematchRequestPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RematchRequestPayload) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *RematchRequestPayload) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+// TournamentRegisterPayload 报名/退出锦标赛
+type TournamentRegisterPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Register      bool                   `protobuf:"varint,2,opt,name=register,proto3" json:"register,omitempty"` // true = 报名, false = 退出
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentRegisterPayload) Reset() {
+	*x = TournamentRegisterPayload{}
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentRegisterPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentRegisterPayload) ProtoMessage() {}
+
+func (x *TournamentRegisterPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentRegisterPayload.ProtoReflect.Descriptor instead.
+func (*TournamentRegisterPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TournamentRegisterPayload) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentRegisterPayload) GetRegister() bool {
+	if x != nil {
+		return x.Register
+	}
+	return false
+}
+
 // PlayCardsPayload 出牌请求
 type PlayCardsPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cards         []*CardInfo            `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	HandKind      int64                  `protobuf:"varint,2,opt,name=hand_kind,json=handKind,proto3" json:"hand_kind,omitempty"` // 指定读法的牌型（一手牌有多种读法时），0 表示由服务端选择
+	KeyRank       int64                  `protobuf:"varint,3,opt,name=key_rank,json=keyRank,proto3" json:"key_rank,omitempty"`    // 指定读法的关键点数
+	Soft          bool                   `protobuf:"varint,4,opt,name=soft,proto3" json:"soft,omitempty"`                         // 指定读法是否为软炸弹
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayCardsPayload) Reset() {
 	*x = PlayCardsPayload{}
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +658,7 @@ func (x *PlayCardsPayload) String() string {
 func (*PlayCardsPayload) ProtoMessage() {}
 
 func (x *PlayCardsPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +671,7 @@ func (x *PlayCardsPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayCardsPayload.ProtoReflect.Descriptor instead.
 func (*PlayCardsPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{4}
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PlayCardsPayload) GetCards() []*CardInfo {
@@ -254,6 +681,27 @@ func (x *PlayCardsPayload) GetCards() []*CardInfo {
 	return nil
 }
 
+func (x *PlayCardsPayload) GetHandKind() int64 {
+	if x != nil {
+		return x.HandKind
+	}
+	return 0
+}
+
+func (x *PlayCardsPayload) GetKeyRank() int64 {
+	if x != nil {
+		return x.KeyRank
+	}
+	return 0
+}
+
+func (x *PlayCardsPayload) GetSoft() bool {
+	if x != nil {
+		return x.Soft
+	}
+	return false
+}
+
 // GetLeaderboardPayload 获取排行榜请求
 type GetLeaderboardPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -266,7 +714,7 @@ type GetLeaderboardPayload struct {
 
 func (x *GetLeaderboardPayload) Reset() {
 	*x = GetLeaderboardPayload{}
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +726,7 @@ func (x *GetLeaderboardPayload) String() string {
 func (*GetLeaderboardPayload) ProtoMessage() {}
 
 func (x *GetLeaderboardPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_client_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_client_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +739,7 @@ func (x *GetLeaderboardPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaderboardPayload.ProtoReflect.Descriptor instead.
 func (*GetLeaderboardPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{5}
+	return file_internal_protocol_proto_client_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetLeaderboardPayload) GetType() string {
@@ -324,14 +772,41 @@ const file_internal_protocol_proto_client_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"+\n" +
 	"\vPingPayload\x12\x1c\n" +
-	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\".\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\"\xa5\x01\n" +
+	"\x11CreateRoomPayload\x12\x19\n" +
+	"\brule_set\x18\x01 \x01(\tR\aruleSet\x12\x14\n" +
+	"\x05laizi\x18\x02 \x01(\bR\x05laizi\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x19\n" +
+	"\bbid_mode\x18\x04 \x01(\tR\abidMode\x12\x1a\n" +
+	"\bdoubling\x18\x05 \x01(\bR\bdoubling\x12\x14\n" +
+	"\x05hands\x18\x06 \x01(\x03R\x05hands\"'\n" +
+	"\x11QuickMatchPayload\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\"'\n" +
+	"\x11ClientSeedPayload\x12\x12\n" +
+	"\x04seed\x18\x01 \x01(\tR\x04seed\".\n" +
 	"\x0fJoinRoomPayload\x12\x1b\n" +
-	"\troom_code\x18\x01 \x01(\tR\broomCode\"\x1e\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\"2\n" +
+	"\x13SpectateRoomPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\"4\n" +
 	"\n" +
 	"BidPayload\x12\x10\n" +
-	"\x03bid\x18\x01 \x01(\bR\x03bid\"<\n" +
+	"\x03bid\x18\x01 \x01(\bR\x03bid\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x03R\x05score\"%\n" +
+	"\rDoublePayload\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x03R\x05level\"*\n" +
+	"\x0eTrusteePayload\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"L\n" +
+	"\x15RematchRequestPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"\\\n" +
+	"\x19TournamentRegisterPayload\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x1a\n" +
+	"\bregister\x18\x02 \x01(\bR\bregister\"\x88\x01\n" +
 	"\x10PlayCardsPayload\x12(\n" +
-	"\x05cards\x18\x01 \x03(\v2\x12.protocol.CardInfoR\x05cards\"Y\n" +
+	"\x05cards\x18\x01 \x03(\v2\x12.protocol.CardInfoR\x05cards\x12\x1b\n" +
+	"\thand_kind\x18\x02 \x01(\x03R\bhandKind\x12\x19\n" +
+	"\bkey_rank\x18\x03 \x01(\x03R\akeyRank\x12\x12\n" +
+	"\x04soft\x18\x04 \x01(\bR\x04soft\"Y\n" +
 	"\x15GetLeaderboardPayload\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x14\n" +
@@ -349,23 +824,31 @@ func file_internal_protocol_proto_client_proto_rawDescGZIP() []byte {
 	return file_internal_protocol_proto_client_proto_rawDescData
 }
 
-var file_internal_protocol_proto_client_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_internal_protocol_proto_client_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_internal_protocol_proto_client_proto_goTypes = []any{
-	(*ReconnectPayload)(nil),      // 0: protocol.ReconnectPayload
-	(*PingPayload)(nil),           // 1: protocol.PingPayload
-	(*JoinRoomPayload)(nil),       // 2: protocol.JoinRoomPayload
-	(*BidPayload)(nil),            // 3: protocol.BidPayload
-	(*PlayCardsPayload)(nil),      // 4: protocol.PlayCardsPayload
-	(*GetLeaderboardPayload)(nil), // 5: protocol.GetLeaderboardPayload
-	(*CardInfo)(nil),              // 6: protocol.CardInfo
+	(*ReconnectPayload)(nil),          // 0: protocol.ReconnectPayload
+	(*PingPayload)(nil),               // 1: protocol.PingPayload
+	(*CreateRoomPayload)(nil),         // 2: protocol.CreateRoomPayload
+	(*QuickMatchPayload)(nil),         // 3: protocol.QuickMatchPayload
+	(*ClientSeedPayload)(nil),         // 4: protocol.ClientSeedPayload
+	(*JoinRoomPayload)(nil),           // 5: protocol.JoinRoomPayload
+	(*SpectateRoomPayload)(nil),       // 6: protocol.SpectateRoomPayload
+	(*BidPayload)(nil),                // 7: protocol.BidPayload
+	(*DoublePayload)(nil),             // 8: protocol.DoublePayload
+	(*TrusteePayload)(nil),            // 9: protocol.TrusteePayload
+	(*RematchRequestPayload)(nil),     // 10: protocol.RematchRequestPayload
+	(*TournamentRegisterPayload)(nil), // 11: protocol.TournamentRegisterPayload
+	(*PlayCardsPayload)(nil),          // 12: protocol.PlayCardsPayload
+	(*GetLeaderboardPayload)(nil),     // 13: protocol.GetLeaderboardPayload
+	(*CardInfo)(nil),                  // 14: protocol.CardInfo
 }
 var file_internal_protocol_proto_client_proto_depIdxs = []int32{
-	6, // 0: protocol.PlayCardsPayload.cards:type_name -> protocol.CardInfo
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	14, // 0: protocol.PlayCardsPayload.cards:type_name -> protocol.CardInfo
+	1,  // [1:1] is the sub-list for method output_type
+	1,  // [1:1] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_internal_protocol_proto_client_proto_init() }
@@ -380,7 +863,7 @@ func file_internal_protocol_proto_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_protocol_proto_client_proto_rawDesc), len(file_internal_protocol_proto_client_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -92,6 +92,7 @@ type PlayerInfo struct {
 	IsLandlord    bool                   `protobuf:"varint,5,opt,name=is_landlord,json=isLandlord,proto3" json:"is_landlord,omitempty"` // 是否是地主
 	CardsCount    int64                  `protobuf:"varint,6,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"` // 手牌数量
 	Online        bool                   `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`                           // 是否在线
+	Trustee       bool                   `protobuf:"varint,8,opt,name=trustee,proto3" json:"trustee,omitempty"`                         // 是否托管
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -175,6 +176,13 @@ func (x *PlayerInfo) GetOnline() bool {
 	return false
 }
 
+func (x *PlayerInfo) GetTrustee() bool {
+	if x != nil {
+		return x.Trustee
+	}
+	return false
+}
+
 // PlayerHand 玩家手牌信息（用于游戏结束展示）
 type PlayerHand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -243,6 +251,8 @@ type PlayerScore struct {
 	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
 	IsLandlord    bool                   `protobuf:"varint,3,opt,name=is_landlord,json=isLandlord,proto3" json:"is_landlord,omitempty"` // 是否是地主
 	Score         int64                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`                             // 本局得分（输为负，赢为正）
+	Multiplier    int64                  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                   // 计入加倍后该玩家的结算倍数
+	Double        int64                  `protobuf:"varint,6,opt,name=double,proto3" json:"double,omitempty"`                           // 该玩家的加倍选择
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -305,25 +315,142 @@ func (x *PlayerScore) GetScore() int64 {
 	return 0
 }
 
+func (x *PlayerScore) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *PlayerScore) GetDouble() int64 {
+	if x != nil {
+		return x.Double
+	}
+	return 0
+}
+
+// MultiplierBreakdown 倍数构成，各项相乘即为当前倍数
+type MultiplierBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          int64                  `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`                                  // 底分：叫分模式为所叫分数，叫抢模式为 1
+	GrabCount     int64                  `protobuf:"varint,2,opt,name=grab_count,json=grabCount,proto3" json:"grab_count,omitempty"`       // 抢地主次数，每次 ×2
+	ShowHand      int64                  `protobuf:"varint,3,opt,name=show_hand,json=showHand,proto3" json:"show_hand,omitempty"`          // 明牌倍数，未明牌为 1
+	BottomBonus   int64                  `protobuf:"varint,4,opt,name=bottom_bonus,json=bottomBonus,proto3" json:"bottom_bonus,omitempty"` // 底牌翻倍倍数，未翻倍为 1
+	BombCount     int64                  `protobuf:"varint,5,opt,name=bomb_count,json=bombCount,proto3" json:"bomb_count,omitempty"`       // 炸弹/王炸翻倍次数（癞子玩法硬炸弹计两次）
+	Spring        bool                   `protobuf:"varint,6,opt,name=spring,proto3" json:"spring,omitempty"`                              // 春天 ×2
+	AntiSpring    bool                   `protobuf:"varint,7,opt,name=anti_spring,json=antiSpring,proto3" json:"anti_spring,omitempty"`    // 反春天 ×2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiplierBreakdown) Reset() {
+	*x = MultiplierBreakdown{}
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiplierBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiplierBreakdown) ProtoMessage() {}
+
+func (x *MultiplierBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiplierBreakdown.ProtoReflect.Descriptor instead.
+func (*MultiplierBreakdown) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MultiplierBreakdown) GetBase() int64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *MultiplierBreakdown) GetGrabCount() int64 {
+	if x != nil {
+		return x.GrabCount
+	}
+	return 0
+}
+
+func (x *MultiplierBreakdown) GetShowHand() int64 {
+	if x != nil {
+		return x.ShowHand
+	}
+	return 0
+}
+
+func (x *MultiplierBreakdown) GetBottomBonus() int64 {
+	if x != nil {
+		return x.BottomBonus
+	}
+	return 0
+}
+
+func (x *MultiplierBreakdown) GetBombCount() int64 {
+	if x != nil {
+		return x.BombCount
+	}
+	return 0
+}
+
+func (x *MultiplierBreakdown) GetSpring() bool {
+	if x != nil {
+		return x.Spring
+	}
+	return false
+}
+
+func (x *MultiplierBreakdown) GetAntiSpring() bool {
+	if x != nil {
+		return x.AntiSpring
+	}
+	return false
+}
+
 // GameStateDTO 游戏状态数据传输对象（用于重连恢复）
 type GameStateDTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                                     // bidding/playing
-	Players       []*PlayerInfo          `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                                 // 所有玩家信息
-	Hand          []*CardInfo            `protobuf:"bytes,3,rep,name=hand,proto3" json:"hand,omitempty"`                                       // 自己的手牌
-	BottomCards   []*CardInfo            `protobuf:"bytes,4,rep,name=bottom_cards,json=bottomCards,proto3" json:"bottom_cards,omitempty"`      // 底牌
-	CurrentTurn   string                 `protobuf:"bytes,5,opt,name=current_turn,json=currentTurn,proto3" json:"current_turn,omitempty"`      // 当前回合玩家 ID
-	LastPlayed    []*CardInfo            `protobuf:"bytes,6,rep,name=last_played,json=lastPlayed,proto3" json:"last_played,omitempty"`         // 上家出的牌
-	LastPlayerId  string                 `protobuf:"bytes,7,opt,name=last_player_id,json=lastPlayerId,proto3" json:"last_player_id,omitempty"` // 上家 ID
-	MustPlay      bool                   `protobuf:"varint,8,opt,name=must_play,json=mustPlay,proto3" json:"must_play,omitempty"`              // 是否必须出牌
-	CanBeat       bool                   `protobuf:"varint,9,opt,name=can_beat,json=canBeat,proto3" json:"can_beat,omitempty"`                 // 是否能打过
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                                       // bidding/playing
+	Players       []*PlayerInfo          `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`                                   // 所有玩家信息
+	Hand          []*CardInfo            `protobuf:"bytes,3,rep,name=hand,proto3" json:"hand,omitempty"`                                         // 自己的手牌
+	BottomCards   []*CardInfo            `protobuf:"bytes,4,rep,name=bottom_cards,json=bottomCards,proto3" json:"bottom_cards,omitempty"`        // 底牌
+	CurrentTurn   string                 `protobuf:"bytes,5,opt,name=current_turn,json=currentTurn,proto3" json:"current_turn,omitempty"`        // 当前回合玩家 ID
+	LastPlayed    []*CardInfo            `protobuf:"bytes,6,rep,name=last_played,json=lastPlayed,proto3" json:"last_played,omitempty"`           // 上家出的牌
+	LastPlayerId  string                 `protobuf:"bytes,7,opt,name=last_player_id,json=lastPlayerId,proto3" json:"last_player_id,omitempty"`   // 上家 ID
+	MustPlay      bool                   `protobuf:"varint,8,opt,name=must_play,json=mustPlay,proto3" json:"must_play,omitempty"`                // 是否必须出牌
+	CanBeat       bool                   `protobuf:"varint,9,opt,name=can_beat,json=canBeat,proto3" json:"can_beat,omitempty"`                   // 是否能打过
+	WildRank      int64                  `protobuf:"varint,10,opt,name=wild_rank,json=wildRank,proto3" json:"wild_rank,omitempty"`               // 癞子点数，0 表示非癞子玩法
+	Mode          string                 `protobuf:"bytes,11,opt,name=mode,proto3" json:"mode,omitempty"`                                        // 人数玩法模式
+	LastHandType  string                 `protobuf:"bytes,12,opt,name=last_hand_type,json=lastHandType,proto3" json:"last_hand_type,omitempty"`  // 上家出牌的牌型名称
+	RevealedHands []*PlayerHand          `protobuf:"bytes,13,rep,name=revealed_hands,json=revealedHands,proto3" json:"revealed_hands,omitempty"` // 明牌玩家的手牌
+	Multiplier    int64                  `protobuf:"varint,14,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                           // 当前倍数
+	Breakdown     *MultiplierBreakdown   `protobuf:"bytes,15,opt,name=breakdown,proto3" json:"breakdown,omitempty"`                              // 当前倍数构成
+	TimeBank      int64                  `protobuf:"varint,16,opt,name=time_bank,json=timeBank,proto3" json:"time_bank,omitempty"`               // 当前出牌玩家剩余的备用时间（秒）
+	LastHandKind  int64                  `protobuf:"varint,17,opt,name=last_hand_kind,json=lastHandKind,proto3" json:"last_hand_kind,omitempty"` // 上家出牌读法的牌型
+	LastKeyRank   int64                  `protobuf:"varint,18,opt,name=last_key_rank,json=lastKeyRank,proto3" json:"last_key_rank,omitempty"`    // 上家出牌读法的关键点数
+	LastSoft      bool                   `protobuf:"varint,19,opt,name=last_soft,json=lastSoft,proto3" json:"last_soft,omitempty"`               // 上家出牌是否为软炸弹
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GameStateDTO) Reset() {
 	*x = GameStateDTO{}
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +462,7 @@ func (x *GameStateDTO) String() string {
 func (*GameStateDTO) ProtoMessage() {}
 
 func (x *GameStateDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +475,7 @@ func (x *GameStateDTO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameStateDTO.ProtoReflect.Descriptor instead.
 func (*GameStateDTO) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GameStateDTO) GetPhase() string {
@@ -414,6 +541,76 @@ func (x *GameStateDTO) GetCanBeat() bool {
 	return false
 }
 
+func (x *GameStateDTO) GetWildRank() int64 {
+	if x != nil {
+		return x.WildRank
+	}
+	return 0
+}
+
+func (x *GameStateDTO) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GameStateDTO) GetLastHandType() string {
+	if x != nil {
+		return x.LastHandType
+	}
+	return ""
+}
+
+func (x *GameStateDTO) GetRevealedHands() []*PlayerHand {
+	if x != nil {
+		return x.RevealedHands
+	}
+	return nil
+}
+
+func (x *GameStateDTO) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *GameStateDTO) GetBreakdown() *MultiplierBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *GameStateDTO) GetTimeBank() int64 {
+	if x != nil {
+		return x.TimeBank
+	}
+	return 0
+}
+
+func (x *GameStateDTO) GetLastHandKind() int64 {
+	if x != nil {
+		return x.LastHandKind
+	}
+	return 0
+}
+
+func (x *GameStateDTO) GetLastKeyRank() int64 {
+	if x != nil {
+		return x.LastKeyRank
+	}
+	return 0
+}
+
+func (x *GameStateDTO) GetLastSoft() bool {
+	if x != nil {
+		return x.LastSoft
+	}
+	return false
+}
+
 // LeaderboardEntry 排行榜条目
 type LeaderboardEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -429,7 +626,7 @@ type LeaderboardEntry struct {
 
 func (x *LeaderboardEntry) Reset() {
 	*x = LeaderboardEntry{}
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +638,7 @@ func (x *LeaderboardEntry) String() string {
 func (*LeaderboardEntry) ProtoMessage() {}
 
 func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +651,7 @@ func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
 func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{5}
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LeaderboardEntry) GetRank() int64 {
@@ -511,7 +708,7 @@ type RoomListItem struct {
 
 func (x *RoomListItem) Reset() {
 	*x = RoomListItem{}
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[6]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +720,7 @@ func (x *RoomListItem) String() string {
 func (*RoomListItem) ProtoMessage() {}
 
 func (x *RoomListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_common_proto_msgTypes[6]
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +733,7 @@ func (x *RoomListItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomListItem.ProtoReflect.Descriptor instead.
 func (*RoomListItem) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{6}
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RoomListItem) GetRoomCode() string {
@@ -560,6 +757,184 @@ func (x *RoomListItem) GetMaxPlayers() int64 {
 	return 0
 }
 
+// TournamentInfo 锦标赛列表项
+type TournamentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartAt       int64                  `protobuf:"varint,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // 开赛时间（Unix 秒）
+	Players       int64                  `protobuf:"varint,4,opt,name=players,proto3" json:"players,omitempty"`                // 报名人数
+	Registered    bool                   `protobuf:"varint,5,opt,name=registered,proto3" json:"registered,omitempty"`          // 请求者是否已报名
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`                     // registering/running
+	Round         int64                  `protobuf:"varint,7,opt,name=round,proto3" json:"round,omitempty"`                    // 进行中的轮次
+	Hands         int64                  `protobuf:"varint,8,opt,name=hands,proto3" json:"hands,omitempty"`                    // 每轮每桌局数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentInfo) Reset() {
+	*x = TournamentInfo{}
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentInfo) ProtoMessage() {}
+
+func (x *TournamentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentInfo.ProtoReflect.Descriptor instead.
+func (*TournamentInfo) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TournamentInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TournamentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetPlayers() int64 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *TournamentInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TournamentInfo) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentInfo) GetHands() int64 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
+// TournamentStanding 锦标赛中一名选手的成绩
+type TournamentStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`           // 各轮累计得分
+	Round         int64                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`           // 打到第几轮
+	Eliminated    bool                   `protobuf:"varint,5,opt,name=eliminated,proto3" json:"eliminated,omitempty"` // 是否已被淘汰
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentStanding) Reset() {
+	*x = TournamentStanding{}
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStanding) ProtoMessage() {}
+
+func (x *TournamentStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStanding.ProtoReflect.Descriptor instead.
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TournamentStanding) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *TournamentStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *TournamentStanding) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentStanding) GetEliminated() bool {
+	if x != nil {
+		return x.Eliminated
+	}
+	return false
+}
+
 var File_internal_protocol_proto_common_proto protoreflect.FileDescriptor
 
 const file_internal_protocol_proto_common_proto_rawDesc = "" +
@@ -568,7 +943,7 @@ const file_internal_protocol_proto_common_proto_rawDesc = "" +
 	"\bCardInfo\x12\x12\n" +
 	"\x04suit\x18\x01 \x01(\x03R\x04suit\x12\x12\n" +
 	"\x04rank\x18\x02 \x01(\x03R\x04rank\x12\x14\n" +
-	"\x05color\x18\x03 \x01(\x03R\x05color\"\xb4\x01\n" +
+	"\x05color\x18\x03 \x01(\x03R\x05color\"\xce\x01\n" +
 	"\n" +
 	"PlayerInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -579,20 +954,36 @@ const file_internal_protocol_proto_common_proto_rawDesc = "" +
 	"isLandlord\x12\x1f\n" +
 	"\vcards_count\x18\x06 \x01(\x03R\n" +
 	"cardsCount\x12\x16\n" +
-	"\x06online\x18\a \x01(\bR\x06online\"t\n" +
+	"\x06online\x18\a \x01(\bR\x06online\x12\x18\n" +
+	"\atrustee\x18\b \x01(\bR\atrustee\"t\n" +
 	"\n" +
 	"PlayerHand\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
 	"playerName\x12(\n" +
-	"\x05cards\x18\x03 \x03(\v2\x12.protocol.CardInfoR\x05cards\"\x82\x01\n" +
+	"\x05cards\x18\x03 \x03(\v2\x12.protocol.CardInfoR\x05cards\"\xba\x01\n" +
 	"\vPlayerScore\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
 	"playerName\x12\x1f\n" +
 	"\vis_landlord\x18\x03 \x01(\bR\n" +
 	"isLandlord\x12\x14\n" +
-	"\x05score\x18\x04 \x01(\x03R\x05score\"\xe9\x02\n" +
+	"\x05score\x18\x04 \x01(\x03R\x05score\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x05 \x01(\x03R\n" +
+	"multiplier\x12\x16\n" +
+	"\x06double\x18\x06 \x01(\x03R\x06double\"\xe0\x01\n" +
+	"\x13MultiplierBreakdown\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\x03R\x04base\x12\x1d\n" +
+	"\n" +
+	"grab_count\x18\x02 \x01(\x03R\tgrabCount\x12\x1b\n" +
+	"\tshow_hand\x18\x03 \x01(\x03R\bshowHand\x12!\n" +
+	"\fbottom_bonus\x18\x04 \x01(\x03R\vbottomBonus\x12\x1d\n" +
+	"\n" +
+	"bomb_count\x18\x05 \x01(\x03R\tbombCount\x12\x16\n" +
+	"\x06spring\x18\x06 \x01(\bR\x06spring\x12\x1f\n" +
+	"\vanti_spring\x18\a \x01(\bR\n" +
+	"antiSpring\"\xde\x05\n" +
 	"\fGameStateDTO\x12\x14\n" +
 	"\x05phase\x18\x01 \x01(\tR\x05phase\x12.\n" +
 	"\aplayers\x18\x02 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\x12&\n" +
@@ -603,7 +994,20 @@ const file_internal_protocol_proto_common_proto_rawDesc = "" +
 	"lastPlayed\x12$\n" +
 	"\x0elast_player_id\x18\a \x01(\tR\flastPlayerId\x12\x1b\n" +
 	"\tmust_play\x18\b \x01(\bR\bmustPlay\x12\x19\n" +
-	"\bcan_beat\x18\t \x01(\bR\acanBeat\"\xa9\x01\n" +
+	"\bcan_beat\x18\t \x01(\bR\acanBeat\x12\x1b\n" +
+	"\twild_rank\x18\n" +
+	" \x01(\x03R\bwildRank\x12\x12\n" +
+	"\x04mode\x18\v \x01(\tR\x04mode\x12$\n" +
+	"\x0elast_hand_type\x18\f \x01(\tR\flastHandType\x12;\n" +
+	"\x0erevealed_hands\x18\r \x03(\v2\x14.protocol.PlayerHandR\rrevealedHands\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x0e \x01(\x03R\n" +
+	"multiplier\x12;\n" +
+	"\tbreakdown\x18\x0f \x01(\v2\x1d.protocol.MultiplierBreakdownR\tbreakdown\x12\x1b\n" +
+	"\ttime_bank\x18\x10 \x01(\x03R\btimeBank\x12$\n" +
+	"\x0elast_hand_kind\x18\x11 \x01(\x03R\flastHandKind\x12\"\n" +
+	"\rlast_key_rank\x18\x12 \x01(\x03R\vlastKeyRank\x12\x1b\n" +
+	"\tlast_soft\x18\x13 \x01(\bR\blastSoft\"\xa9\x01\n" +
 	"\x10LeaderboardEntry\x12\x12\n" +
 	"\x04rank\x18\x01 \x01(\x03R\x04rank\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x1f\n" +
@@ -616,7 +1020,27 @@ const file_internal_protocol_proto_common_proto_rawDesc = "" +
 	"\troom_code\x18\x01 \x01(\tR\broomCode\x12!\n" +
 	"\fplayer_count\x18\x02 \x01(\x03R\vplayerCount\x12\x1f\n" +
 	"\vmax_players\x18\x03 \x01(\x03R\n" +
-	"maxPlayersB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
+	"maxPlayers\"\xcb\x01\n" +
+	"\x0eTournamentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\x03R\astartAt\x12\x18\n" +
+	"\aplayers\x18\x04 \x01(\x03R\aplayers\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x05 \x01(\bR\n" +
+	"registered\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x14\n" +
+	"\x05round\x18\a \x01(\x03R\x05round\x12\x14\n" +
+	"\x05hands\x18\b \x01(\x03R\x05hands\"\x9e\x01\n" +
+	"\x12TournamentStanding\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\x03R\x05round\x12\x1e\n" +
+	"\n" +
+	"eliminated\x18\x05 \x01(\bR\n" +
+	"eliminatedB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
 
 var (
 	file_internal_protocol_proto_common_proto_rawDescOnce sync.Once
@@ -630,15 +1054,18 @@ func file_internal_protocol_proto_common_proto_rawDescGZIP() []byte {
 	return file_internal_protocol_proto_common_proto_rawDescData
 }
 
-var file_internal_protocol_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_protocol_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_internal_protocol_proto_common_proto_goTypes = []any{
-	(*CardInfo)(nil),         // 0: protocol.CardInfo
-	(*PlayerInfo)(nil),       // 1: protocol.PlayerInfo
-	(*PlayerHand)(nil),       // 2: protocol.PlayerHand
-	(*PlayerScore)(nil),      // 3: protocol.PlayerScore
-	(*GameStateDTO)(nil),     // 4: protocol.GameStateDTO
-	(*LeaderboardEntry)(nil), // 5: protocol.LeaderboardEntry
-	(*RoomListItem)(nil),     // 6: protocol.RoomListItem
+	(*CardInfo)(nil),            // 0: protocol.CardInfo
+	(*PlayerInfo)(nil),          // 1: protocol.PlayerInfo
+	(*PlayerHand)(nil),          // 2: protocol.PlayerHand
+	(*PlayerScore)(nil),         // 3: protocol.PlayerScore
+	(*MultiplierBreakdown)(nil), // 4: protocol.MultiplierBreakdown
+	(*GameStateDTO)(nil),        // 5: protocol.GameStateDTO
+	(*LeaderboardEntry)(nil),    // 6: protocol.LeaderboardEntry
+	(*RoomListItem)(nil),        // 7: protocol.RoomListItem
+	(*TournamentInfo)(nil),      // 8: protocol.TournamentInfo
+	(*TournamentStanding)(nil),  // 9: protocol.TournamentStanding
 }
 var file_internal_protocol_proto_common_proto_depIdxs = []int32{
 	0, // 0: protocol.PlayerHand.cards:type_name -> protocol.CardInfo
@@ -646,11 +1073,13 @@ var file_internal_protocol_proto_common_proto_depIdxs = []int32{
 	0, // 2: protocol.GameStateDTO.hand:type_name -> protocol.CardInfo
 	0, // 3: protocol.GameStateDTO.bottom_cards:type_name -> protocol.CardInfo
 	0, // 4: protocol.GameStateDTO.last_played:type_name -> protocol.CardInfo
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 5: protocol.GameStateDTO.revealed_hands:type_name -> protocol.PlayerHand
+	4, // 6: protocol.GameStateDTO.breakdown:type_name -> protocol.MultiplierBreakdown
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_internal_protocol_proto_common_proto_init() }
@@ -664,7 +1093,7 @@ func file_internal_protocol_proto_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_protocol_proto_common_proto_rawDesc), len(file_internal_protocol_proto_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,7 @@ type RoomCreatedPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
 	Player        *PlayerInfo            `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	SeedCommit    string                 `protobuf:"bytes,3,opt,name=seed_commit,json=seedCommit,proto3" json:"seed_commit,omitempty"` // 下一局服务端种子的承诺
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,12 +75,20 @@ func (x *RoomCreatedPayload) GetPlayer() *PlayerInfo {
 	return nil
 }
 
+func (x *RoomCreatedPayload) GetSeedCommit() string {
+	if x != nil {
+		return x.SeedCommit
+	}
+	return ""
+}
+
 // RoomJoinedPayload 加入房间成功响应
 type RoomJoinedPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
 	Player        *PlayerInfo            `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
 	Players       []*PlayerInfo          `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	SeedCommit    string                 `protobuf:"bytes,4,opt,name=seed_commit,json=seedCommit,proto3" json:"seed_commit,omitempty"` // 下一局服务端种子的承诺
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -135,6 +144,13 @@ func (x *RoomJoinedPayload) GetPlayers() []*PlayerInfo {
 	return nil
 }
 
+func (x *RoomJoinedPayload) GetSeedCommit() string {
+	if x != nil {
+		return x.SeedCommit
+	}
+	return ""
+}
+
 // PlayerJoinedPayload 其他玩家加入通知
 type PlayerJoinedPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -290,6 +306,12 @@ func (x *PlayerReadyPayload) GetReady() bool {
 type GameStartPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Players       []*PlayerInfo          `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	RuleSet       string                 `protobuf:"bytes,2,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`          // 本局房规名称
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                               // 人数玩法模式
+	SeedCommit    string                 `protobuf:"bytes,4,opt,name=seed_commit,json=seedCommit,proto3" json:"seed_commit,omitempty"` // 服务端种子的 SHA-256 承诺值
+	BidMode       string                 `protobuf:"bytes,5,opt,name=bid_mode,json=bidMode,proto3" json:"bid_mode,omitempty"`          // 叫地主方式
+	Hand          int64                  `protobuf:"varint,6,opt,name=hand,proto3" json:"hand,omitempty"`                              // 系列赛中的第几局，从 1 开始
+	Hands         int64                  `protobuf:"varint,7,opt,name=hands,proto3" json:"hands,omitempty"`                            // 系列赛总局数，0 表示单局
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -331,11 +353,54 @@ func (x *GameStartPayload) GetPlayers() []*PlayerInfo {
 	return nil
 }
 
+func (x *GameStartPayload) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *GameStartPayload) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *GameStartPayload) GetSeedCommit() string {
+	if x != nil {
+		return x.SeedCommit
+	}
+	return ""
+}
+
+func (x *GameStartPayload) GetBidMode() string {
+	if x != nil {
+		return x.BidMode
+	}
+	return ""
+}
+
+func (x *GameStartPayload) GetHand() int64 {
+	if x != nil {
+		return x.Hand
+	}
+	return 0
+}
+
+func (x *GameStartPayload) GetHands() int64 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
 // DealCardsPayload 发牌通知
 type DealCardsPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cards         []*CardInfo            `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
 	BottomCards   []*CardInfo            `protobuf:"bytes,2,rep,name=bottom_cards,json=bottomCards,proto3" json:"bottom_cards,omitempty"`
+	WildRank      int64                  `protobuf:"varint,3,opt,name=wild_rank,json=wildRank,proto3" json:"wild_rank,omitempty"` // 本局癞子点数，0 表示非癞子玩法
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -384,6 +449,13 @@ func (x *DealCardsPayload) GetBottomCards() []*CardInfo {
 	return nil
 }
 
+func (x *DealCardsPayload) GetWildRank() int64 {
+	if x != nil {
+		return x.WildRank
+	}
+	return 0
+}
+
 // BidTurnPayload 轮到叫地主通知
 type BidTurnPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -391,6 +463,7 @@ type BidTurnPayload struct {
 	Timeout       int64                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	IsGrab        bool                   `protobuf:"varint,3,opt,name=is_grab,json=isGrab,proto3" json:"is_grab,omitempty"` // true=抢地主阶段, false=叫地主阶段
 	Multiplier    int64                  `protobuf:"varint,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`       // 当前倍数
+	Options       []int64                `protobuf:"varint,5,rep,packed,name=options,proto3" json:"options,omitempty"`      // 叫分模式下可叫的分数（不含不叫）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -453,6 +526,13 @@ func (x *BidTurnPayload) GetMultiplier() int64 {
 	return 0
 }
 
+func (x *BidTurnPayload) GetOptions() []int64 {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 // BidResultPayload 叫地主结果通知
 type BidResultPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -461,6 +541,7 @@ type BidResultPayload struct {
 	Bid           bool                   `protobuf:"varint,3,opt,name=bid,proto3" json:"bid,omitempty"`                     // 是否叫/抢
 	IsGrab        bool                   `protobuf:"varint,4,opt,name=is_grab,json=isGrab,proto3" json:"is_grab,omitempty"` // 该决策是否处于抢地主阶段
 	Multiplier    int64                  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`       // 决策后的当前倍数
+	Score         int64                  `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`                 // 叫分模式下叫的分数，0 表示不叫
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -530,13 +611,22 @@ func (x *BidResultPayload) GetMultiplier() int64 {
 	return 0
 }
 
+func (x *BidResultPayload) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 // LandlordPayload 地主确定通知
 type LandlordPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
 	BottomCards   []*CardInfo            `protobuf:"bytes,3,rep,name=bottom_cards,json=bottomCards,proto3" json:"bottom_cards,omitempty"`
-	Multiplier    int64                  `protobuf:"varint,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 底倍（叫抢结束后的倍数）
+	Multiplier    int64                  `protobuf:"varint,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                           // 底倍（叫抢倍数 × 底牌翻倍）
+	BottomBonus   int64                  `protobuf:"varint,5,opt,name=bottom_bonus,json=bottomBonus,proto3" json:"bottom_bonus,omitempty"`      // 底牌翻倍倍数，无翻倍时为 1
+	BottomPattern string                 `protobuf:"bytes,6,opt,name=bottom_pattern,json=bottomPattern,proto3" json:"bottom_pattern,omitempty"` // 触发翻倍的底牌牌型名称，无翻倍时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -599,31 +689,44 @@ func (x *LandlordPayload) GetMultiplier() int64 {
 	return 0
 }
 
-// PlayTurnPayload 轮到出牌通知
-type PlayTurnPayload struct {
+func (x *LandlordPayload) GetBottomBonus() int64 {
+	if x != nil {
+		return x.BottomBonus
+	}
+	return 0
+}
+
+func (x *LandlordPayload) GetBottomPattern() string {
+	if x != nil {
+		return x.BottomPattern
+	}
+	return ""
+}
+
+// DoubleTurnPayload 加倍阶段开始通知（所有玩家同时选择）
+type DoubleTurnPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Timeout       int64                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	MustPlay      bool                   `protobuf:"varint,3,opt,name=must_play,json=mustPlay,proto3" json:"must_play,omitempty"`
-	CanBeat       bool                   `protobuf:"varint,4,opt,name=can_beat,json=canBeat,proto3" json:"can_beat,omitempty"`
+	Timeout       int64                  `protobuf:"varint,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Multiplier    int64                  `protobuf:"varint,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 当前底倍
+	Pending       []string               `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending,omitempty"`        // 尚未选择的玩家 ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayTurnPayload) Reset() {
-	*x = PlayTurnPayload{}
+func (x *DoubleTurnPayload) Reset() {
+	*x = DoubleTurnPayload{}
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayTurnPayload) String() string {
+func (x *DoubleTurnPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayTurnPayload) ProtoMessage() {}
+func (*DoubleTurnPayload) ProtoMessage() {}
 
-func (x *PlayTurnPayload) ProtoReflect() protoreflect.Message {
+func (x *DoubleTurnPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,65 +738,56 @@ func (x *PlayTurnPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayTurnPayload.ProtoReflect.Descriptor instead.
-func (*PlayTurnPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use DoubleTurnPayload.ProtoReflect.Descriptor instead.
+func (*DoubleTurnPayload) Descriptor() ([]byte, []int) {
 	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PlayTurnPayload) GetPlayerId() string {
-	if x != nil {
-		return x.PlayerId
-	}
-	return ""
-}
-
-func (x *PlayTurnPayload) GetTimeout() int64 {
+func (x *DoubleTurnPayload) GetTimeout() int64 {
 	if x != nil {
 		return x.Timeout
 	}
 	return 0
 }
 
-func (x *PlayTurnPayload) GetMustPlay() bool {
+func (x *DoubleTurnPayload) GetMultiplier() int64 {
 	if x != nil {
-		return x.MustPlay
+		return x.Multiplier
 	}
-	return false
+	return 0
 }
 
-func (x *PlayTurnPayload) GetCanBeat() bool {
+func (x *DoubleTurnPayload) GetPending() []string {
 	if x != nil {
-		return x.CanBeat
+		return x.Pending
 	}
-	return false
+	return nil
 }
 
-// CardPlayedPayload 出牌通知
-type CardPlayedPayload struct {
+// DoubleResultPayload 玩家加倍选择通知
+type DoubleResultPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	Cards         []*CardInfo            `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
-	CardsLeft     int64                  `protobuf:"varint,4,opt,name=cards_left,json=cardsLeft,proto3" json:"cards_left,omitempty"`
-	HandType      string                 `protobuf:"bytes,5,opt,name=hand_type,json=handType,proto3" json:"hand_type,omitempty"`
+	Level         int64                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"` // 0 = 不加倍, 1 = 加倍, 2 = 超级加倍
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardPlayedPayload) Reset() {
-	*x = CardPlayedPayload{}
+func (x *DoubleResultPayload) Reset() {
+	*x = DoubleResultPayload{}
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardPlayedPayload) String() string {
+func (x *DoubleResultPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardPlayedPayload) ProtoMessage() {}
+func (*DoubleResultPayload) ProtoMessage() {}
 
-func (x *CardPlayedPayload) ProtoReflect() protoreflect.Message {
+func (x *DoubleResultPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -705,69 +799,57 @@ func (x *CardPlayedPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardPlayedPayload.ProtoReflect.Descriptor instead.
-func (*CardPlayedPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use DoubleResultPayload.ProtoReflect.Descriptor instead.
+func (*DoubleResultPayload) Descriptor() ([]byte, []int) {
 	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CardPlayedPayload) GetPlayerId() string {
+func (x *DoubleResultPayload) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *CardPlayedPayload) GetPlayerName() string {
+func (x *DoubleResultPayload) GetPlayerName() string {
 	if x != nil {
 		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *CardPlayedPayload) GetCards() []*CardInfo {
-	if x != nil {
-		return x.Cards
-	}
-	return nil
-}
-
-func (x *CardPlayedPayload) GetCardsLeft() int64 {
+func (x *DoubleResultPayload) GetLevel() int64 {
 	if x != nil {
-		return x.CardsLeft
+		return x.Level
 	}
 	return 0
 }
 
-func (x *CardPlayedPayload) GetHandType() string {
-	if x != nil {
-		return x.HandType
-	}
-	return ""
-}
-
-// PlayerPassPayload 不出通知
-type PlayerPassPayload struct {
+// HandRevealedPayload 明牌玩家的当前手牌（明牌时及之后每次出牌后广播）
+type HandRevealedPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Cards         []*CardInfo            `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
+	Multiplier    int64                  `protobuf:"varint,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 该玩家明牌的倍数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerPassPayload) Reset() {
-	*x = PlayerPassPayload{}
+func (x *HandRevealedPayload) Reset() {
+	*x = HandRevealedPayload{}
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerPassPayload) String() string {
+func (x *HandRevealedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerPassPayload) ProtoMessage() {}
+func (*HandRevealedPayload) ProtoMessage() {}
 
-func (x *PlayerPassPayload) ProtoReflect() protoreflect.Message {
+func (x *HandRevealedPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,52 +861,63 @@ func (x *PlayerPassPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerPassPayload.ProtoReflect.Descriptor instead.
-func (*PlayerPassPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use HandRevealedPayload.ProtoReflect.Descriptor instead.
+func (*HandRevealedPayload) Descriptor() ([]byte, []int) {
 	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PlayerPassPayload) GetPlayerId() string {
+func (x *HandRevealedPayload) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *PlayerPassPayload) GetPlayerName() string {
+func (x *HandRevealedPayload) GetPlayerName() string {
 	if x != nil {
 		return x.PlayerName
 	}
 	return ""
 }
 
-// GameOverPayload 游戏结束通知
-type GameOverPayload struct {
+func (x *HandRevealedPayload) GetCards() []*CardInfo {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *HandRevealedPayload) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// TrusteeChangedPayload 玩家托管状态变化
+type TrusteeChangedPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
-	WinnerName    string                 `protobuf:"bytes,2,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
-	IsLandlord    bool                   `protobuf:"varint,3,opt,name=is_landlord,json=isLandlord,proto3" json:"is_landlord,omitempty"`
-	PlayerHands   []*PlayerHand          `protobuf:"bytes,4,rep,name=player_hands,json=playerHands,proto3" json:"player_hands,omitempty"`
-	Multiplier    int64                  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 最终倍数
-	Scores        []*PlayerScore         `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`          // 每位玩家本局得分
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameOverPayload) Reset() {
-	*x = GameOverPayload{}
+func (x *TrusteeChangedPayload) Reset() {
+	*x = TrusteeChangedPayload{}
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameOverPayload) String() string {
+func (x *TrusteeChangedPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameOverPayload) ProtoMessage() {}
+func (*TrusteeChangedPayload) ProtoMessage() {}
 
-func (x *GameOverPayload) ProtoReflect() protoreflect.Message {
+func (x *TrusteeChangedPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_protocol_proto_game_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,95 +929,826 @@ func (x *GameOverPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameOverPayload.ProtoReflect.Descriptor instead.
-func (*GameOverPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrusteeChangedPayload.ProtoReflect.Descriptor instead.
+func (*TrusteeChangedPayload) Descriptor() ([]byte, []int) {
 	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GameOverPayload) GetWinnerId() string {
+func (x *TrusteeChangedPayload) GetPlayerId() string {
 	if x != nil {
-		return x.WinnerId
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *GameOverPayload) GetWinnerName() string {
+func (x *TrusteeChangedPayload) GetPlayerName() string {
 	if x != nil {
-		return x.WinnerName
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *GameOverPayload) GetIsLandlord() bool {
+func (x *TrusteeChangedPayload) GetEnabled() bool {
 	if x != nil {
-		return x.IsLandlord
+		return x.Enabled
 	}
 	return false
 }
 
-func (x *GameOverPayload) GetPlayerHands() []*PlayerHand {
+// PlayTurnPayload 轮到出牌通知
+type PlayTurnPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Timeout       int64                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MustPlay      bool                   `protobuf:"varint,3,opt,name=must_play,json=mustPlay,proto3" json:"must_play,omitempty"`
+	CanBeat       bool                   `protobuf:"varint,4,opt,name=can_beat,json=canBeat,proto3" json:"can_beat,omitempty"`
+	TimeBank      int64                  `protobuf:"varint,5,opt,name=time_bank,json=timeBank,proto3" json:"time_bank,omitempty"` // 出牌玩家剩余的备用时间（秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayTurnPayload) Reset() {
+	*x = PlayTurnPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayTurnPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayTurnPayload) ProtoMessage() {}
+
+func (x *PlayTurnPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[14]
 	if x != nil {
-		return x.PlayerHands
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameOverPayload) GetMultiplier() int64 {
+// Deprecated: Use PlayTurnPayload.ProtoReflect.Descriptor instead.
+func (*PlayTurnPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayTurnPayload) GetPlayerId() string {
 	if x != nil {
-		return x.Multiplier
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayTurnPayload) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
 	}
 	return 0
 }
 
-func (x *GameOverPayload) GetScores() []*PlayerScore {
+func (x *PlayTurnPayload) GetMustPlay() bool {
 	if x != nil {
-		return x.Scores
+		return x.MustPlay
 	}
-	return nil
+	return false
 }
 
-var File_internal_protocol_proto_game_proto protoreflect.FileDescriptor
+func (x *PlayTurnPayload) GetCanBeat() bool {
+	if x != nil {
+		return x.CanBeat
+	}
+	return false
+}
 
-const file_internal_protocol_proto_game_proto_rawDesc = "" +
-	"\n" +
-	"\"internal/protocol/proto/game.proto\x12\bprotocol\x1a$internal/protocol/proto/common.proto\"_\n" +
-	"\x12RoomCreatedPayload\x12\x1b\n" +
-	"\troom_code\x18\x01 \x01(\tR\broomCode\x12,\n" +
-	"\x06player\x18\x02 \x01(\v2\x14.protocol.PlayerInfoR\x06player\"\x8e\x01\n" +
-	"\x11RoomJoinedPayload\x12\x1b\n" +
-	"\troom_code\x18\x01 \x01(\tR\broomCode\x12,\n" +
-	"\x06player\x18\x02 \x01(\v2\x14.protocol.PlayerInfoR\x06player\x12.\n" +
-	"\aplayers\x18\x03 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\"C\n" +
-	"\x13PlayerJoinedPayload\x12,\n" +
-	"\x06player\x18\x01 \x01(\v2\x14.protocol.PlayerInfoR\x06player\"Q\n" +
-	"\x11PlayerLeftPayload\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
-	"\vplayer_name\x18\x02 \x01(\tR\n" +
-	"playerName\"G\n" +
-	"\x12PlayerReadyPayload\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x14\n" +
-	"\x05ready\x18\x02 \x01(\bR\x05ready\"B\n" +
-	"\x10GameStartPayload\x12.\n" +
-	"\aplayers\x18\x01 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\"s\n" +
-	"\x10DealCardsPayload\x12(\n" +
-	"\x05cards\x18\x01 \x03(\v2\x12.protocol.CardInfoR\x05cards\x125\n" +
-	"\fbottom_cards\x18\x02 \x03(\v2\x12.protocol.CardInfoR\vbottomCards\"\x80\x01\n" +
-	"\x0eBidTurnPayload\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
-	"\atimeout\x18\x02 \x01(\x03R\atimeout\x12\x17\n" +
-	"\ais_grab\x18\x03 \x01(\bR\x06isGrab\x12\x1e\n" +
-	"\n" +
-	"multiplier\x18\x04 \x01(\x03R\n" +
-	"multiplier\"\x9b\x01\n" +
-	"\x10BidResultPayload\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
-	"\vplayer_name\x18\x02 \x01(\tR\n" +
+func (x *PlayTurnPayload) GetTimeBank() int64 {
+	if x != nil {
+		return x.TimeBank
+	}
+	return 0
+}
+
+// CardPlayedPayload 出牌通知
+type CardPlayedPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Cards         []*CardInfo            `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
+	CardsLeft     int64                  `protobuf:"varint,4,opt,name=cards_left,json=cardsLeft,proto3" json:"cards_left,omitempty"`
+	HandType      string                 `protobuf:"bytes,5,opt,name=hand_type,json=handType,proto3" json:"hand_type,omitempty"`
+	HandKind      int64                  `protobuf:"varint,6,opt,name=hand_kind,json=handKind,proto3" json:"hand_kind,omitempty"`
+	KeyRank       int64                  `protobuf:"varint,7,opt,name=key_rank,json=keyRank,proto3" json:"key_rank,omitempty"`
+	Soft          bool                   `protobuf:"varint,8,opt,name=soft,proto3" json:"soft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardPlayedPayload) Reset() {
+	*x = CardPlayedPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardPlayedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardPlayedPayload) ProtoMessage() {}
+
+func (x *CardPlayedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardPlayedPayload.ProtoReflect.Descriptor instead.
+func (*CardPlayedPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CardPlayedPayload) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CardPlayedPayload) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CardPlayedPayload) GetCards() []*CardInfo {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *CardPlayedPayload) GetCardsLeft() int64 {
+	if x != nil {
+		return x.CardsLeft
+	}
+	return 0
+}
+
+func (x *CardPlayedPayload) GetHandType() string {
+	if x != nil {
+		return x.HandType
+	}
+	return ""
+}
+
+func (x *CardPlayedPayload) GetHandKind() int64 {
+	if x != nil {
+		return x.HandKind
+	}
+	return 0
+}
+
+func (x *CardPlayedPayload) GetKeyRank() int64 {
+	if x != nil {
+		return x.KeyRank
+	}
+	return 0
+}
+
+func (x *CardPlayedPayload) GetSoft() bool {
+	if x != nil {
+		return x.Soft
+	}
+	return false
+}
+
+// PlayerPassPayload 不出通知
+type PlayerPassPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerPassPayload) Reset() {
+	*x = PlayerPassPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPassPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPassPayload) ProtoMessage() {}
+
+func (x *PlayerPassPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPassPayload.ProtoReflect.Descriptor instead.
+func (*PlayerPassPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PlayerPassPayload) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerPassPayload) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+// GameOverPayload 游戏结束通知
+type GameOverPayload struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WinnerId       string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	WinnerName     string                 `protobuf:"bytes,2,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	IsLandlord     bool                   `protobuf:"varint,3,opt,name=is_landlord,json=isLandlord,proto3" json:"is_landlord,omitempty"`
+	PlayerHands    []*PlayerHand          `protobuf:"bytes,4,rep,name=player_hands,json=playerHands,proto3" json:"player_hands,omitempty"`
+	Multiplier     int64                  `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                                // 最终倍数
+	Scores         []*PlayerScore         `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`                                         // 每位玩家本局得分
+	Proof          *ShuffleProof          `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`                                           // 发牌公平性证明
+	Breakdown      *MultiplierBreakdown   `protobuf:"bytes,8,opt,name=breakdown,proto3" json:"breakdown,omitempty"`                                   // 最终倍数的构成
+	NextSeedCommit string                 `protobuf:"bytes,9,opt,name=next_seed_commit,json=nextSeedCommit,proto3" json:"next_seed_commit,omitempty"` // 下一局服务端种子的承诺
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GameOverPayload) Reset() {
+	*x = GameOverPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOverPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOverPayload) ProtoMessage() {}
+
+func (x *GameOverPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOverPayload.ProtoReflect.Descriptor instead.
+func (*GameOverPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GameOverPayload) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *GameOverPayload) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+func (x *GameOverPayload) GetIsLandlord() bool {
+	if x != nil {
+		return x.IsLandlord
+	}
+	return false
+}
+
+func (x *GameOverPayload) GetPlayerHands() []*PlayerHand {
+	if x != nil {
+		return x.PlayerHands
+	}
+	return nil
+}
+
+func (x *GameOverPayload) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *GameOverPayload) GetScores() []*PlayerScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *GameOverPayload) GetProof() *ShuffleProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *GameOverPayload) GetBreakdown() *MultiplierBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *GameOverPayload) GetNextSeedCommit() string {
+	if x != nil {
+		return x.NextSeedCommit
+	}
+	return ""
+}
+
+// SeriesStanding 系列赛中一名玩家的累计成绩
+type SeriesStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"` // 累计得分
+	Last          int64                  `protobuf:"varint,4,opt,name=last,proto3" json:"last,omitempty"`   // 最近一局得分
+	Wins          int64                  `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`   // 获胜局数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesStanding) Reset() {
+	*x = SeriesStanding{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesStanding) ProtoMessage() {}
+
+func (x *SeriesStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesStanding.ProtoReflect.Descriptor instead.
+func (*SeriesStanding) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SeriesStanding) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SeriesStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SeriesStanding) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SeriesStanding) GetLast() int64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *SeriesStanding) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+// SeriesStandingsPayload 系列赛排名，每局结束后推送
+type SeriesStandingsPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hand          int64                  `protobuf:"varint,1,opt,name=hand,proto3" json:"hand,omitempty"`          // 已完成的局数
+	Hands         int64                  `protobuf:"varint,2,opt,name=hands,proto3" json:"hands,omitempty"`        // 系列赛总局数
+	Standings     []*SeriesStanding      `protobuf:"bytes,3,rep,name=standings,proto3" json:"standings,omitempty"` // 按累计得分从高到低
+	Final         bool                   `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`        // 系列赛是否已结束
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesStandingsPayload) Reset() {
+	*x = SeriesStandingsPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesStandingsPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesStandingsPayload) ProtoMessage() {}
+
+func (x *SeriesStandingsPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesStandingsPayload.ProtoReflect.Descriptor instead.
+func (*SeriesStandingsPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SeriesStandingsPayload) GetHand() int64 {
+	if x != nil {
+		return x.Hand
+	}
+	return 0
+}
+
+func (x *SeriesStandingsPayload) GetHands() int64 {
+	if x != nil {
+		return x.Hands
+	}
+	return 0
+}
+
+func (x *SeriesStandingsPayload) GetStandings() []*SeriesStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *SeriesStandingsPayload) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+// RematchStatusPayload 再来一局投票状态
+type RematchStatusPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	Agreed        []string               `protobuf:"bytes,2,rep,name=agreed,proto3" json:"agreed,omitempty"`    // 已同意的玩家 ID
+	Timeout       int64                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"` // 投票剩余秒数
+	Result        string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`    // pending/started/failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RematchStatusPayload) Reset() {
+	*x = RematchStatusPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RematchStatusPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchStatusPayload) ProtoMessage() {}
+
+func (x *RematchStatusPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchStatusPayload.ProtoReflect.Descriptor instead.
+func (*RematchStatusPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RematchStatusPayload) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *RematchStatusPayload) GetAgreed() []string {
+	if x != nil {
+		return x.Agreed
+	}
+	return nil
+}
+
+func (x *RematchStatusPayload) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *RematchStatusPayload) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// MultiplierUpdatePayload 倍数变化通知
+type MultiplierUpdatePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Multiplier    int64                  `protobuf:"varint,1,opt,name=multiplier,proto3" json:"multiplier,omitempty"` // 当前倍数
+	Breakdown     *MultiplierBreakdown   `protobuf:"bytes,2,opt,name=breakdown,proto3" json:"breakdown,omitempty"`    // 倍数构成
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiplierUpdatePayload) Reset() {
+	*x = MultiplierUpdatePayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiplierUpdatePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiplierUpdatePayload) ProtoMessage() {}
+
+func (x *MultiplierUpdatePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiplierUpdatePayload.ProtoReflect.Descriptor instead.
+func (*MultiplierUpdatePayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MultiplierUpdatePayload) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *MultiplierUpdatePayload) GetBreakdown() *MultiplierBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+// SpectatorHandsPayload 延迟公开给观战者的各家手牌
+type SpectatorHandsPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hands         []*PlayerHand          `protobuf:"bytes,1,rep,name=hands,proto3" json:"hands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectatorHandsPayload) Reset() {
+	*x = SpectatorHandsPayload{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorHandsPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorHandsPayload) ProtoMessage() {}
+
+func (x *SpectatorHandsPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorHandsPayload.ProtoReflect.Descriptor instead.
+func (*SpectatorHandsPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SpectatorHandsPayload) GetHands() []*PlayerHand {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+// ShuffleProof 结算时揭示的洗牌证明
+type ShuffleProof struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                  // 人数玩法模式，决定初始牌序
+	ServerSeed    string                 `protobuf:"bytes,2,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`    // 服务端种子（十六进制）
+	ClientSeeds   []string               `protobuf:"bytes,3,rep,name=client_seeds,json=clientSeeds,proto3" json:"client_seeds,omitempty"` // 按座位排列的客户端种子
+	Round         int64                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`                               // 发牌轮次（流局重发时递增）
+	Deck          []*CardInfo            `protobuf:"bytes,5,rep,name=deck,proto3" json:"deck,omitempty"`                                  // 洗牌后的完整牌序
+	Shift         int64                  `protobuf:"varint,6,opt,name=shift,proto3" json:"shift,omitempty"`                               // 复式赛本桌手牌相对原始牌序轮换的座位数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleProof) Reset() {
+	*x = ShuffleProof{}
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleProof) ProtoMessage() {}
+
+func (x *ShuffleProof) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleProof.ProtoReflect.Descriptor instead.
+func (*ShuffleProof) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ShuffleProof) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ShuffleProof) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *ShuffleProof) GetClientSeeds() []string {
+	if x != nil {
+		return x.ClientSeeds
+	}
+	return nil
+}
+
+func (x *ShuffleProof) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ShuffleProof) GetDeck() []*CardInfo {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *ShuffleProof) GetShift() int64 {
+	if x != nil {
+		return x.Shift
+	}
+	return 0
+}
+
+var File_internal_protocol_proto_game_proto protoreflect.FileDescriptor
+
+const file_internal_protocol_proto_game_proto_rawDesc = "" +
+	"\n" +
+	"\"internal/protocol/proto/game.proto\x12\bprotocol\x1a$internal/protocol/proto/common.proto\"\x80\x01\n" +
+	"\x12RoomCreatedPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\x12,\n" +
+	"\x06player\x18\x02 \x01(\v2\x14.protocol.PlayerInfoR\x06player\x12\x1f\n" +
+	"\vseed_commit\x18\x03 \x01(\tR\n" +
+	"seedCommit\"\xaf\x01\n" +
+	"\x11RoomJoinedPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\x12,\n" +
+	"\x06player\x18\x02 \x01(\v2\x14.protocol.PlayerInfoR\x06player\x12.\n" +
+	"\aplayers\x18\x03 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\x12\x1f\n" +
+	"\vseed_commit\x18\x04 \x01(\tR\n" +
+	"seedCommit\"C\n" +
+	"\x13PlayerJoinedPayload\x12,\n" +
+	"\x06player\x18\x01 \x01(\v2\x14.protocol.PlayerInfoR\x06player\"Q\n" +
+	"\x11PlayerLeftPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\"G\n" +
+	"\x12PlayerReadyPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05ready\x18\x02 \x01(\bR\x05ready\"\xd7\x01\n" +
+	"\x10GameStartPayload\x12.\n" +
+	"\aplayers\x18\x01 \x03(\v2\x14.protocol.PlayerInfoR\aplayers\x12\x19\n" +
+	"\brule_set\x18\x02 \x01(\tR\aruleSet\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vseed_commit\x18\x04 \x01(\tR\n" +
+	"seedCommit\x12\x19\n" +
+	"\bbid_mode\x18\x05 \x01(\tR\abidMode\x12\x12\n" +
+	"\x04hand\x18\x06 \x01(\x03R\x04hand\x12\x14\n" +
+	"\x05hands\x18\a \x01(\x03R\x05hands\"\x90\x01\n" +
+	"\x10DealCardsPayload\x12(\n" +
+	"\x05cards\x18\x01 \x03(\v2\x12.protocol.CardInfoR\x05cards\x125\n" +
+	"\fbottom_cards\x18\x02 \x03(\v2\x12.protocol.CardInfoR\vbottomCards\x12\x1b\n" +
+	"\twild_rank\x18\x03 \x01(\x03R\bwildRank\"\x9a\x01\n" +
+	"\x0eBidTurnPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\x03R\atimeout\x12\x17\n" +
+	"\ais_grab\x18\x03 \x01(\bR\x06isGrab\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x04 \x01(\x03R\n" +
+	"multiplier\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\x03R\aoptions\"\xb1\x01\n" +
+	"\x10BidResultPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
 	"playerName\x12\x10\n" +
 	"\x03bid\x18\x03 \x01(\bR\x03bid\x12\x17\n" +
 	"\ais_grab\x18\x04 \x01(\bR\x06isGrab\x12\x1e\n" +
 	"\n" +
 	"multiplier\x18\x05 \x01(\x03R\n" +
-	"multiplier\"\xa6\x01\n" +
+	"multiplier\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x03R\x05score\"\xf0\x01\n" +
 	"\x0fLandlordPayload\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
@@ -932,12 +1756,39 @@ const file_internal_protocol_proto_game_proto_rawDesc = "" +
 	"\fbottom_cards\x18\x03 \x03(\v2\x12.protocol.CardInfoR\vbottomCards\x12\x1e\n" +
 	"\n" +
 	"multiplier\x18\x04 \x01(\x03R\n" +
-	"multiplier\"\x80\x01\n" +
+	"multiplier\x12!\n" +
+	"\fbottom_bonus\x18\x05 \x01(\x03R\vbottomBonus\x12%\n" +
+	"\x0ebottom_pattern\x18\x06 \x01(\tR\rbottomPattern\"g\n" +
+	"\x11DoubleTurnPayload\x12\x18\n" +
+	"\atimeout\x18\x01 \x01(\x03R\atimeout\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x03R\n" +
+	"multiplier\x12\x18\n" +
+	"\apending\x18\x03 \x03(\tR\apending\"i\n" +
+	"\x13DoubleResultPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x03R\x05level\"\x9d\x01\n" +
+	"\x13HandRevealedPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12(\n" +
+	"\x05cards\x18\x03 \x03(\v2\x12.protocol.CardInfoR\x05cards\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x04 \x01(\x03R\n" +
+	"multiplier\"o\n" +
+	"\x15TrusteeChangedPayload\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\x9d\x01\n" +
 	"\x0fPlayTurnPayload\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
 	"\atimeout\x18\x02 \x01(\x03R\atimeout\x12\x1b\n" +
 	"\tmust_play\x18\x03 \x01(\bR\bmustPlay\x12\x19\n" +
-	"\bcan_beat\x18\x04 \x01(\bR\acanBeat\"\xb7\x01\n" +
+	"\bcan_beat\x18\x04 \x01(\bR\acanBeat\x12\x1b\n" +
+	"\ttime_bank\x18\x05 \x01(\x03R\btimeBank\"\x83\x02\n" +
 	"\x11CardPlayedPayload\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
@@ -945,11 +1796,14 @@ const file_internal_protocol_proto_game_proto_rawDesc = "" +
 	"\x05cards\x18\x03 \x03(\v2\x12.protocol.CardInfoR\x05cards\x12\x1d\n" +
 	"\n" +
 	"cards_left\x18\x04 \x01(\x03R\tcardsLeft\x12\x1b\n" +
-	"\thand_type\x18\x05 \x01(\tR\bhandType\"Q\n" +
+	"\thand_type\x18\x05 \x01(\tR\bhandType\x12\x1b\n" +
+	"\thand_kind\x18\x06 \x01(\x03R\bhandKind\x12\x19\n" +
+	"\bkey_rank\x18\a \x01(\x03R\akeyRank\x12\x12\n" +
+	"\x04soft\x18\b \x01(\bR\x04soft\"Q\n" +
 	"\x11PlayerPassPayload\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vplayer_name\x18\x02 \x01(\tR\n" +
-	"playerName\"\xf8\x01\n" +
+	"playerName\"\x8d\x03\n" +
 	"\x0fGameOverPayload\x12\x1b\n" +
 	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\x12\x1f\n" +
 	"\vwinner_name\x18\x02 \x01(\tR\n" +
@@ -960,7 +1814,42 @@ const file_internal_protocol_proto_game_proto_rawDesc = "" +
 	"\n" +
 	"multiplier\x18\x05 \x01(\x03R\n" +
 	"multiplier\x12-\n" +
-	"\x06scores\x18\x06 \x03(\v2\x15.protocol.PlayerScoreR\x06scoresB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
+	"\x06scores\x18\x06 \x03(\v2\x15.protocol.PlayerScoreR\x06scores\x12,\n" +
+	"\x05proof\x18\a \x01(\v2\x16.protocol.ShuffleProofR\x05proof\x12;\n" +
+	"\tbreakdown\x18\b \x01(\v2\x1d.protocol.MultiplierBreakdownR\tbreakdown\x12(\n" +
+	"\x10next_seed_commit\x18\t \x01(\tR\x0enextSeedCommit\"\x8c\x01\n" +
+	"\x0eSeriesStanding\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04last\x18\x04 \x01(\x03R\x04last\x12\x12\n" +
+	"\x04wins\x18\x05 \x01(\x03R\x04wins\"\x90\x01\n" +
+	"\x16SeriesStandingsPayload\x12\x12\n" +
+	"\x04hand\x18\x01 \x01(\x03R\x04hand\x12\x14\n" +
+	"\x05hands\x18\x02 \x01(\x03R\x05hands\x126\n" +
+	"\tstandings\x18\x03 \x03(\v2\x18.protocol.SeriesStandingR\tstandings\x12\x14\n" +
+	"\x05final\x18\x04 \x01(\bR\x05final\"}\n" +
+	"\x14RematchStatusPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\x12\x16\n" +
+	"\x06agreed\x18\x02 \x03(\tR\x06agreed\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x03R\atimeout\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\"v\n" +
+	"\x17MultiplierUpdatePayload\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x01 \x01(\x03R\n" +
+	"multiplier\x12;\n" +
+	"\tbreakdown\x18\x02 \x01(\v2\x1d.protocol.MultiplierBreakdownR\tbreakdown\"C\n" +
+	"\x15SpectatorHandsPayload\x12*\n" +
+	"\x05hands\x18\x01 \x03(\v2\x14.protocol.PlayerHandR\x05hands\"\xba\x01\n" +
+	"\fShuffleProof\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vserver_seed\x18\x02 \x01(\tR\n" +
+	"serverSeed\x12!\n" +
+	"\fclient_seeds\x18\x03 \x03(\tR\vclientSeeds\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\x03R\x05round\x12&\n" +
+	"\x04deck\x18\x05 \x03(\v2\x12.protocol.CardInfoR\x04deck\x12\x14\n" +
+	"\x05shift\x18\x06 \x01(\x03R\x05shiftB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
 
 var (
 	file_internal_protocol_proto_game_proto_rawDescOnce sync.Once
@@ -974,44 +1863,62 @@ func file_internal_protocol_proto_game_proto_rawDescGZIP() []byte {
 	return file_internal_protocol_proto_game_proto_rawDescData
 }
 
-var file_internal_protocol_proto_game_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_internal_protocol_proto_game_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_internal_protocol_proto_game_proto_goTypes = []any{
-	(*RoomCreatedPayload)(nil),  // 0: protocol.RoomCreatedPayload
-	(*RoomJoinedPayload)(nil),   // 1: protocol.RoomJoinedPayload
-	(*PlayerJoinedPayload)(nil), // 2: protocol.PlayerJoinedPayload
-	(*PlayerLeftPayload)(nil),   // 3: protocol.PlayerLeftPayload
-	(*PlayerReadyPayload)(nil),  // 4: protocol.PlayerReadyPayload
-	(*GameStartPayload)(nil),    // 5: protocol.GameStartPayload
-	(*DealCardsPayload)(nil),    // 6: protocol.DealCardsPayload
-	(*BidTurnPayload)(nil),      // 7: protocol.BidTurnPayload
-	(*BidResultPayload)(nil),    // 8: protocol.BidResultPayload
-	(*LandlordPayload)(nil),     // 9: protocol.LandlordPayload
-	(*PlayTurnPayload)(nil),     // 10: protocol.PlayTurnPayload
-	(*CardPlayedPayload)(nil),   // 11: protocol.CardPlayedPayload
-	(*PlayerPassPayload)(nil),   // 12: protocol.PlayerPassPayload
-	(*GameOverPayload)(nil),     // 13: protocol.GameOverPayload
-	(*PlayerInfo)(nil),          // 14: protocol.PlayerInfo
-	(*CardInfo)(nil),            // 15: protocol.CardInfo
-	(*PlayerHand)(nil),          // 16: protocol.PlayerHand
-	(*PlayerScore)(nil),         // 17: protocol.PlayerScore
+	(*RoomCreatedPayload)(nil),      // 0: protocol.RoomCreatedPayload
+	(*RoomJoinedPayload)(nil),       // 1: protocol.RoomJoinedPayload
+	(*PlayerJoinedPayload)(nil),     // 2: protocol.PlayerJoinedPayload
+	(*PlayerLeftPayload)(nil),       // 3: protocol.PlayerLeftPayload
+	(*PlayerReadyPayload)(nil),      // 4: protocol.PlayerReadyPayload
+	(*GameStartPayload)(nil),        // 5: protocol.GameStartPayload
+	(*DealCardsPayload)(nil),        // 6: protocol.DealCardsPayload
+	(*BidTurnPayload)(nil),          // 7: protocol.BidTurnPayload
+	(*BidResultPayload)(nil),        // 8: protocol.BidResultPayload
+	(*LandlordPayload)(nil),         // 9: protocol.LandlordPayload
+	(*DoubleTurnPayload)(nil),       // 10: protocol.DoubleTurnPayload
+	(*DoubleResultPayload)(nil),     // 11: protocol.DoubleResultPayload
+	(*HandRevealedPayload)(nil),     // 12: protocol.HandRevealedPayload
+	(*TrusteeChangedPayload)(nil),   // 13: protocol.TrusteeChangedPayload
+	(*PlayTurnPayload)(nil),         // 14: protocol.PlayTurnPayload
+	(*CardPlayedPayload)(nil),       // 15: protocol.CardPlayedPayload
+	(*PlayerPassPayload)(nil),       // 16: protocol.PlayerPassPayload
+	(*GameOverPayload)(nil),         // 17: protocol.GameOverPayload
+	(*SeriesStanding)(nil),          // 18: protocol.SeriesStanding
+	(*SeriesStandingsPayload)(nil),  // 19: protocol.SeriesStandingsPayload
+	(*RematchStatusPayload)(nil),    // 20: protocol.RematchStatusPayload
+	(*MultiplierUpdatePayload)(nil), // 21: protocol.MultiplierUpdatePayload
+	(*SpectatorHandsPayload)(nil),   // 22: protocol.SpectatorHandsPayload
+	(*ShuffleProof)(nil),            // 23: protocol.ShuffleProof
+	(*PlayerInfo)(nil),              // 24: protocol.PlayerInfo
+	(*CardInfo)(nil),                // 25: protocol.CardInfo
+	(*PlayerHand)(nil),              // 26: protocol.PlayerHand
+	(*PlayerScore)(nil),             // 27: protocol.PlayerScore
+	(*MultiplierBreakdown)(nil),     // 28: protocol.MultiplierBreakdown
 }
 var file_internal_protocol_proto_game_proto_depIdxs = []int32{
-	14, // 0: protocol.RoomCreatedPayload.player:type_name -> protocol.PlayerInfo
-	14, // 1: protocol.RoomJoinedPayload.player:type_name -> protocol.PlayerInfo
-	14, // 2: protocol.RoomJoinedPayload.players:type_name -> protocol.PlayerInfo
-	14, // 3: protocol.PlayerJoinedPayload.player:type_name -> protocol.PlayerInfo
-	14, // 4: protocol.GameStartPayload.players:type_name -> protocol.PlayerInfo
-	15, // 5: protocol.DealCardsPayload.cards:type_name -> protocol.CardInfo
-	15, // 6: protocol.DealCardsPayload.bottom_cards:type_name -> protocol.CardInfo
-	15, // 7: protocol.LandlordPayload.bottom_cards:type_name -> protocol.CardInfo
-	15, // 8: protocol.CardPlayedPayload.cards:type_name -> protocol.CardInfo
-	16, // 9: protocol.GameOverPayload.player_hands:type_name -> protocol.PlayerHand
-	17, // 10: protocol.GameOverPayload.scores:type_name -> protocol.PlayerScore
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	24, // 0: protocol.RoomCreatedPayload.player:type_name -> protocol.PlayerInfo
+	24, // 1: protocol.RoomJoinedPayload.player:type_name -> protocol.PlayerInfo
+	24, // 2: protocol.RoomJoinedPayload.players:type_name -> protocol.PlayerInfo
+	24, // 3: protocol.PlayerJoinedPayload.player:type_name -> protocol.PlayerInfo
+	24, // 4: protocol.GameStartPayload.players:type_name -> protocol.PlayerInfo
+	25, // 5: protocol.DealCardsPayload.cards:type_name -> protocol.CardInfo
+	25, // 6: protocol.DealCardsPayload.bottom_cards:type_name -> protocol.CardInfo
+	25, // 7: protocol.LandlordPayload.bottom_cards:type_name -> protocol.CardInfo
+	25, // 8: protocol.HandRevealedPayload.cards:type_name -> protocol.CardInfo
+	25, // 9: protocol.CardPlayedPayload.cards:type_name -> protocol.CardInfo
+	26, // 10: protocol.GameOverPayload.player_hands:type_name -> protocol.PlayerHand
+	27, // 11: protocol.GameOverPayload.scores:type_name -> protocol.PlayerScore
+	23, // 12: protocol.GameOverPayload.proof:type_name -> protocol.ShuffleProof
+	28, // 13: protocol.GameOverPayload.breakdown:type_name -> protocol.MultiplierBreakdown
+	18, // 14: protocol.SeriesStandingsPayload.standings:type_name -> protocol.SeriesStanding
+	28, // 15: protocol.MultiplierUpdatePayload.breakdown:type_name -> protocol.MultiplierBreakdown
+	26, // 16: protocol.SpectatorHandsPayload.hands:type_name -> protocol.PlayerHand
+	25, // 17: protocol.ShuffleProof.deck:type_name -> protocol.CardInfo
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_internal_protocol_proto_game_proto_init() }
@@ -1026,7 +1933,7 @@ func file_internal_protocol_proto_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_protocol_proto_game_proto_rawDesc), len(file_internal_protocol_proto_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
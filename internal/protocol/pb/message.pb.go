@@ -43,36 +43,56 @@ const (
 	MessageType_MSG_GET_ONLINE_COUNT       MessageType = 15
 	MessageType_MSG_GET_MAINTENANCE_STATUS MessageType = 17
 	MessageType_MSG_CHAT                   MessageType = 16
+	MessageType_MSG_CLIENT_SEED            MessageType = 18
+	MessageType_MSG_DOUBLE                 MessageType = 19
+	MessageType_MSG_SHOW_HAND              MessageType = 20
+	MessageType_MSG_SPECTATE_ROOM          MessageType = 21
+	MessageType_MSG_STOP_SPECTATING        MessageType = 22
+	MessageType_MSG_TRUSTEE                MessageType = 23
+	MessageType_MSG_REMATCH_REQUEST        MessageType = 24
+	MessageType_MSG_GET_TOURNAMENTS        MessageType = 25
+	MessageType_MSG_TOURNAMENT_REGISTER    MessageType = 26
 	// 服务端 -> 客户端
-	MessageType_MSG_CONNECTED          MessageType = 100
-	MessageType_MSG_RECONNECTED        MessageType = 101
-	MessageType_MSG_PONG               MessageType = 102
-	MessageType_MSG_PLAYER_OFFLINE     MessageType = 103
-	MessageType_MSG_PLAYER_ONLINE      MessageType = 104
-	MessageType_MSG_ONLINE_COUNT       MessageType = 105
-	MessageType_MSG_ROOM_CREATED       MessageType = 106
-	MessageType_MSG_ROOM_JOINED        MessageType = 107
-	MessageType_MSG_PLAYER_JOINED      MessageType = 108
-	MessageType_MSG_PLAYER_LEFT        MessageType = 109
-	MessageType_MSG_PLAYER_READY       MessageType = 110
-	MessageType_MSG_MATCH_FOUND        MessageType = 111
-	MessageType_MSG_GAME_START         MessageType = 112
-	MessageType_MSG_DEAL_CARDS         MessageType = 113
-	MessageType_MSG_BID_TURN           MessageType = 114
-	MessageType_MSG_BID_RESULT         MessageType = 115
-	MessageType_MSG_LANDLORD           MessageType = 116
-	MessageType_MSG_PLAY_TURN          MessageType = 117
-	MessageType_MSG_CARD_PLAYED        MessageType = 118
-	MessageType_MSG_PLAYER_PASS        MessageType = 119
-	MessageType_MSG_GAME_OVER          MessageType = 120
-	MessageType_MSG_ROUND_RESULT       MessageType = 121
-	MessageType_MSG_STATS_RESULT       MessageType = 122
-	MessageType_MSG_LEADERBOARD_RESULT MessageType = 123
-	MessageType_MSG_ROOM_LIST_RESULT   MessageType = 124
-	MessageType_MSG_MAINTENANCE_STATUS MessageType = 125
-	MessageType_MSG_MAINTENANCE        MessageType = 126
-	MessageType_MSG_ERROR              MessageType = 200
-	MessageType_MSG_PRACTICE_MATCH     MessageType = 201
+	MessageType_MSG_CONNECTED            MessageType = 100
+	MessageType_MSG_RECONNECTED          MessageType = 101
+	MessageType_MSG_PONG                 MessageType = 102
+	MessageType_MSG_PLAYER_OFFLINE       MessageType = 103
+	MessageType_MSG_PLAYER_ONLINE        MessageType = 104
+	MessageType_MSG_ONLINE_COUNT         MessageType = 105
+	MessageType_MSG_ROOM_CREATED         MessageType = 106
+	MessageType_MSG_ROOM_JOINED          MessageType = 107
+	MessageType_MSG_PLAYER_JOINED        MessageType = 108
+	MessageType_MSG_PLAYER_LEFT          MessageType = 109
+	MessageType_MSG_PLAYER_READY         MessageType = 110
+	MessageType_MSG_MATCH_FOUND          MessageType = 111
+	MessageType_MSG_GAME_START           MessageType = 112
+	MessageType_MSG_DEAL_CARDS           MessageType = 113
+	MessageType_MSG_BID_TURN             MessageType = 114
+	MessageType_MSG_BID_RESULT           MessageType = 115
+	MessageType_MSG_LANDLORD             MessageType = 116
+	MessageType_MSG_PLAY_TURN            MessageType = 117
+	MessageType_MSG_CARD_PLAYED          MessageType = 118
+	MessageType_MSG_PLAYER_PASS          MessageType = 119
+	MessageType_MSG_GAME_OVER            MessageType = 120
+	MessageType_MSG_ROUND_RESULT         MessageType = 121
+	MessageType_MSG_STATS_RESULT         MessageType = 122
+	MessageType_MSG_LEADERBOARD_RESULT   MessageType = 123
+	MessageType_MSG_ROOM_LIST_RESULT     MessageType = 124
+	MessageType_MSG_MAINTENANCE_STATUS   MessageType = 125
+	MessageType_MSG_MAINTENANCE          MessageType = 126
+	MessageType_MSG_DOUBLE_TURN          MessageType = 127
+	MessageType_MSG_DOUBLE_RESULT        MessageType = 128
+	MessageType_MSG_HAND_REVEALED        MessageType = 129
+	MessageType_MSG_MULTIPLIER_UPDATE    MessageType = 130
+	MessageType_MSG_SPECTATE_STARTED     MessageType = 131
+	MessageType_MSG_SPECTATOR_HANDS      MessageType = 132
+	MessageType_MSG_TRUSTEE_CHANGED      MessageType = 133
+	MessageType_MSG_SERIES_STANDINGS     MessageType = 134
+	MessageType_MSG_REMATCH_STATUS       MessageType = 135
+	MessageType_MSG_TOURNAMENT_LIST      MessageType = 136
+	MessageType_MSG_TOURNAMENT_STANDINGS MessageType = 137
+	MessageType_MSG_ERROR                MessageType = 200
+	MessageType_MSG_PRACTICE_MATCH       MessageType = 201
 )
 
 // Enum value maps for MessageType.
@@ -96,6 +116,15 @@ var (
 		15:  "MSG_GET_ONLINE_COUNT",
 		17:  "MSG_GET_MAINTENANCE_STATUS",
 		16:  "MSG_CHAT",
+		18:  "MSG_CLIENT_SEED",
+		19:  "MSG_DOUBLE",
+		20:  "MSG_SHOW_HAND",
+		21:  "MSG_SPECTATE_ROOM",
+		22:  "MSG_STOP_SPECTATING",
+		23:  "MSG_TRUSTEE",
+		24:  "MSG_REMATCH_REQUEST",
+		25:  "MSG_GET_TOURNAMENTS",
+		26:  "MSG_TOURNAMENT_REGISTER",
 		100: "MSG_CONNECTED",
 		101: "MSG_RECONNECTED",
 		102: "MSG_PONG",
@@ -123,6 +152,17 @@ var (
 		124: "MSG_ROOM_LIST_RESULT",
 		125: "MSG_MAINTENANCE_STATUS",
 		126: "MSG_MAINTENANCE",
+		127: "MSG_DOUBLE_TURN",
+		128: "MSG_DOUBLE_RESULT",
+		129: "MSG_HAND_REVEALED",
+		130: "MSG_MULTIPLIER_UPDATE",
+		131: "MSG_SPECTATE_STARTED",
+		132: "MSG_SPECTATOR_HANDS",
+		133: "MSG_TRUSTEE_CHANGED",
+		134: "MSG_SERIES_STANDINGS",
+		135: "MSG_REMATCH_STATUS",
+		136: "MSG_TOURNAMENT_LIST",
+		137: "MSG_TOURNAMENT_STANDINGS",
 		200: "MSG_ERROR",
 		201: "MSG_PRACTICE_MATCH",
 	}
@@ -145,6 +185,15 @@ var (
 		"MSG_GET_ONLINE_COUNT":       15,
 		"MSG_GET_MAINTENANCE_STATUS": 17,
 		"MSG_CHAT":                   16,
+		"MSG_CLIENT_SEED":            18,
+		"MSG_DOUBLE":                 19,
+		"MSG_SHOW_HAND":              20,
+		"MSG_SPECTATE_ROOM":          21,
+		"MSG_STOP_SPECTATING":        22,
+		"MSG_TRUSTEE":                23,
+		"MSG_REMATCH_REQUEST":        24,
+		"MSG_GET_TOURNAMENTS":        25,
+		"MSG_TOURNAMENT_REGISTER":    26,
 		"MSG_CONNECTED":              100,
 		"MSG_RECONNECTED":            101,
 		"MSG_PONG":                   102,
@@ -172,6 +221,17 @@ var (
 		"MSG_ROOM_LIST_RESULT":       124,
 		"MSG_MAINTENANCE_STATUS":     125,
 		"MSG_MAINTENANCE":            126,
+		"MSG_DOUBLE_TURN":            127,
+		"MSG_DOUBLE_RESULT":          128,
+		"MSG_HAND_REVEALED":          129,
+		"MSG_MULTIPLIER_UPDATE":      130,
+		"MSG_SPECTATE_STARTED":       131,
+		"MSG_SPECTATOR_HANDS":        132,
+		"MSG_TRUSTEE_CHANGED":        133,
+		"MSG_SERIES_STANDINGS":       134,
+		"MSG_REMATCH_STATUS":         135,
+		"MSG_TOURNAMENT_LIST":        136,
+		"MSG_TOURNAMENT_STANDINGS":   137,
 		"MSG_ERROR":                  200,
 		"MSG_PRACTICE_MATCH":         201,
 	}
@@ -264,7 +324,7 @@ const file_internal_protocol_proto_message_proto_rawDesc = "" +
 	"%internal/protocol/proto/message.proto\x12\bprotocol\"N\n" +
 	"\aMessage\x12)\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x15.protocol.MessageTypeR\x04type\x12\x18\n" +
-	"\apayload\x18\x02 \x01(\fR\apayload*\xd8\a\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload*\xbd\v\n" +
 	"\vMessageType\x12\x0f\n" +
 	"\vMSG_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rMSG_RECONNECT\x10\x01\x12\f\n" +
@@ -284,7 +344,17 @@ const file_internal_protocol_proto_message_proto_rawDesc = "" +
 	"\x11MSG_GET_ROOM_LIST\x10\x0e\x12\x18\n" +
 	"\x14MSG_GET_ONLINE_COUNT\x10\x0f\x12\x1e\n" +
 	"\x1aMSG_GET_MAINTENANCE_STATUS\x10\x11\x12\f\n" +
-	"\bMSG_CHAT\x10\x10\x12\x11\n" +
+	"\bMSG_CHAT\x10\x10\x12\x13\n" +
+	"\x0fMSG_CLIENT_SEED\x10\x12\x12\x0e\n" +
+	"\n" +
+	"MSG_DOUBLE\x10\x13\x12\x11\n" +
+	"\rMSG_SHOW_HAND\x10\x14\x12\x15\n" +
+	"\x11MSG_SPECTATE_ROOM\x10\x15\x12\x17\n" +
+	"\x13MSG_STOP_SPECTATING\x10\x16\x12\x0f\n" +
+	"\vMSG_TRUSTEE\x10\x17\x12\x17\n" +
+	"\x13MSG_REMATCH_REQUEST\x10\x18\x12\x17\n" +
+	"\x13MSG_GET_TOURNAMENTS\x10\x19\x12\x1b\n" +
+	"\x17MSG_TOURNAMENT_REGISTER\x10\x1a\x12\x11\n" +
 	"\rMSG_CONNECTED\x10d\x12\x13\n" +
 	"\x0fMSG_RECONNECTED\x10e\x12\f\n" +
 	"\bMSG_PONG\x10f\x12\x16\n" +
@@ -311,7 +381,18 @@ const file_internal_protocol_proto_message_proto_rawDesc = "" +
 	"\x16MSG_LEADERBOARD_RESULT\x10{\x12\x18\n" +
 	"\x14MSG_ROOM_LIST_RESULT\x10|\x12\x1a\n" +
 	"\x16MSG_MAINTENANCE_STATUS\x10}\x12\x13\n" +
-	"\x0fMSG_MAINTENANCE\x10~\x12\x0e\n" +
+	"\x0fMSG_MAINTENANCE\x10~\x12\x13\n" +
+	"\x0fMSG_DOUBLE_TURN\x10\x7f\x12\x16\n" +
+	"\x11MSG_DOUBLE_RESULT\x10\x80\x01\x12\x16\n" +
+	"\x11MSG_HAND_REVEALED\x10\x81\x01\x12\x1a\n" +
+	"\x15MSG_MULTIPLIER_UPDATE\x10\x82\x01\x12\x19\n" +
+	"\x14MSG_SPECTATE_STARTED\x10\x83\x01\x12\x18\n" +
+	"\x13MSG_SPECTATOR_HANDS\x10\x84\x01\x12\x18\n" +
+	"\x13MSG_TRUSTEE_CHANGED\x10\x85\x01\x12\x19\n" +
+	"\x14MSG_SERIES_STANDINGS\x10\x86\x01\x12\x17\n" +
+	"\x12MSG_REMATCH_STATUS\x10\x87\x01\x12\x18\n" +
+	"\x13MSG_TOURNAMENT_LIST\x10\x88\x01\x12\x1d\n" +
+	"\x18MSG_TOURNAMENT_STANDINGS\x10\x89\x01\x12\x0e\n" +
 	"\tMSG_ERROR\x10\xc8\x01\x12\x17\n" +
 	"\x12MSG_PRACTICE_MATCH\x10\xc9\x01B=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
 
@@ -151,6 +151,67 @@ func (x *ReconnectedPayload) GetGameState() *GameStateDTO {
 	return nil
 }
 
+// SpectateStartedPayload 开始观战响应
+type SpectateStartedPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomCode      string                 `protobuf:"bytes,1,opt,name=room_code,json=roomCode,proto3" json:"room_code,omitempty"`
+	GameState     *GameStateDTO          `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`  // 当前局面，不含任何玩家的手牌
+	HandDelay     int64                  `protobuf:"varint,3,opt,name=hand_delay,json=handDelay,proto3" json:"hand_delay,omitempty"` // 各家手牌延迟公开的秒数，0 表示不公开
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateStartedPayload) Reset() {
+	*x = SpectateStartedPayload{}
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateStartedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateStartedPayload) ProtoMessage() {}
+
+func (x *SpectateStartedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateStartedPayload.ProtoReflect.Descriptor instead.
+func (*SpectateStartedPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SpectateStartedPayload) GetRoomCode() string {
+	if x != nil {
+		return x.RoomCode
+	}
+	return ""
+}
+
+func (x *SpectateStartedPayload) GetGameState() *GameStateDTO {
+	if x != nil {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *SpectateStartedPayload) GetHandDelay() int64 {
+	if x != nil {
+		return x.HandDelay
+	}
+	return 0
+}
+
 // PongPayload 心跳响应
 type PongPayload struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -162,7 +223,7 @@ type PongPayload struct {
 
 func (x *PongPayload) Reset() {
 	*x = PongPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[2]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -174,7 +235,7 @@ func (x *PongPayload) String() string {
 func (*PongPayload) ProtoMessage() {}
 
 func (x *PongPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[2]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +248,7 @@ func (x *PongPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PongPayload.ProtoReflect.Descriptor instead.
 func (*PongPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{2}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PongPayload) GetClientTimestamp() int64 {
@@ -216,7 +277,7 @@ type PlayerOfflinePayload struct {
 
 func (x *PlayerOfflinePayload) Reset() {
 	*x = PlayerOfflinePayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[3]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +289,7 @@ func (x *PlayerOfflinePayload) String() string {
 func (*PlayerOfflinePayload) ProtoMessage() {}
 
 func (x *PlayerOfflinePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[3]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +302,7 @@ func (x *PlayerOfflinePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerOfflinePayload.ProtoReflect.Descriptor instead.
 func (*PlayerOfflinePayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{3}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PlayerOfflinePayload) GetPlayerId() string {
@@ -276,7 +337,7 @@ type PlayerOnlinePayload struct {
 
 func (x *PlayerOnlinePayload) Reset() {
 	*x = PlayerOnlinePayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +349,7 @@ func (x *PlayerOnlinePayload) String() string {
 func (*PlayerOnlinePayload) ProtoMessage() {}
 
 func (x *PlayerOnlinePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[4]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +362,7 @@ func (x *PlayerOnlinePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerOnlinePayload.ProtoReflect.Descriptor instead.
 func (*PlayerOnlinePayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{4}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerOnlinePayload) GetPlayerId() string {
@@ -328,7 +389,7 @@ type OnlineCountPayload struct {
 
 func (x *OnlineCountPayload) Reset() {
 	*x = OnlineCountPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -340,7 +401,7 @@ func (x *OnlineCountPayload) String() string {
 func (*OnlineCountPayload) ProtoMessage() {}
 
 func (x *OnlineCountPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[5]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +414,7 @@ func (x *OnlineCountPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnlineCountPayload.ProtoReflect.Descriptor instead.
 func (*OnlineCountPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{5}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OnlineCountPayload) GetCount() int64 {
@@ -373,7 +434,7 @@ type MaintenanceStatusPayload struct {
 
 func (x *MaintenanceStatusPayload) Reset() {
 	*x = MaintenanceStatusPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[6]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +446,7 @@ func (x *MaintenanceStatusPayload) String() string {
 func (*MaintenanceStatusPayload) ProtoMessage() {}
 
 func (x *MaintenanceStatusPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[6]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +459,7 @@ func (x *MaintenanceStatusPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceStatusPayload.ProtoReflect.Descriptor instead.
 func (*MaintenanceStatusPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{6}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MaintenanceStatusPayload) GetMaintenance() bool {
@@ -418,7 +479,7 @@ type MaintenancePayload struct {
 
 func (x *MaintenancePayload) Reset() {
 	*x = MaintenancePayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[7]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +491,7 @@ func (x *MaintenancePayload) String() string {
 func (*MaintenancePayload) ProtoMessage() {}
 
 func (x *MaintenancePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[7]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +504,7 @@ func (x *MaintenancePayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenancePayload.ProtoReflect.Descriptor instead.
 func (*MaintenancePayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{7}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MaintenancePayload) GetMaintenance() bool {
@@ -464,7 +525,7 @@ type ErrorPayload struct {
 
 func (x *ErrorPayload) Reset() {
 	*x = ErrorPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[8]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -476,7 +537,7 @@ func (x *ErrorPayload) String() string {
 func (*ErrorPayload) ProtoMessage() {}
 
 func (x *ErrorPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[8]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,7 +550,7 @@ func (x *ErrorPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorPayload.ProtoReflect.Descriptor instead.
 func (*ErrorPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{8}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ErrorPayload) GetCode() int64 {
@@ -529,7 +590,7 @@ type StatsResultPayload struct {
 
 func (x *StatsResultPayload) Reset() {
 	*x = StatsResultPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[9]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -541,7 +602,7 @@ func (x *StatsResultPayload) String() string {
 func (*StatsResultPayload) ProtoMessage() {}
 
 func (x *StatsResultPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[9]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +615,7 @@ func (x *StatsResultPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsResultPayload.ProtoReflect.Descriptor instead.
 func (*StatsResultPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{9}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StatsResultPayload) GetPlayerId() string {
@@ -666,7 +727,7 @@ type LeaderboardResultPayload struct {
 
 func (x *LeaderboardResultPayload) Reset() {
 	*x = LeaderboardResultPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[10]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +739,7 @@ func (x *LeaderboardResultPayload) String() string {
 func (*LeaderboardResultPayload) ProtoMessage() {}
 
 func (x *LeaderboardResultPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[10]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +752,7 @@ func (x *LeaderboardResultPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaderboardResultPayload.ProtoReflect.Descriptor instead.
 func (*LeaderboardResultPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{10}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LeaderboardResultPayload) GetType() string {
@@ -718,7 +779,7 @@ type RoomListResultPayload struct {
 
 func (x *RoomListResultPayload) Reset() {
 	*x = RoomListResultPayload{}
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[11]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +791,7 @@ func (x *RoomListResultPayload) String() string {
 func (*RoomListResultPayload) ProtoMessage() {}
 
 func (x *RoomListResultPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_protocol_proto_server_proto_msgTypes[11]
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +804,7 @@ func (x *RoomListResultPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoomListResultPayload.ProtoReflect.Descriptor instead.
 func (*RoomListResultPayload) Descriptor() ([]byte, []int) {
-	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{11}
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RoomListResultPayload) GetRooms() []*RoomListItem {
@@ -753,6 +814,128 @@ func (x *RoomListResultPayload) GetRooms() []*RoomListItem {
 	return nil
 }
 
+// TournamentListPayload 锦标赛列表
+type TournamentListPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournaments   []*TournamentInfo      `protobuf:"bytes,1,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentListPayload) Reset() {
+	*x = TournamentListPayload{}
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentListPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentListPayload) ProtoMessage() {}
+
+func (x *TournamentListPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentListPayload.ProtoReflect.Descriptor instead.
+func (*TournamentListPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TournamentListPayload) GetTournaments() []*TournamentInfo {
+	if x != nil {
+		return x.Tournaments
+	}
+	return nil
+}
+
+// TournamentStandingsPayload 锦标赛排名，每轮结束后推送给全部参赛者
+type TournamentStandingsPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Round         int64                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`        // 刚结束的轮次
+	Standings     []*TournamentStanding  `protobuf:"bytes,4,rep,name=standings,proto3" json:"standings,omitempty"` // 未淘汰的在前，其余按打到的轮次与累计得分排序
+	Final         bool                   `protobuf:"varint,5,opt,name=final,proto3" json:"final,omitempty"`        // 决赛桌已结束
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentStandingsPayload) Reset() {
+	*x = TournamentStandingsPayload{}
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentStandingsPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentStandingsPayload) ProtoMessage() {}
+
+func (x *TournamentStandingsPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_proto_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentStandingsPayload.ProtoReflect.Descriptor instead.
+func (*TournamentStandingsPayload) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_proto_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TournamentStandingsPayload) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *TournamentStandingsPayload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentStandingsPayload) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *TournamentStandingsPayload) GetStandings() []*TournamentStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *TournamentStandingsPayload) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
 var File_internal_protocol_proto_server_proto protoreflect.FileDescriptor
 
 const file_internal_protocol_proto_server_proto_rawDesc = "" +
@@ -769,7 +952,13 @@ const file_internal_protocol_proto_server_proto_rawDesc = "" +
 	"playerName\x12\x1b\n" +
 	"\troom_code\x18\x03 \x01(\tR\broomCode\x125\n" +
 	"\n" +
-	"game_state\x18\x04 \x01(\v2\x16.protocol.GameStateDTOR\tgameState\"c\n" +
+	"game_state\x18\x04 \x01(\v2\x16.protocol.GameStateDTOR\tgameState\"\x8b\x01\n" +
+	"\x16SpectateStartedPayload\x12\x1b\n" +
+	"\troom_code\x18\x01 \x01(\tR\broomCode\x125\n" +
+	"\n" +
+	"game_state\x18\x02 \x01(\v2\x16.protocol.GameStateDTOR\tgameState\x12\x1d\n" +
+	"\n" +
+	"hand_delay\x18\x03 \x01(\x03R\thandDelay\"c\n" +
 	"\vPongPayload\x12)\n" +
 	"\x10client_timestamp\x18\x01 \x01(\x03R\x0fclientTimestamp\x12)\n" +
 	"\x10server_timestamp\x18\x02 \x01(\x03R\x0fserverTimestamp\"n\n" +
@@ -814,7 +1003,15 @@ const file_internal_protocol_proto_server_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x124\n" +
 	"\aentries\x18\x02 \x03(\v2\x1a.protocol.LeaderboardEntryR\aentries\"E\n" +
 	"\x15RoomListResultPayload\x12,\n" +
-	"\x05rooms\x18\x01 \x03(\v2\x16.protocol.RoomListItemR\x05roomsB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
+	"\x05rooms\x18\x01 \x03(\v2\x16.protocol.RoomListItemR\x05rooms\"S\n" +
+	"\x15TournamentListPayload\x12:\n" +
+	"\vtournaments\x18\x01 \x03(\v2\x18.protocol.TournamentInfoR\vtournaments\"\xbd\x01\n" +
+	"\x1aTournamentStandingsPayload\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\x03R\x05round\x12:\n" +
+	"\tstandings\x18\x04 \x03(\v2\x1c.protocol.TournamentStandingR\tstandings\x12\x14\n" +
+	"\x05final\x18\x05 \x01(\bR\x05finalB=Z;github.com/palemoky/fight-the-landlord/internal/protocol/pbb\x06proto3"
 
 var (
 	file_internal_protocol_proto_server_proto_rawDescOnce sync.Once
@@ -828,33 +1025,41 @@ func file_internal_protocol_proto_server_proto_rawDescGZIP() []byte {
 	return file_internal_protocol_proto_server_proto_rawDescData
 }
 
-var file_internal_protocol_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_internal_protocol_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_internal_protocol_proto_server_proto_goTypes = []any{
-	(*ConnectedPayload)(nil),         // 0: protocol.ConnectedPayload
-	(*ReconnectedPayload)(nil),       // 1: protocol.ReconnectedPayload
-	(*PongPayload)(nil),              // 2: protocol.PongPayload
-	(*PlayerOfflinePayload)(nil),     // 3: protocol.PlayerOfflinePayload
-	(*PlayerOnlinePayload)(nil),      // 4: protocol.PlayerOnlinePayload
-	(*OnlineCountPayload)(nil),       // 5: protocol.OnlineCountPayload
-	(*MaintenanceStatusPayload)(nil), // 6: protocol.MaintenanceStatusPayload
-	(*MaintenancePayload)(nil),       // 7: protocol.MaintenancePayload
-	(*ErrorPayload)(nil),             // 8: protocol.ErrorPayload
-	(*StatsResultPayload)(nil),       // 9: protocol.StatsResultPayload
-	(*LeaderboardResultPayload)(nil), // 10: protocol.LeaderboardResultPayload
-	(*RoomListResultPayload)(nil),    // 11: protocol.RoomListResultPayload
-	(*GameStateDTO)(nil),             // 12: protocol.GameStateDTO
-	(*LeaderboardEntry)(nil),         // 13: protocol.LeaderboardEntry
-	(*RoomListItem)(nil),             // 14: protocol.RoomListItem
+	(*ConnectedPayload)(nil),           // 0: protocol.ConnectedPayload
+	(*ReconnectedPayload)(nil),         // 1: protocol.ReconnectedPayload
+	(*SpectateStartedPayload)(nil),     // 2: protocol.SpectateStartedPayload
+	(*PongPayload)(nil),                // 3: protocol.PongPayload
+	(*PlayerOfflinePayload)(nil),       // 4: protocol.PlayerOfflinePayload
+	(*PlayerOnlinePayload)(nil),        // 5: protocol.PlayerOnlinePayload
+	(*OnlineCountPayload)(nil),         // 6: protocol.OnlineCountPayload
+	(*MaintenanceStatusPayload)(nil),   // 7: protocol.MaintenanceStatusPayload
+	(*MaintenancePayload)(nil),         // 8: protocol.MaintenancePayload
+	(*ErrorPayload)(nil),               // 9: protocol.ErrorPayload
+	(*StatsResultPayload)(nil),         // 10: protocol.StatsResultPayload
+	(*LeaderboardResultPayload)(nil),   // 11: protocol.LeaderboardResultPayload
+	(*RoomListResultPayload)(nil),      // 12: protocol.RoomListResultPayload
+	(*TournamentListPayload)(nil),      // 13: protocol.TournamentListPayload
+	(*TournamentStandingsPayload)(nil), // 14: protocol.TournamentStandingsPayload
+	(*GameStateDTO)(nil),               // 15: protocol.GameStateDTO
+	(*LeaderboardEntry)(nil),           // 16: protocol.LeaderboardEntry
+	(*RoomListItem)(nil),               // 17: protocol.RoomListItem
+	(*TournamentInfo)(nil),             // 18: protocol.TournamentInfo
+	(*TournamentStanding)(nil),         // 19: protocol.TournamentStanding
 }
 var file_internal_protocol_proto_server_proto_depIdxs = []int32{
-	12, // 0: protocol.ReconnectedPayload.game_state:type_name -> protocol.GameStateDTO
-	13, // 1: protocol.LeaderboardResultPayload.entries:type_name -> protocol.LeaderboardEntry
-	14, // 2: protocol.RoomListResultPayload.rooms:type_name -> protocol.RoomListItem
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	15, // 0: protocol.ReconnectedPayload.game_state:type_name -> protocol.GameStateDTO
+	15, // 1: protocol.SpectateStartedPayload.game_state:type_name -> protocol.GameStateDTO
+	16, // 2: protocol.LeaderboardResultPayload.entries:type_name -> protocol.LeaderboardEntry
+	17, // 3: protocol.RoomListResultPayload.rooms:type_name -> protocol.RoomListItem
+	18, // 4: protocol.TournamentListPayload.tournaments:type_name -> protocol.TournamentInfo
+	19, // 5: protocol.TournamentStandingsPayload.standings:type_name -> protocol.TournamentStanding
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_internal_protocol_proto_server_proto_init() }
@@ -869,7 +1074,7 @@ func file_internal_protocol_proto_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_protocol_proto_server_proto_rawDesc), len(file_internal_protocol_proto_server_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
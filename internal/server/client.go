@@ -179,19 +179,33 @@ func (c *Client) SendMessage(msg *protocol.Message) {
 	case c.send <- data:
 	default:
 		// 发送缓冲区已满，关闭连接
-		log.Printf("客户端 %s 发送缓冲区已满", c.ID)
+		log.Printf("客户端 %s 发送缓冲区已满", c.GetID())
 		c.Close()
 	}
 }
 
 // handleDisconnect 处理断开连接
 func (c *Client) handleDisconnect() {
+	// 观战只跟随当前连接，断开即退出（已被新连接取代时不影响新连接）
+	c.server.roomManager.StopSpectating(c)
+
+	// 玩家已用新连接重连，旧连接断开不影响其在线状态
+	if !c.server.isCurrentClient(c) {
+		return
+	}
+
 	// 标记会话为离线状态
-	c.server.sessionManager.SetOffline(c.ID)
+	c.server.sessionManager.SetOffline(c.GetID())
+
+	// 结算后等待再来一局时掉线，撤回同意
+	c.server.roomManager.WithdrawRematch(c)
 
-	// 如果在房间中，通知房间玩家掉线（但不移除）
-	if c.RoomID != "" {
+	// 如果在房间中，通知房间玩家掉线（但不移除），对局中暂停其回合计时等待重连
+	if roomCode := c.GetRoom(); roomCode != "" {
 		c.server.roomManager.NotifyPlayerOffline(c)
+		if gs := c.server.handler.GetGameSession(roomCode); gs != nil {
+			gs.PlayerOffline(c.GetID())
+		}
 	}
 
 	// 如果在匹配队列中，移除
@@ -226,7 +240,25 @@ func (c *Client) GetRoom() string {
 	return c.RoomID
 }
 
+// rebind 重连时改用原玩家的 ID 与昵称
+func (c *Client) rebind(id, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ID = id
+	c.Name = name
+}
+
 // Interface implementations for types.ClientInterface
-func (c *Client) GetID() string   { return c.ID }
-func (c *Client) GetName() string { return c.Name }
-func (c *Client) IsBot() bool     { return false }
+func (c *Client) GetID() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ID
+}
+
+func (c *Client) GetName() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Name
+}
+
+func (c *Client) IsBot() bool { return false }
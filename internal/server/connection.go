@@ -114,23 +114,35 @@ func (s *Server) registerClient(client *Client) {
 	s.clients[client.ID] = client
 }
 
-// unregisterClient 注销客户端
+// unregisterClient 注销客户端（已被重连的新连接取代时不处理）
 func (s *Server) unregisterClient(client *Client) {
 	s.clientsMu.Lock()
 	defer s.clientsMu.Unlock()
 
-	if _, ok := s.clients[client.ID]; ok {
-		delete(s.clients, client.ID)
-		log.Printf("❌ 玩家 %s (%s) 已断开", client.Name, client.ID)
+	id := client.GetID()
+	if c, ok := s.clients[id]; ok && c == client {
+		delete(s.clients, id)
+		log.Printf("❌ 玩家 %s (%s) 已断开", client.GetName(), id)
 	}
 }
 
+// isCurrentClient 判断 client 是否仍是该玩家当前的连接
+func (s *Server) isCurrentClient(client *Client) bool {
+	s.clientsMu.RLock()
+	defer s.clientsMu.RUnlock()
+	return s.clients[client.GetID()] == client
+}
+
 // Interface implementations for types.ServerContext
 
 func (s *Server) GetClientByID(id string) types.ClientInterface {
 	s.clientsMu.RLock()
 	defer s.clientsMu.RUnlock()
-	return s.clients[id]
+	// 不存在时返回 nil 接口，而不是装着 nil 指针的接口
+	if c, ok := s.clients[id]; ok {
+		return c
+	}
+	return nil
 }
 
 func (s *Server) RegisterClient(id string, client types.ClientInterface) {
@@ -146,3 +158,24 @@ func (s *Server) UnregisterClient(id string) {
 	defer s.clientsMu.Unlock()
 	delete(s.clients, id)
 }
+
+// RebindClient 重连成功后让新连接接管原玩家身份，并丢弃新连接建立时创建的临时会话
+func (s *Server) RebindClient(client types.ClientInterface, id, name string) {
+	c, ok := client.(*Client)
+	if !ok {
+		return
+	}
+	tempID := c.GetID()
+
+	s.clientsMu.Lock()
+	if s.clients[tempID] == c {
+		delete(s.clients, tempID)
+	}
+	c.rebind(id, name)
+	s.clients[id] = c
+	s.clientsMu.Unlock()
+
+	if tempID != id {
+		s.sessionManager.DeleteSession(tempID)
+	}
+}
@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"time"
 
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/server/session"
@@ -24,6 +27,24 @@ func (h *Handler) handlePing(client types.ClientInterface, msg *protocol.Message
 	}))
 }
 
+// handleClientSeed 记录客户端上报的洗牌种子，供其所在房间的下一局发牌使用
+func (h *Handler) handleClientSeed(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.ClientSeedPayload](msg)
+	if err != nil {
+		return
+	}
+
+	err = h.roomManager.SetClientSeed(client, payload.Seed)
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidSeed):
+		sendGameError(client, err)
+	case errors.Is(err, apperrors.ErrGameStarted):
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeGameStarted, "对局已开始，本次洗牌种子未被采用"))
+	case err != nil:
+		log.Printf("忽略客户端 %s 的洗牌种子: %v", client.GetName(), err)
+	}
+}
+
 // handleReconnect 处理断线重连
 func (h *Handler) handleReconnect(client types.ClientInterface, msg *protocol.Message) {
 	payload, err := codec.ParsePayload[protocol.ReconnectPayload](msg)
@@ -45,13 +66,8 @@ func (h *Handler) handleReconnect(client types.ClientInterface, msg *protocol.Me
 		return
 	}
 
-	// 注意：由于ClientInterface不允许修改ID/Name，我们需要通过Server层面处理
-	// 这里我们假设client已经是正确的类型，可以进行类型断言
-	oldID := client.GetID()
-
-	// 从旧 ID 注销，用新 ID 注册
-	h.server.UnregisterClient(oldID)
-	h.server.RegisterClient(session.PlayerID, client)
+	// 新连接接管原玩家身份（新连接建立时分配的临时 ID 与会话随之作废）
+	h.server.RebindClient(client, session.PlayerID, session.PlayerName)
 
 	// 标记会话上线
 	h.sessionManager.SetOnline(session.PlayerID)
@@ -62,47 +78,41 @@ func (h *Handler) handleReconnect(client types.ClientInterface, msg *protocol.Me
 		PlayerName: session.PlayerName,
 	}
 
-	// 如果在房间中，尝试恢复房间信息
-	if session.RoomCode != "" {
-		h.tryRestoreRoomState(client, session, &reconnectPayload)
-	}
+	// 如果在房间中，尝试恢复房间信息（服务重启后房间由 Redis 恢复，会话中未必记录了房间号）
+	gameSession := h.tryRestoreRoomState(client, session.PlayerID, &reconnectPayload)
 
 	// 发送重连成功消息
 	client.SendMessage(codec.MustNewMessage(protocol.MsgReconnected, reconnectPayload))
 
 	// 快照恢复后，若正轮到该玩家，补发当前回合通知，恢复其操作提示与倒计时（快照本身不含 IsGrab / 剩余时间等回合信息）。须在 MsgReconnected 之后发送，确保客户端先应用快照、再设置回合提示。
-	if reconnectPayload.GameState != nil && reconnectPayload.GameState.CurrentTurn == session.PlayerID {
-		if gameSession := h.GetGameSession(session.RoomCode); gameSession != nil {
-			gameSession.ResendTurnTo(client)
-		}
+	if gameSession != nil && reconnectPayload.GameState.CurrentTurn == session.PlayerID {
+		gameSession.ResendTurnTo(client)
 	}
 
 	log.Printf("🔄 玩家 %s (%s) 重连成功", session.PlayerName, session.PlayerID)
 }
 
-// tryRestoreRoomState 尝试恢复房间状态
-func (h *Handler) tryRestoreRoomState(client types.ClientInterface, session *session.PlayerSession, payload *protocol.ReconnectedPayload) {
-	room := h.roomManager.GetRoom(session.RoomCode)
-	if room == nil {
-		return
-	}
-
-	oldClient := h.server.GetClientByID(session.PlayerID)
-	if oldClient == nil {
-		return
+// tryRestoreRoomState 把重连的玩家放回原房间座位，对局进行中时恢复其计时并返回游戏会话
+func (h *Handler) tryRestoreRoomState(client types.ClientInterface, playerID string, payload *protocol.ReconnectedPayload) *session.GameSession {
+	r := h.roomManager.GetRoomByPlayerID(playerID)
+	if r == nil || r.State == room.RoomStateEnded {
+		return nil
 	}
 
 	// 重连到房间
-	if err := h.roomManager.ReconnectPlayer(oldClient, client); err != nil {
+	if err := h.roomManager.ReconnectPlayer(r.Code, client); err != nil {
 		log.Printf("重连到房间失败: %v", err)
-		return
+		return nil
 	}
+	payload.RoomCode = r.Code
+	h.sessionManager.SetRoom(playerID, r.Code)
 
-	client.SetRoom(session.RoomCode)
-	payload.RoomCode = session.RoomCode
-
-	// 如果游戏正在进行，恢复游戏状态
-	if gameSession := h.GetGameSession(session.RoomCode); gameSession != nil {
-		payload.GameState = gameSession.BuildGameStateDTO(session.PlayerID, h.sessionManager)
+	// 如果游戏正在进行，恢复该玩家的回合计时与游戏状态（系列赛局间只回到房间）
+	gameSession := h.GetGameSession(r.Code)
+	if gameSession == nil || r.State == room.RoomStateWaiting {
+		return nil
 	}
+	gameSession.PlayerOnline(playerID)
+	payload.GameState = gameSession.BuildGameStateDTO(playerID, h.sessionManager)
+	return gameSession
 }
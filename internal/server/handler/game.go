@@ -5,6 +5,7 @@ import (
 
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
 	"github.com/palemoky/fight-the-landlord/internal/types"
 
 	"github.com/palemoky/fight-the-landlord/internal/apperrors"
@@ -44,7 +45,66 @@ func (h *Handler) handleBid(client types.ClientInterface, msg *protocol.Message)
 		return
 	}
 
-	if err := gameSession.HandleBid(client.GetID(), payload.Bid); err != nil {
+	if gameSession.ScoreBidding() {
+		err = gameSession.HandleBidScore(client.GetID(), payload.Score)
+	} else {
+		err = gameSession.HandleBid(client.GetID(), payload.Bid)
+	}
+	if err != nil {
+		sendGameError(client, err)
+	}
+}
+
+// handleDouble 处理加倍
+func (h *Handler) handleDouble(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.DoublePayload](msg)
+	if err != nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+
+	if h.roomManager == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	room := h.roomManager.GetRoom(client.GetRoom())
+	if room == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeNotInRoom))
+		return
+	}
+
+	gameSession := h.GetGameSession(room.Code)
+	if gameSession == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	if err := gameSession.HandleDouble(client.GetID(), payload.Level); err != nil {
+		sendGameError(client, err)
+	}
+}
+
+// handleShowHand 处理明牌
+func (h *Handler) handleShowHand(client types.ClientInterface) {
+	if h.roomManager == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	room := h.roomManager.GetRoom(client.GetRoom())
+	if room == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeNotInRoom))
+		return
+	}
+
+	gameSession := h.GetGameSession(room.Code)
+	if gameSession == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	if err := gameSession.HandleShowHand(client.GetID()); err != nil {
 		sendGameError(client, err)
 	}
 }
@@ -74,7 +134,7 @@ func (h *Handler) handlePlayCards(client types.ClientInterface, msg *protocol.Me
 		return
 	}
 
-	if err := gameSession.HandlePlayCards(client.GetID(), payload.Cards); err != nil {
+	if err := gameSession.HandlePlayCards(client.GetID(), payload.Cards, convert.InfoToReading(payload.Reading)); err != nil {
 		sendGameError(client, err)
 	}
 }
@@ -102,3 +162,33 @@ func (h *Handler) handlePass(client types.ClientInterface) {
 		sendGameError(client, err)
 	}
 }
+
+// handleTrustee 开启/取消托管
+func (h *Handler) handleTrustee(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.TrusteePayload](msg)
+	if err != nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+
+	if h.roomManager == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	room := h.roomManager.GetRoom(client.GetRoom())
+	if room == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeNotInRoom))
+		return
+	}
+
+	gameSession := h.GetGameSession(room.Code)
+	if gameSession == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	if err := gameSession.SetTrustee(client.GetID(), payload.Enabled); err != nil {
+		sendGameError(client, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/palemoky/fight-the-landlord/internal/game/match"
 	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/tournament"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/server/session"
@@ -21,6 +22,7 @@ type HandlerDeps struct {
 	ChatLimiter    types.ChatLimiter
 	Leaderboard    *storage.LeaderboardManager
 	SessionManager *session.SessionManager
+	Tournaments    *tournament.Manager
 }
 
 // Handler 消息处理器
@@ -31,6 +33,7 @@ type Handler struct {
 	chatLimiter    types.ChatLimiter
 	leaderboard    *storage.LeaderboardManager
 	sessionManager *session.SessionManager
+	tournaments    *tournament.Manager
 	handlers       map[protocol.MessageType]handlerFunc
 	games          map[string]*session.GameSession
 	gamesMu        sync.RWMutex
@@ -48,6 +51,7 @@ func NewHandler(deps HandlerDeps) *Handler {
 		chatLimiter:    deps.ChatLimiter,
 		leaderboard:    deps.Leaderboard,
 		sessionManager: deps.SessionManager,
+		tournaments:    deps.Tournaments,
 		games:          make(map[string]*session.GameSession),
 	}
 	h.initHandlers()
@@ -76,22 +80,35 @@ func (h *Handler) SetGameSession(roomCode string, gs *session.GameSession) {
 func (h *Handler) initHandlers() {
 	h.handlers = map[protocol.MessageType]handlerFunc{
 		// 连接操作
-		protocol.MsgPing:      h.handlePing,
-		protocol.MsgReconnect: h.handleReconnect,
+		protocol.MsgPing:       h.handlePing,
+		protocol.MsgReconnect:  h.handleReconnect,
+		protocol.MsgClientSeed: h.handleClientSeed,
 
 		// 房间操作
-		protocol.MsgCreateRoom:    func(c types.ClientInterface, _ *protocol.Message) { h.handleCreateRoom(c) },
-		protocol.MsgJoinRoom:      h.handleJoinRoom,
-		protocol.MsgLeaveRoom:     func(c types.ClientInterface, _ *protocol.Message) { h.handleLeaveRoom(c) },
-		protocol.MsgQuickMatch:    func(c types.ClientInterface, _ *protocol.Message) { h.handleQuickMatch(c) },
-		protocol.MsgPracticeMatch: func(c types.ClientInterface, _ *protocol.Message) { h.handlePracticeMatch(c) },
-		protocol.MsgReady:         func(c types.ClientInterface, _ *protocol.Message) { h.handleReady(c, true) },
-		protocol.MsgCancelReady:   func(c types.ClientInterface, _ *protocol.Message) { h.handleReady(c, false) },
+		protocol.MsgCreateRoom:     h.handleCreateRoom,
+		protocol.MsgJoinRoom:       h.handleJoinRoom,
+		protocol.MsgLeaveRoom:      func(c types.ClientInterface, _ *protocol.Message) { h.handleLeaveRoom(c) },
+		protocol.MsgQuickMatch:     h.handleQuickMatch,
+		protocol.MsgPracticeMatch:  func(c types.ClientInterface, _ *protocol.Message) { h.handlePracticeMatch(c) },
+		protocol.MsgReady:          func(c types.ClientInterface, _ *protocol.Message) { h.handleReady(c, true) },
+		protocol.MsgCancelReady:    func(c types.ClientInterface, _ *protocol.Message) { h.handleReady(c, false) },
+		protocol.MsgRematchRequest: h.handleRematchRequest,
 
 		// 游戏操作
 		protocol.MsgBid:       h.handleBid,
+		protocol.MsgDouble:    h.handleDouble,
+		protocol.MsgShowHand:  func(c types.ClientInterface, _ *protocol.Message) { h.handleShowHand(c) },
 		protocol.MsgPlayCards: h.handlePlayCards,
 		protocol.MsgPass:      func(c types.ClientInterface, _ *protocol.Message) { h.handlePass(c) },
+		protocol.MsgTrustee:   h.handleTrustee,
+
+		// 观战
+		protocol.MsgSpectateRoom:   h.handleSpectateRoom,
+		protocol.MsgStopSpectating: func(c types.ClientInterface, _ *protocol.Message) { h.handleStopSpectating(c) },
+
+		// 锦标赛
+		protocol.MsgGetTournaments:     func(c types.ClientInterface, _ *protocol.Message) { h.handleGetTournaments(c) },
+		protocol.MsgTournamentRegister: h.handleTournamentRegister,
 
 		// 信息查询
 		protocol.MsgGetStats:             func(c types.ClientInterface, _ *protocol.Message) { h.handleGetStats(c) },
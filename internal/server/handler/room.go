@@ -2,7 +2,10 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/types"
@@ -11,7 +14,7 @@ import (
 )
 
 // handleCreateRoom 处理创建房间
-func (h *Handler) handleCreateRoom(client types.ClientInterface) {
+func (h *Handler) handleCreateRoom(client types.ClientInterface, msg *protocol.Message) {
 	// 维护模式检查
 	if h.server.IsMaintenanceMode() {
 		client.SendMessage(codec.NewErrorMessageWithText(
@@ -19,12 +22,51 @@ func (h *Handler) handleCreateRoom(client types.ClientInterface) {
 		return
 	}
 
-	// 如果已在房间中，先离开
+	// payload 可选：未指定房规时按经典规则建房
+	payload, err := codec.ParsePayload[protocol.CreateRoomPayload](msg)
+	if err != nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+	opts := room.RoomOptions{
+		RuleSet:  payload.RuleSet,
+		Laizi:    payload.Laizi,
+		Mode:     payload.Mode,
+		BidMode:  payload.BidMode,
+		Doubling: payload.Doubling,
+		Hands:    payload.Hands,
+	}
+	if _, ok := rule.RuleSetByName(opts.RuleSet); !ok {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "未知的房规: "+opts.RuleSet))
+		return
+	}
+	if _, ok := room.LayoutByMode(opts.Mode); !ok {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "未知的玩法模式: "+opts.Mode))
+		return
+	}
+	// 两副牌一手最多 8 张癞子，逐一展开读法的代价太高，暂不支持
+	if opts.Laizi && opts.Layout().Decks > 1 {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "两副牌玩法不支持癞子"))
+		return
+	}
+	if !room.ValidBidMode(opts.BidMode) {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "未知的叫地主方式: "+opts.BidMode))
+		return
+	}
+	if opts.Hands < 0 || opts.Hands > room.MaxSeriesHands {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg,
+			fmt.Sprintf("系列赛局数须在 1~%d 之间", room.MaxSeriesHands)))
+		return
+	}
+
+	// 如果已在房间中，先离开；正在观战则退出观战，并撤回再来一局的同意
 	if client.GetRoom() != "" {
 		h.roomManager.LeaveRoom(client)
 	}
+	h.roomManager.StopSpectating(client)
+	h.roomManager.WithdrawRematch(client)
 
-	room, err := h.roomManager.CreateRoom(client)
+	room, err := h.roomManager.CreateRoom(client, opts)
 	if err != nil {
 		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeUnknown, err.Error()))
 		return
@@ -36,8 +78,9 @@ func (h *Handler) handleCreateRoom(client types.ClientInterface) {
 	}
 
 	client.SendMessage(codec.MustNewMessage(protocol.MsgRoomCreated, protocol.RoomCreatedPayload{
-		RoomCode: room.Code,
-		Player:   room.GetPlayerInfo(client.GetID()),
+		RoomCode:   room.Code,
+		Player:     room.GetPlayerInfo(client.GetID()),
+		SeedCommit: room.SeedCommit(),
 	}))
 }
 
@@ -56,10 +99,12 @@ func (h *Handler) handleJoinRoom(client types.ClientInterface, msg *protocol.Mes
 		return
 	}
 
-	// 如果已在房间中，先离开
+	// 如果已在房间中，先离开；正在观战则退出观战，并撤回再来一局的同意
 	if client.GetRoom() != "" {
 		h.roomManager.LeaveRoom(client)
 	}
+	h.roomManager.StopSpectating(client)
+	h.roomManager.WithdrawRematch(client)
 
 	room, err := h.roomManager.JoinRoom(client, payload.RoomCode)
 	if err != nil {
@@ -78,19 +123,21 @@ func (h *Handler) handleJoinRoom(client types.ClientInterface, msg *protocol.Mes
 	}
 
 	client.SendMessage(codec.MustNewMessage(protocol.MsgRoomJoined, protocol.RoomJoinedPayload{
-		RoomCode: room.Code,
-		Player:   room.GetPlayerInfo(client.GetID()),
-		Players:  room.GetAllPlayersInfo(),
+		RoomCode:   room.Code,
+		Player:     room.GetPlayerInfo(client.GetID()),
+		Players:    room.GetAllPlayersInfo(),
+		SeedCommit: room.SeedCommit(),
 	}))
 }
 
-// handleLeaveRoom 处理离开房间
+// handleLeaveRoom 处理离开房间，结算后离开也撤回再来一局的同意
 func (h *Handler) handleLeaveRoom(client types.ClientInterface) {
 	h.roomManager.LeaveRoom(client)
+	h.roomManager.WithdrawRematch(client)
 }
 
 // handleQuickMatch 处理快速匹配
-func (h *Handler) handleQuickMatch(client types.ClientInterface) {
+func (h *Handler) handleQuickMatch(client types.ClientInterface, msg *protocol.Message) {
 	// 维护模式检查
 	if h.server.IsMaintenanceMode() {
 		client.SendMessage(codec.NewErrorMessageWithText(
@@ -98,12 +145,25 @@ func (h *Handler) handleQuickMatch(client types.ClientInterface) {
 		return
 	}
 
-	// 如果已在房间中，先离开
+	// payload 可选：未指定模式时匹配经典三人局
+	payload, err := codec.ParsePayload[protocol.QuickMatchPayload](msg)
+	if err != nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+	if _, ok := room.LayoutByMode(payload.Mode); !ok {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "未知的玩法模式: "+payload.Mode))
+		return
+	}
+
+	// 如果已在房间中，先离开；正在观战则退出观战，并撤回再来一局的同意
 	if client.GetRoom() != "" {
 		h.roomManager.LeaveRoom(client)
 	}
+	h.roomManager.StopSpectating(client)
+	h.roomManager.WithdrawRematch(client)
 
-	h.matcher.AddToQueue(client)
+	h.matcher.AddToQueue(client, payload.Mode)
 }
 
 // handlePracticeMatch 处理人机练习
@@ -117,6 +177,8 @@ func (h *Handler) handlePracticeMatch(client types.ClientInterface) {
 	if client.GetRoom() != "" {
 		h.roomManager.LeaveRoom(client)
 	}
+	h.roomManager.StopSpectating(client)
+	h.roomManager.WithdrawRematch(client)
 
 	h.matcher.PracticeMatch(client)
 }
@@ -133,3 +195,35 @@ func (h *Handler) handleReady(client types.ClientInterface, ready bool) {
 		}
 	}
 }
+
+// handleRematchRequest 处理对局结束后的再来一局表态
+func (h *Handler) handleRematchRequest(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.RematchRequestPayload](msg)
+	if err != nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+
+	accept := payload.Accept
+	if accept && h.server.IsMaintenanceMode() {
+		client.SendMessage(codec.NewErrorMessageWithText(
+			protocol.ErrCodeServerMaintenance, "服务器维护中，暂停再来一局"))
+		accept = false
+	}
+
+	// 同意后在原房间等待其他玩家：退出观战与匹配队列；已进入其他房间的玩家由 RequestRematch 拒绝
+	if accept {
+		h.roomManager.StopSpectating(client)
+		h.matcher.RemoveFromQueue(client)
+	}
+
+	// 拒绝时投票可能已经结束，无需提示
+	if err := h.roomManager.RequestRematch(client, payload.RoomCode, accept); err != nil && accept {
+		var gameErr *apperrors.GameError
+		if errors.As(err, &gameErr) {
+			client.SendMessage(codec.NewErrorMessage(gameErr.Code))
+		} else {
+			client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeUnknown, err.Error()))
+		}
+	}
+}
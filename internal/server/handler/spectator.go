@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// handleSpectateRoom 处理观战：下发不含手牌的当前局面，之后随房间广播接收公开消息
+func (h *Handler) handleSpectateRoom(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.SpectateRoomPayload](msg)
+	if err != nil || payload.RoomCode == "" {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+
+	// 玩家不能同时观战，避免其他房间的广播混入自己的对局
+	if client.GetRoom() != "" {
+		client.SendMessage(codec.NewErrorMessageWithText(protocol.ErrCodeInvalidMsg, "请先离开当前房间再观战"))
+		return
+	}
+
+	room, err := h.roomManager.SpectateRoom(client, payload.RoomCode)
+	if err != nil {
+		sendGameError(client, err)
+		return
+	}
+
+	gameSession := h.GetGameSession(room.Code)
+	if gameSession == nil {
+		h.roomManager.StopSpectating(client)
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeGameNotStart))
+		return
+	}
+
+	client.SendMessage(codec.MustNewMessage(protocol.MsgSpectateStarted, protocol.SpectateStartedPayload{
+		RoomCode:  room.Code,
+		GameState: gameSession.BuildSpectatorStateDTO(h.sessionManager),
+		HandDelay: gameSession.SpectatorHandDelay(),
+	}))
+}
+
+// handleStopSpectating 处理退出观战
+func (h *Handler) handleStopSpectating(client types.ClientInterface) {
+	h.roomManager.StopSpectating(client)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/types"
+)
+
+// handleGetTournaments 获取锦标赛列表
+func (h *Handler) handleGetTournaments(client types.ClientInterface) {
+	var tournaments []protocol.TournamentInfo
+	if h.tournaments != nil {
+		tournaments = h.tournaments.List(client.GetID())
+	}
+	client.SendMessage(codec.MustNewMessage(protocol.MsgTournamentList, protocol.TournamentListPayload{
+		Tournaments: tournaments,
+	}))
+}
+
+// handleTournamentRegister 处理报名/退出锦标赛，成功后回复最新的锦标赛列表
+func (h *Handler) handleTournamentRegister(client types.ClientInterface, msg *protocol.Message) {
+	payload, err := codec.ParsePayload[protocol.TournamentRegisterPayload](msg)
+	if err != nil || payload.TournamentID == "" {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeInvalidMsg))
+		return
+	}
+	if h.tournaments == nil {
+		client.SendMessage(codec.NewErrorMessage(protocol.ErrCodeTournamentNotFound))
+		return
+	}
+
+	if payload.Register && h.server.IsMaintenanceMode() {
+		client.SendMessage(codec.NewErrorMessageWithText(
+			protocol.ErrCodeServerMaintenance, "服务器维护中，暂停锦标赛报名"))
+		return
+	}
+
+	if err := h.tournaments.Register(client, payload.TournamentID, payload.Register); err != nil {
+		sendGameError(client, err)
+		return
+	}
+	h.handleGetTournaments(client)
+}
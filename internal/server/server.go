@@ -14,8 +14,10 @@ import (
 
 	"github.com/palemoky/fight-the-landlord/internal/bot"
 	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/game/match"
 	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/tournament"
 	"github.com/palemoky/fight-the-landlord/internal/server/handler"
 	"github.com/palemoky/fight-the-landlord/internal/server/session"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
@@ -44,10 +46,14 @@ type Server struct {
 	leaderboard    *storage.LeaderboardManager
 	roomManager    *room.RoomManager
 	matcher        *match.Matcher
+	tournaments    *tournament.Manager
 	sessionManager *session.SessionManager
 	clients        map[string]*Client
 	clientsMu      sync.RWMutex
 	handler        *handler.Handler
+	eventSink      gamelog.Sink       // 对局事件日志落地，nil 表示不保存
+	botEngine      bot.DecisionEngine // 机器人使用的决策引擎，未启用机器人时为 nil
+	autoPlayEngine bot.DecisionEngine // 托管与超时代打使用的决策引擎
 
 	// 安全组件
 	rateLimiter    *RateLimiter
@@ -107,34 +113,51 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		semaphore:      make(chan struct{}, cfg.Server.MaxConnections),
 	}
 
+	// 重连令牌写入 Redis，服务重启后玩家仍可凭令牌回到对局
+	s.sessionManager.SetStore(s.redisStore)
+
 	// 初始化房间管理器
 	s.roomManager = room.NewRoomManager(s.redisStore, cfg.Game)
 
 	// 初始化机器人 (未启用时为 nil）
-	var botEngine bot.DecisionEngine
 	if cfg.BOT.Enabled {
 		if cfg.BOT.DouZeroEnabled {
-			botEngine = bot.NewDouZeroEngine(cfg.BOT.DouZeroURL)
+			s.botEngine = bot.NewDouZeroEngine(cfg.BOT.DouZeroURL)
 			log.Printf("🎮 DouZero 引擎已启用（服务地址: %s，等待超时: %ds）", cfg.BOT.DouZeroURL, cfg.BOT.BotFillTimeout)
 		} else {
-			botEngine = bot.NewHeuristicEngine()
+			s.botEngine = bot.NewHeuristicEngine()
 			log.Printf("🤖 规则启发式机器人已启用（等待超时: %ds）", cfg.BOT.BotFillTimeout)
 		}
 	}
 
+	s.autoPlayEngine = newAutoPlayEngine(cfg.BOT, s.botEngine)
+
 	// 初始化匹配器
 	s.matcher = match.NewMatcher(match.MatcherDeps{
+		RoomManager:     s.roomManager,
+		RedisStore:      s.redisStore,
+		Leaderboard:     s.leaderboard,
+		GameConfig:      cfg.Game,
+		BotEngine:       s.botEngine,
+		BotConfig:       cfg.BOT,
+		RegisterSession: s.registerGameSession,
+	})
+
+	// 初始化锦标赛
+	s.tournaments = tournament.NewManager(tournament.ManagerDeps{
 		RoomManager: s.roomManager,
-		RedisStore:  s.redisStore,
-		Leaderboard: s.leaderboard,
-		GameConfig:  cfg.Game,
-		BotEngine:   botEngine,
-		BotConfig:   cfg.BOT,
-		RegisterSession: func(roomCode string, gs *session.GameSession) {
-			s.handler.SetGameSession(roomCode, gs)
-		},
+		Config:      cfg.Tournament,
+		BotEngine:   s.botEngine,
+		Lookup:      s.GetClientByID,
+		LeaveQueue:  s.matcher.RemoveFromQueue,
 	})
 
+	// 每局结束保存回放文件
+	if cfg.Game.ReplayDir != "" {
+		s.eventSink = gamelog.NewFileSink(cfg.Game.ReplayDir)
+		log.Printf("📼 对局回放保存目录: %s", cfg.Game.ReplayDir)
+	}
+
 	// 初始化消息处理器
 	s.handler = handler.NewHandler(handler.HandlerDeps{
 		Server:         s,
@@ -143,21 +166,109 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		ChatLimiter:    s.chatLimiter,
 		Leaderboard:    s.leaderboard,
 		SessionManager: s.sessionManager,
+		Tournaments:    s.tournaments,
 	})
 
 	// 设置房间游戏开始回调
 	s.roomManager.SetOnGameStart(func(r *room.Room) {
 		gs := session.NewGameSession(r, s.leaderboard, s.config.Game)
-		s.handler.SetGameSession(r.Code, gs)
+		// 人机练习再来一局时房间里有机器人，需要注入新的会话
+		for _, p := range r.Players {
+			if b, ok := p.Client.(*bot.BotClient); ok {
+				b.SetSession(gs)
+			}
+		}
+		s.registerGameSession(r.Code, gs)
 		gs.Start()
 	})
 
+	// 再来一局未成行时，同意的玩家回到匹配队列
+	s.roomManager.SetOnRequeue(s.matcher.AddToQueue)
+
+	// 房间移除后释放其游戏会话（含事件日志与房间引用）
+	s.roomManager.SetOnRoomRemoved(func(code string) { s.handler.SetGameSession(code, nil) })
+
+	// 恢复服务重启前进行中的对局
+	s.restoreGames()
+
 	log.Printf("🔒 安全配置: 连接限制=%d/s, 消息限制=%d/s, 聊天限制=%d/s, 最大连接数=%d",
 		cfg.Security.RateLimit.MaxPerSecond, cfg.Security.MessageLimit.MaxPerSecond, cfg.Security.ChatLimit.MaxPerSecond, cfg.Server.MaxConnections)
 
 	return s, nil
 }
 
+// registerGameSession 注册游戏会话，挂上事件日志 Sink 与快照存储（需在 gs.Start 之前调用）
+func (s *Server) registerGameSession(roomCode string, gs *session.GameSession) {
+	gs.SetEventSink(s.eventSink)
+	gs.SetDecisionEngine(s.autoPlayEngine)
+	gs.SetStore(s.redisStore)
+	gs.SetDealSource(s.tournaments.DealSource(roomCode))
+	gs.SetOnGameOver(func(final bool) {
+		// 锦标赛桌由锦标赛安排下一局，不发起再来一局
+		if s.tournaments.HandleHandEnd(roomCode, final) {
+			return
+		}
+		if final {
+			s.roomManager.OpenRematch(roomCode)
+		}
+	})
+	s.handler.SetGameSession(roomCode, gs)
+}
+
+// newAutoPlayEngine 按配置创建托管与超时代打使用的引擎，未指定时沿用机器人引擎
+func newAutoPlayEngine(cfg config.BotConfig, botEngine bot.DecisionEngine) bot.DecisionEngine {
+	switch cfg.AutoPlayEngine {
+	case "douzero":
+		log.Printf("🤖 代打引擎: DouZero（服务地址: %s）", cfg.DouZeroURL)
+		return bot.NewDouZeroEngine(cfg.DouZeroURL)
+	case "heuristic":
+		return bot.NewHeuristicEngine()
+	case "":
+	default:
+		log.Printf("⚠️ 未知的代打引擎 %q，沿用机器人引擎", cfg.AutoPlayEngine)
+	}
+	if botEngine != nil {
+		return botEngine
+	}
+	return bot.NewHeuristicEngine()
+}
+
+// restoreGames 从 Redis 恢复服务重启前进行中的对局，玩家凭重连令牌回到原座位
+func (s *Server) restoreGames() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	codes, err := s.redisStore.GetAllRoomCodes(ctx)
+	if err != nil {
+		log.Printf("⚠️ 读取房间列表失败，跳过对局恢复: %v", err)
+		return
+	}
+
+	restored := 0
+	for _, code := range codes {
+		data, err := s.redisStore.LoadRoom(ctx, code)
+		if err != nil {
+			log.Printf("⚠️ 房间 %s 数据读取失败: %v", code, err)
+			continue
+		}
+		if data == nil || data.GameData == nil {
+			continue
+		}
+
+		r := s.roomManager.RestoreRoom(data)
+		if id := r.DetachTournament(); id != "" {
+			log.Printf("⚠️ 房间 %s 是锦标赛 %s 的牌桌，锦标赛进度已随重启丢失：本局按普通对局打完后结束，成绩不计入锦标赛", r.Code, id)
+		}
+		gs := session.RestoreGameSession(r, data.GameData, s.leaderboard, s.config.Game)
+		s.registerGameSession(r.Code, gs)
+		gs.Resume()
+		restored++
+	}
+	if restored > 0 {
+		log.Printf("♻️ 已恢复 %d 局进行中的对局，等待玩家重连", restored)
+	}
+}
+
 // Start 启动服务器
 func (s *Server) Start() error {
 	addr := fmt.Sprintf("%s:%d", s.config.Server.Host, s.config.Server.Port)
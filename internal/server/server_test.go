@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/palemoky/fight-the-landlord/internal/bot"
 	"github.com/palemoky/fight-the-landlord/internal/config"
 )
 
@@ -113,3 +114,32 @@ func TestServer_GracefulShutdown_Logic(t *testing.T) {
 	// Skip complex integration-like tests in unit tests unless we mock everything.
 	// Focusing on available simple logic.
 }
+
+func TestNewAutoPlayEngine(t *testing.T) {
+	t.Parallel()
+
+	botEngine := bot.NewHeuristicEngine()
+	tests := []struct {
+		name      string
+		engine    string
+		botEngine bot.DecisionEngine
+		want      any
+	}{
+		{"未指定时沿用机器人引擎", "", botEngine, botEngine},
+		{"未启用机器人时使用启发式引擎", "", nil, &bot.HeuristicEngine{}},
+		{"指定 DouZero", "douzero", botEngine, &bot.DouZeroEngine{}},
+		{"指定启发式", "heuristic", nil, &bot.HeuristicEngine{}},
+		{"未知引擎沿用机器人引擎", "llm", botEngine, botEngine},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := newAutoPlayEngine(config.BotConfig{AutoPlayEngine: tt.engine}, tt.botEngine)
+			if tt.want == botEngine {
+				assert.Same(t, botEngine, got)
+				return
+			}
+			assert.IsType(t, tt.want, got)
+		})
+	}
+}
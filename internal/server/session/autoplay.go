@@ -0,0 +1,221 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/bot"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// SetDecisionEngine 设置代替玩家决策（托管、超时与离线代打）使用的引擎，nil 表示使用规则启发式引擎
+func (gs *GameSession) SetDecisionEngine(engine bot.DecisionEngine) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.engine = engine
+}
+
+// decisionEngine 返回代打使用的引擎（调用方需持有 gs.mu）
+func (gs *GameSession) decisionEngine() bot.DecisionEngine {
+	if gs.engine == nil {
+		return bot.NewHeuristicEngine()
+	}
+	return gs.engine
+}
+
+// autoAction 一次代打决策的输入：持锁时采集，解锁后再调用引擎（DouZero 引擎是网络调用）与搜索兜底出牌
+type autoAction struct {
+	engine     bot.DecisionEngine
+	state      GameState
+	playerID   string
+	name       string
+	hand       []card.Card
+	isLandlord bool
+	bidReq     bot.BidRequest  // 叫地主阶段
+	gctx       bot.GameContext // 出牌阶段
+	toBeat     rule.ParsedHand // 出牌阶段要压的上家牌，必须出牌时为空
+}
+
+// prepareAutoAction 采集座位 idx 的玩家在当前阶段做决策所需的信息（调用方需持有 gs.mu）
+func (gs *GameSession) prepareAutoAction(idx int) autoAction {
+	p := gs.players[idx]
+	a := autoAction{
+		engine:     gs.decisionEngine(),
+		state:      gs.state,
+		playerID:   p.ID,
+		name:       p.Name,
+		hand:       slices.Clone(p.Hand),
+		isLandlord: p.IsLandlord,
+	}
+	switch gs.state {
+	case GameStateBidding:
+		a.bidReq = bot.BidRequest{
+			IsGrab:  gs.landlordCaller != -1,
+			Options: gs.bidOptions(),
+			PrevBid: gs.prevBid(p.ID),
+		}
+	case GameStatePlaying:
+		a.gctx = gs.engineContext(idx)
+		if !a.gctx.MustPlay {
+			a.toBeat = gs.lastPlayedHand
+		}
+	}
+	return a
+}
+
+// fallbackCards 兜底出牌：最小能打过的牌，必须出牌时出最小的一手，nil 表示不出。
+// 只用采集到的副本，不需要持有 gs.mu：癞子玩法中搜索出牌较慢，不应阻塞其他玩家的操作
+func (a autoAction) fallbackCards() []card.Card {
+	return a.gctx.Rules.FindSmallestBeatingCards(a.hand, a.toBeat)
+}
+
+// performAutoAction 调用引擎决策并代玩家提交，提交时仍会校验是否轮到该玩家；
+// 出牌阶段引擎给出的牌被拒绝时改用兜底出牌，保证回合能继续
+func (gs *GameSession) performAutoAction(a autoAction) error {
+	ctx := context.Background()
+	switch a.state {
+	case GameStateBidding:
+		decision := a.engine.DecideBid(ctx, a.name, a.hand, a.bidReq)
+		if a.bidReq.ScoreMode() {
+			return gs.handleBidScore(a.playerID, decision, true)
+		}
+		return gs.handleBid(a.playerID, decision > 0, true)
+	case GameStateDoubling:
+		return gs.handleDouble(a.playerID, a.engine.DecideDouble(ctx, a.name, a.hand, a.isLandlord), true)
+	case GameStatePlaying:
+		err := gs.submitPlay(a.playerID, a.engine.DecidePlay(ctx, a.name, a.gctx))
+		if err == nil || errors.Is(err, apperrors.ErrNotYourTurn) || errors.Is(err, apperrors.ErrGameNotStart) {
+			return err
+		}
+		log.Printf("⚠️ 玩家 %s 代打出牌被拒绝，改用兜底出牌: %v", a.name, err)
+		return gs.submitPlay(a.playerID, a.fallbackCards())
+	default:
+		return apperrors.ErrGameNotStart
+	}
+}
+
+// submitPlay 代玩家出牌，cards 为 nil 时不出
+func (gs *GameSession) submitPlay(playerID string, cards []card.Card) error {
+	if cards == nil {
+		return gs.pass(playerID, true)
+	}
+	return gs.playCards(playerID, convert.CardsToInfos(cards), rule.HandReading{}, true)
+}
+
+// dealEvents 返回本次发牌之后的事件（流局重发时只看最后一次发牌）（调用方需持有 gs.mu）
+func (gs *GameSession) dealEvents() []gamelog.Event {
+	events := gs.events.Events
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type == gamelog.EventDeal {
+			return events[i+1:]
+		}
+	}
+	return events
+}
+
+// prevBid 本次发牌后其他玩家最近一次叫地主的决策，尚无时为 nil（调用方需持有 gs.mu）
+func (gs *GameSession) prevBid(playerID string) *bool {
+	events := gs.dealEvents()
+	for i := len(events) - 1; i >= 0; i-- {
+		if e := events[i]; e.Type == gamelog.EventBid && e.PlayerID != playerID {
+			return &e.Bid
+		}
+	}
+	return nil
+}
+
+// engineContext 构建座位 idx 的玩家视角下的出牌决策上下文，只使用该玩家能看到的信息（调用方需持有 gs.mu）
+func (gs *GameSession) engineContext(idx int) bot.GameContext {
+	p := gs.players[idx]
+	n := len(gs.players)
+	prev, next := gs.players[(idx+n-1)%n], gs.players[gs.nextSeat(idx)]
+	mustPlay := gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty()
+
+	gctx := bot.GameContext{
+		IsLandlord:   p.IsLandlord,
+		Hand:         slices.Clone(p.Hand),
+		BottomCards:  slices.Clone(gs.bottomCards),
+		MustPlay:     mustPlay,
+		CanBeat:      mustPlay || gs.rules.CanBeatWithHand(p.Hand, gs.lastPlayedHand),
+		Rules:        gs.rules,
+		PlayerCounts: [2]int{len(prev.Hand), len(next.Hand)},
+		PlayerRoles:  [2]bool{prev.IsLandlord, next.IsLandlord},
+	}
+
+	// 记牌器：整副牌扣除已出的牌
+	remaining := make(map[card.Rank]int)
+	for _, c := range gs.room.Options.Layout().NewDeck() {
+		remaining[c.Rank]++
+	}
+
+	// DouZero 只支持三人局，位置按地主座位换算
+	landlordSeat := -1
+	if n == 3 {
+		for i, pl := range gs.players {
+			if pl.IsLandlord {
+				landlordSeat = i
+				break
+			}
+		}
+	}
+	if landlordSeat >= 0 {
+		gctx.DouZeroPos = bot.SeatToDouZeroPos(idx, landlordSeat)
+		gctx.NumCardsLeft = make(map[string]int, n)
+		for i, pl := range gs.players {
+			gctx.NumCardsLeft[bot.SeatToDouZeroPos(i, landlordSeat)] = len(pl.Hand)
+		}
+	}
+
+	for _, e := range gs.dealEvents() {
+		switch e.Type {
+		case gamelog.EventPlay:
+			for _, c := range e.Cards {
+				if remaining[c.Rank] > 0 {
+					remaining[c.Rank]--
+				}
+			}
+			seat := gs.seatOf(e.PlayerID)
+			if parsed, ok := gs.rules.PlayedHand(e.Cards, convert.InfoToReading(e.Reading)); ok {
+				gctx.RecentPlays[1] = gctx.RecentPlays[0]
+				gctx.RecentPlays[0] = bot.PlayRecord{
+					Played:     parsed,
+					PlayerName: gs.players[seat].Name,
+					IsLandlord: gs.players[seat].IsLandlord,
+				}
+			}
+			if landlordSeat >= 0 {
+				ranks := make([]card.Rank, len(e.Cards))
+				for i, c := range e.Cards {
+					ranks[i] = c.Rank
+				}
+				// DouZero 位置下标：0=地主、1=地主下家、2=地主上家
+				pos := (seat - landlordSeat + n) % n
+				gctx.PlayedByPos[pos] = append(gctx.PlayedByPos[pos], ranks...)
+				gctx.ActionSeq = append(gctx.ActionSeq, ranks)
+				gctx.LastMovePos = bot.SeatToDouZeroPos(seat, landlordSeat)
+			}
+		case gamelog.EventPass:
+			if landlordSeat >= 0 {
+				gctx.ActionSeq = append(gctx.ActionSeq, nil)
+			}
+		}
+	}
+	gctx.RemainingCards = remaining
+	return gctx
+}
+
+// seatOf 返回玩家的座位索引，不在对局中时为 -1（调用方需持有 gs.mu）
+func (gs *GameSession) seatOf(playerID string) int {
+	for i, p := range gs.players {
+		if p.ID == playerID {
+			return i
+		}
+	}
+	return -1
+}
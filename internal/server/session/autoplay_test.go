@@ -0,0 +1,235 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/bot"
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+)
+
+// newTestSession 创建三人对局会话（未开局）
+func newTestSession(t *testing.T) (*GameSession, []*testutil.SimpleClient) {
+	t.Helper()
+	clients := []*testutil.SimpleClient{
+		testutil.NewSimpleClient("p1", "Player1"),
+		testutil.NewSimpleClient("p2", "Player2"),
+		testutil.NewSimpleClient("p3", "Player3"),
+	}
+	r := room.NewMockRoom("TEST123", clients[0])
+	r.Players["p2"] = &room.RoomPlayer{Client: clients[1], Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: clients[2], Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	t.Cleanup(gs.StopAllTimers)
+	return gs, clients
+}
+
+func TestGameSession_Trustee(t *testing.T) {
+	t.Parallel()
+
+	gs, clients := newTestSession(t)
+	assert.ErrorIs(t, gs.SetTrustee("p1", true), apperrors.ErrGameNotStart)
+
+	gs.Start()
+	assert.ErrorIs(t, gs.SetTrustee("nobody", true), apperrors.ErrNotInRoom)
+
+	bidder := gs.players[gs.currentBidder].ID
+	require.NoError(t, gs.SetTrustee(bidder, true))
+	// 取消托管前到点的代打也会放弃，不会与下面手动触发的代打冲突
+	t.Cleanup(func() { _ = gs.SetTrustee(bidder, false) })
+
+	// 全桌都收到托管通知，快照中也带有托管标记
+	for _, c := range clients {
+		msgs := c.SentMessages()
+		last := msgs[len(msgs)-1]
+		assert.Equal(t, protocol.MsgTrusteeChanged, last.Type)
+	}
+	for _, p := range gs.BuildGameStateDTO("p1", NewSessionManager()).Players {
+		assert.Equal(t, p.ID == bidder, p.Trustee)
+	}
+
+	// 代打一次：由引擎替托管玩家叫地主
+	gs.runTrustee(bidder)
+	events := gs.EventLog().Events
+	bid := events[len(events)-1]
+	assert.Equal(t, gamelog.EventBid, bid.Type)
+	assert.Equal(t, bidder, bid.PlayerID)
+
+	// 已不轮到该玩家时不会再代打
+	gs.runTrustee(bidder)
+	assert.Len(t, gs.EventLog().Events, len(events))
+
+	// 代打不会取消托管；被拒绝的手动操作（不轮到该玩家）也不会
+	assert.True(t, gs.players[gs.seatOf(bidder)].Trustee)
+	before := len(clients[0].SentMessages())
+	assert.ErrorIs(t, gs.HandleBid(bidder, true), apperrors.ErrNotYourTurn)
+	assert.True(t, gs.players[gs.seatOf(bidder)].Trustee)
+	assert.Len(t, clients[0].SentMessages(), before)
+}
+
+func TestGameSession_ManualActionCancelsTrustee(t *testing.T) {
+	t.Parallel()
+
+	gs, clients := newTestSession(t)
+	gs.Start()
+
+	c := func(s card.Suit, r card.Rank) card.Card { return card.Card{Suit: s, Rank: r} }
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 0
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{}
+	gs.players[0].Hand = []card.Card{c(card.Spade, card.Rank5), c(card.Heart, card.Rank4), c(card.Spade, card.Rank3)}
+	gs.players[0].Trustee = true
+	gs.mu.Unlock()
+
+	// 校验失败的出牌不取消托管
+	err := gs.HandlePlayCards("p1", convert.CardsToInfos([]card.Card{c(card.Spade, card.Rank5), c(card.Heart, card.Rank4)}), rule.HandReading{})
+	require.ErrorIs(t, err, apperrors.ErrInvalidCards)
+	gs.mu.RLock()
+	assert.True(t, gs.players[0].Trustee)
+	gs.mu.RUnlock()
+
+	// 亲自出牌成功后取消托管并通知全桌
+	require.NoError(t, gs.HandlePlayCards("p1", convert.CardsToInfos([]card.Card{c(card.Spade, card.Rank3)}), rule.HandReading{}))
+	gs.mu.RLock()
+	assert.False(t, gs.players[0].Trustee)
+	gs.mu.RUnlock()
+	changed, err := codec.ParsePayload[protocol.TrusteeChangedPayload](lastMessage(clients[1], protocol.MsgTrusteeChanged))
+	require.NoError(t, err)
+	assert.Equal(t, "p1", changed.PlayerID)
+	assert.False(t, changed.Enabled)
+}
+
+func TestGameSession_EngineContext(t *testing.T) {
+	t.Parallel()
+
+	gs, _ := newTestSession(t)
+	gs.Start()
+
+	c := func(s card.Suit, r card.Rank) card.Card { return card.Card{Suit: s, Rank: r} }
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 0
+	gs.lastPlayerIdx = 0
+	gs.players[0].IsLandlord = true
+	gs.players[0].Hand = []card.Card{c(card.Spade, card.Rank5), c(card.Heart, card.Rank4), c(card.Spade, card.Rank3)}
+	gs.players[1].Hand = []card.Card{c(card.Club, card.RankA), c(card.Club, card.Rank7)}
+	gs.players[2].Hand = []card.Card{c(card.Diamond, card.Rank9)}
+	gs.mu.Unlock()
+
+	require.NoError(t, gs.HandlePlayCards("p1", []protocol.CardInfo{convert.CardToInfo(c(card.Spade, card.Rank3))}, rule.HandReading{}))
+
+	gs.mu.Lock()
+	gctx := gs.engineContext(1)
+	gs.mu.Unlock()
+
+	assert.False(t, gctx.IsLandlord)
+	assert.Len(t, gctx.Hand, 2)
+	assert.False(t, gctx.MustPlay)
+	assert.True(t, gctx.CanBeat)
+	assert.Equal(t, [2]int{2, 1}, gctx.PlayerCounts, "上家为地主，下家为 p3")
+	assert.Equal(t, [2]bool{true, false}, gctx.PlayerRoles)
+	assert.Equal(t, "Player1", gctx.RecentPlays[0].PlayerName)
+	assert.True(t, gctx.RecentPlays[0].IsLandlord)
+	assert.Equal(t, 3, gctx.RemainingCards[card.Rank3], "已出的 3 从记牌器中扣除")
+
+	assert.Equal(t, bot.DouZeroPosLandlordDn, gctx.DouZeroPos)
+	assert.Equal(t, bot.DouZeroPosLandlord, gctx.LastMovePos)
+	assert.Equal(t, [][]card.Rank{{card.Rank3}}, gctx.ActionSeq)
+	assert.Equal(t, []card.Rank{card.Rank3}, gctx.PlayedByPos[0])
+	assert.Equal(t, map[string]int{
+		bot.DouZeroPosLandlord:   2,
+		bot.DouZeroPosLandlordDn: 2,
+		bot.DouZeroPosLandlordUp: 1,
+	}, gctx.NumCardsLeft)
+}
+
+// stubEngine 出牌时返回固定的牌，并记录收到的决策上下文
+type stubEngine struct {
+	bot.DecisionEngine
+	play []card.Card
+	gctx bot.GameContext
+}
+
+func (e *stubEngine) DecidePlay(_ context.Context, _ string, gctx bot.GameContext) []card.Card {
+	e.gctx = gctx
+	return e.play
+}
+
+func TestGameSession_PlayTimeoutUsesEngine(t *testing.T) {
+	t.Parallel()
+
+	c := func(s card.Suit, r card.Rank) card.Card { return card.Card{Suit: s, Rank: r} }
+	setup := func(t *testing.T, engine bot.DecisionEngine) *GameSession {
+		gs, _ := newTestSession(t)
+		gs.Start()
+		gs.SetDecisionEngine(engine)
+
+		gs.mu.Lock()
+		gs.state = GameStatePlaying
+		gs.currentPlayer = 1
+		gs.lastPlayerIdx = 1
+		gs.players[0].IsLandlord = true
+		gs.players[0].Hand = []card.Card{c(card.Spade, card.Rank5)}
+		gs.players[1].Hand = []card.Card{c(card.Club, card.RankA), c(card.Club, card.Rank7)}
+		gs.players[2].Hand = []card.Card{c(card.Diamond, card.Rank9)}
+		gs.mu.Unlock()
+		return gs
+	}
+	lastPlay := func(gs *GameSession) gamelog.Event {
+		events := gs.EventLog().Events
+		return events[len(events)-1]
+	}
+
+	t.Run("引擎按农民身份决策", func(t *testing.T) {
+		t.Parallel()
+		engine := &stubEngine{play: []card.Card{c(card.Club, card.RankA)}}
+		gs := setup(t, engine)
+
+		gs.handlePlayTimeout()
+
+		assert.False(t, engine.gctx.IsLandlord)
+		assert.Equal(t, [2]bool{true, false}, engine.gctx.PlayerRoles)
+		play := lastPlay(gs)
+		assert.Equal(t, gamelog.EventPlay, play.Type)
+		assert.Equal(t, "p2", play.PlayerID)
+		assert.Equal(t, []card.Card{c(card.Club, card.RankA)}, play.Cards)
+	})
+
+	t.Run("引擎出牌不合法时兜底出最小的牌", func(t *testing.T) {
+		t.Parallel()
+		gs := setup(t, &stubEngine{}) // 必须出牌时不出
+
+		gs.handlePlayTimeout()
+
+		play := lastPlay(gs)
+		assert.Equal(t, gamelog.EventPlay, play.Type)
+		assert.Equal(t, []card.Card{c(card.Club, card.Rank7)}, play.Cards)
+	})
+
+	t.Run("离线超时同样由引擎代打", func(t *testing.T) {
+		t.Parallel()
+		engine := &stubEngine{play: []card.Card{c(card.Club, card.RankA)}}
+		gs := setup(t, engine)
+
+		gs.handleOfflineTimeout("p2")
+
+		assert.Equal(t, []card.Card{c(card.Club, card.RankA)}, lastPlay(gs).Cards)
+	})
+}
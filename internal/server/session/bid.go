@@ -3,9 +3,13 @@ package session
 import (
 	"cmp"
 	"slices"
+	"time"
 
 	"github.com/palemoky/fight-the-landlord/internal/apperrors"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
@@ -18,21 +22,37 @@ import (
 //     底倍为 1，随后进入抢地主阶段。若一圈无人叫则流局重新发牌。
 //   - 抢地主阶段：除暂定地主外的玩家依次「抢 / 不抢」，每次抢翻一倍并接管暂定地主身份
 //     （原叫地主者可「反抢」）。当连续两人放弃后，暂定地主成为地主，底倍即叫抢累计的倍数。
+//
+// 叫分模式下 bid 为 true 表示叫可叫的最低分（见 HandleBidScore），超时自动不叫也走这里。
 func (gs *GameSession) HandleBid(playerID string, bid bool) error {
+	return gs.handleBid(playerID, bid, false)
+}
+
+// handleBid 处理叫地主 / 抢地主，auto 表示由系统代为决策（超时、离线或托管），代为决策不取消托管
+func (gs *GameSession) handleBid(playerID string, bid, auto bool) error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
-	if gs.state != GameStateBidding {
-		return apperrors.ErrGameNotStart
+	currentPlayer, err := gs.checkBidTurn(playerID)
+	if err != nil {
+		return err
 	}
 
-	currentPlayer := gs.players[gs.currentBidder]
-	if currentPlayer.ID != playerID {
-		return apperrors.ErrNotYourTurn
+	if gs.ScoreBidding() {
+		score := 0
+		if bid {
+			score = gs.bidOptions()[0]
+		}
+		gs.stopTimer()
+		gs.cancelTrustee(gs.currentBidder, auto)
+		gs.handleScore(currentPlayer, score)
+		return nil
 	}
 
 	// 取消超时计时器
 	gs.stopTimer()
+	gs.cancelTrustee(gs.currentBidder, auto)
 
 	isGrab := gs.landlordCaller != -1 // 已有人叫地主则处于抢地主阶段
 
@@ -44,6 +64,104 @@ func (gs *GameSession) HandleBid(playerID string, bid bool) error {
 	return nil
 }
 
+// HandleBidScore 处理叫分（叫分模式）
+//
+// 叫分流程：从随机一位玩家开始每人叫一次，只能叫比当前最高分更高的分数（1~3）或不叫。
+// 有人叫到 3 分立即成为地主；一圈叫完由最高分者当地主，底倍即所叫分数；无人叫分则流局重新发牌。
+func (gs *GameSession) HandleBidScore(playerID string, score int) error {
+	return gs.handleBidScore(playerID, score, false)
+}
+
+// handleBidScore 处理叫分，auto 表示由系统代为决策，代为决策不取消托管
+func (gs *GameSession) handleBidScore(playerID string, score int, auto bool) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	defer gs.persist()
+
+	currentPlayer, err := gs.checkBidTurn(playerID)
+	if err != nil {
+		return err
+	}
+	if !gs.ScoreBidding() || (score != 0 && !slices.Contains(gs.bidOptions(), score)) {
+		return apperrors.ErrInvalidBid
+	}
+
+	gs.stopTimer()
+	gs.cancelTrustee(gs.currentBidder, auto)
+	gs.handleScore(currentPlayer, score)
+	return nil
+}
+
+// checkBidTurn 检查是否处于叫地主阶段且轮到该玩家，返回当前叫地主的玩家
+func (gs *GameSession) checkBidTurn(playerID string) (*GamePlayer, error) {
+	if gs.state != GameStateBidding {
+		return nil, apperrors.ErrGameNotStart
+	}
+	currentPlayer := gs.players[gs.currentBidder]
+	if currentPlayer.ID != playerID {
+		return nil, apperrors.ErrNotYourTurn
+	}
+	return currentPlayer, nil
+}
+
+// ScoreBidding 本房间是否使用叫分模式
+func (gs *GameSession) ScoreBidding() bool {
+	return gs.room.Options.BidMode == room.BidModeScore
+}
+
+// bidOptions 叫分模式下当前可叫的分数（比最高叫分高），叫抢模式返回 nil
+func (gs *GameSession) bidOptions() []int {
+	if !gs.ScoreBidding() {
+		return nil
+	}
+	var options []int
+	for s := gs.highestBid + 1; s <= room.MaxBidScore; s++ {
+		options = append(options, s)
+	}
+	return options
+}
+
+// bidTimeout 当前叫地主方式的超时时间，叫分超时未配置时沿用叫地主超时
+func (gs *GameSession) bidTimeout() time.Duration {
+	if gs.ScoreBidding() && gs.gameConfig.ScoreBidTimeout > 0 {
+		return gs.gameConfig.ScoreBidTimeoutDuration()
+	}
+	return gs.gameConfig.BidTimeoutDuration()
+}
+
+// handleScore 处理叫分模式的决策，score 为 0 表示不叫
+func (gs *GameSession) handleScore(player *GamePlayer, score int) {
+	gs.bidTurns++
+	if score > 0 {
+		gs.highestBid = score
+		gs.landlordCandidate = gs.currentBidder
+		gs.bidMultiplier = score
+	}
+	gs.record(gamelog.Event{Type: gamelog.EventBid, PlayerID: player.ID, Bid: score > 0, Score: score})
+
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgBidResult, protocol.BidResultPayload{
+		PlayerID:   player.ID,
+		PlayerName: player.Name,
+		Bid:        score > 0,
+		Multiplier: gs.bidMultiplier,
+		Score:      score,
+	}))
+	if score > 0 {
+		gs.broadcastMultiplier(nil)
+	}
+
+	switch {
+	case gs.highestBid == room.MaxBidScore, gs.bidTurns >= len(gs.players) && gs.highestBid > 0:
+		gs.setLandlord(gs.landlordCandidate)
+	case gs.bidTurns >= len(gs.players):
+		// 一圈无人叫分 → 流局，重新发牌
+		gs.redeal()
+	default:
+		gs.currentBidder = gs.nextSeat(gs.currentBidder)
+		gs.notifyBidTurn()
+	}
+}
+
 // handleCall 处理叫地主阶段的决策
 func (gs *GameSession) handleCall(player *GamePlayer, bid bool) {
 	if bid {
@@ -51,6 +169,7 @@ func (gs *GameSession) handleCall(player *GamePlayer, bid bool) {
 		gs.landlordCaller = gs.currentBidder
 		gs.landlordCandidate = gs.currentBidder
 		gs.bidMultiplier = 1
+		gs.grabCount = 0
 		gs.bidPasses = 0
 		gs.grabActions = 0
 
@@ -66,12 +185,12 @@ func (gs *GameSession) handleCall(player *GamePlayer, bid bool) {
 	gs.broadcastBidResult(player, false, false)
 
 	// 一圈无人叫地主 → 流局，重新发牌
-	if gs.bidPasses >= 3 {
+	if gs.bidPasses >= len(gs.players) {
 		gs.redeal()
 		return
 	}
 
-	gs.currentBidder = (gs.currentBidder + 1) % 3
+	gs.currentBidder = gs.nextSeat(gs.currentBidder)
 	gs.notifyBidTurn()
 }
 
@@ -80,6 +199,7 @@ func (gs *GameSession) handleGrab(player *GamePlayer, bid bool) {
 	if bid {
 		// 抢地主：翻倍并接管暂定地主身份
 		gs.bidMultiplier *= 2
+		gs.grabCount++
 		gs.landlordCandidate = gs.currentBidder
 		gs.bidPasses = 0
 	} else {
@@ -90,10 +210,10 @@ func (gs *GameSession) handleGrab(player *GamePlayer, bid bool) {
 	gs.broadcastBidResult(player, bid, true)
 
 	// 抢地主结束条件（满足其一）：
-	//   1. 除暂定地主外的两名玩家连续放弃；
-	//   2. 每名玩家都已抢过一次（叫地主者 A 之后 B、C、A 依次决策，最多 3 次），
+	//   1. 除暂定地主外的其余玩家连续放弃；
+	//   2. 每名玩家都已抢过一次（三人时叫地主者 A 之后 B、C、A 依次决策，最多 3 次），
 	//      防止互相反抢导致倍数无限翻倍。
-	if gs.bidPasses >= 2 || gs.grabActions >= 3 {
+	if gs.bidPasses >= len(gs.players)-1 || gs.grabActions >= len(gs.players) {
 		gs.setLandlord(gs.landlordCandidate)
 		return
 	}
@@ -104,15 +224,16 @@ func (gs *GameSession) handleGrab(player *GamePlayer, bid bool) {
 
 // nextGrabber 返回下一个可抢地主的玩家索引（跳过当前暂定地主）
 func (gs *GameSession) nextGrabber(from int) int {
-	next := (from + 1) % 3
+	next := gs.nextSeat(from)
 	if next == gs.landlordCandidate {
-		next = (next + 1) % 3
+		next = gs.nextSeat(next)
 	}
 	return next
 }
 
 // broadcastBidResult 广播叫/抢地主结果
 func (gs *GameSession) broadcastBidResult(player *GamePlayer, bid, isGrab bool) {
+	gs.record(gamelog.Event{Type: gamelog.EventBid, PlayerID: player.ID, Bid: bid, IsGrab: isGrab})
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgBidResult, protocol.BidResultPayload{
 		PlayerID:   player.ID,
 		PlayerName: player.Name,
@@ -120,6 +241,9 @@ func (gs *GameSession) broadcastBidResult(player *GamePlayer, bid, isGrab bool)
 		IsGrab:     isGrab,
 		Multiplier: gs.bidMultiplier,
 	}))
+	if bid {
+		gs.broadcastMultiplier(nil)
+	}
 }
 
 // setLandlord 设置地主
@@ -135,24 +259,67 @@ func (gs *GameSession) setLandlord(idx int) {
 
 	// 更新房间玩家状态
 	gs.room.Players[landlord.ID].IsLandlord = true
+	gs.evalBottomBonus()
+	gs.record(gamelog.Event{Type: gamelog.EventLandlord, PlayerID: landlord.ID, BottomCards: slices.Clone(gs.bottomCards)})
+	gs.scheduleSpectatorHands()
 
-	// 广播地主信息（含底倍）
+	// 广播地主信息（含底倍与底牌翻倍）
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgLandlord, protocol.LandlordPayload{
-		PlayerID:    landlord.ID,
-		PlayerName:  landlord.Name,
-		BottomCards: convert.CardsToInfos(gs.bottomCards),
-		Multiplier:  gs.bidMultiplier,
+		PlayerID:      landlord.ID,
+		PlayerName:    landlord.Name,
+		BottomCards:   convert.CardsToInfos(gs.bottomCards),
+		Multiplier:    gs.landlordMultiplier(),
+		BottomBonus:   gs.bottomBonus,
+		BottomPattern: gs.bottomPattern.String(),
 	}))
+	if gs.bottomBonus > 1 {
+		gs.broadcastMultiplier(nil)
+	}
 
 	// 给地主发送更新后的手牌
-	rp := gs.room.Players[landlord.ID]
-	client := rp.Client
-	client.SendMessage(codec.MustNewMessage(protocol.MsgDealCards, protocol.DealCardsPayload{
+	gs.room.Players[landlord.ID].Send(codec.MustNewMessage(protocol.MsgDealCards, protocol.DealCardsPayload{
 		Cards:       convert.CardsToInfos(landlord.Hand),
 		BottomCards: convert.CardsToInfos(gs.bottomCards),
+		WildRank:    int(gs.rules.Wild),
 	}))
+	// 发牌时已明牌的地主，拿到底牌后向全桌更新手牌
+	if landlord.ShowHand > 0 {
+		gs.broadcastHand(landlord)
+	}
+
+	// 开启加倍的房间先进入加倍阶段，否则直接开始出牌
+	if gs.room.Options.Doubling {
+		gs.startDoubling()
+		return
+	}
+	gs.startPlaying(idx)
+}
+
+// evalBottomBonus 按配置结算底牌翻倍：底牌满足多种牌型时取倍数最高的一个
+func (gs *GameSession) evalBottomBonus() {
+	cfg := gs.gameConfig.BottomBonus
+	factors := map[rule.BottomPattern]int{
+		rule.BottomJoker:    cfg.Joker,
+		rule.BottomPair:     cfg.Pair,
+		rule.BottomStraight: cfg.Straight,
+		rule.BottomFlush:    cfg.Flush,
+	}
+
+	gs.bottomPattern, gs.bottomBonus = rule.BottomNone, 1
+	for _, p := range rule.BottomPatterns(gs.bottomCards) {
+		if factors[p] > gs.bottomBonus {
+			gs.bottomPattern, gs.bottomBonus = p, factors[p]
+		}
+	}
+}
+
+// landlordMultiplier 定地主后的底倍：叫抢倍数 × 明牌 × 底牌翻倍，与倍数构成推送的总倍数一致
+func (gs *GameSession) landlordMultiplier() int {
+	return breakdownTotal(gs.multiplierBreakdown(nil))
+}
 
-	// 开始游戏，地主先出牌
+// startPlaying 开始出牌阶段，地主先出牌
+func (gs *GameSession) startPlaying(idx int) {
 	gs.state = GameStatePlaying
 	gs.room.State = RoomStatePlaying
 	gs.currentPlayer = idx
@@ -166,9 +333,11 @@ func (gs *GameSession) notifyBidTurn() {
 	player := gs.players[gs.currentBidder]
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgBidTurn, protocol.BidTurnPayload{
 		PlayerID:   player.ID,
-		Timeout:    gs.gameConfig.BidTimeout,
+		Timeout:    int(gs.bidTimeout().Seconds()),
 		IsGrab:     gs.landlordCaller != -1,
 		Multiplier: gs.bidMultiplier,
+		Options:    gs.bidOptions(),
 	}))
 	gs.startBidTimer()
+	gs.scheduleTrustee()
 }
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+)
+
+// undecidedDouble 加倍阶段尚未做出选择
+const undecidedDouble = -1
+
+// startDoubling 定地主后进入加倍阶段，所有玩家同时选择不加倍 / 加倍 / 超级加倍（调用方需持有 gs.mu）
+func (gs *GameSession) startDoubling() {
+	gs.state = GameStateDoubling
+	gs.doubles = make([]int, len(gs.players))
+	for i := range gs.doubles {
+		gs.doubles[i] = undecidedDouble
+	}
+
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgDoubleTurn, protocol.DoubleTurnPayload{
+		Timeout:    gs.gameConfig.DoubleTimeout,
+		Multiplier: gs.landlordMultiplier(),
+		Pending:    gs.pendingDoubles(),
+	}))
+	gs.startDoubleTimer()
+	gs.scheduleTrustee()
+}
+
+// HandleDouble 处理加倍选择，每名玩家只能选择一次；所有人选完后地主开始出牌
+func (gs *GameSession) HandleDouble(playerID string, level int) error {
+	return gs.handleDouble(playerID, level, false)
+}
+
+// handleDouble 处理加倍选择，auto 表示由系统代为决策，代为决策不取消托管
+func (gs *GameSession) handleDouble(playerID string, level int, auto bool) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	defer gs.persist()
+
+	if gs.state != GameStateDoubling {
+		return apperrors.ErrGameNotStart
+	}
+	idx := -1
+	for i, p := range gs.players {
+		if p.ID == playerID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 || level < room.DoubleNone || level > room.DoubleSuper || gs.doubles[idx] != undecidedDouble {
+		return apperrors.ErrInvalidDouble
+	}
+
+	gs.cancelTrustee(idx, auto)
+	gs.doubles[idx] = level
+	player := gs.players[idx]
+	gs.record(gamelog.Event{Type: gamelog.EventDouble, PlayerID: player.ID, Level: level})
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgDoubleResult, protocol.DoubleResultPayload{
+		PlayerID:   player.ID,
+		PlayerName: player.Name,
+		Level:      level,
+	}))
+
+	if len(gs.pendingDoubles()) > 0 {
+		return nil
+	}
+	gs.stopTimer()
+	for i, p := range gs.players {
+		if p.IsLandlord {
+			gs.startPlaying(i)
+			break
+		}
+	}
+	return nil
+}
+
+// pendingDoubles 返回加倍阶段尚未选择的玩家 ID
+func (gs *GameSession) pendingDoubles() []string {
+	var pending []string
+	for i, level := range gs.doubles {
+		if level == undecidedDouble {
+			pending = append(pending, gs.players[i].ID)
+		}
+	}
+	return pending
+}
+
+// doubleLevel 返回玩家的加倍选择，未开启加倍或未选择时为不加倍
+func (gs *GameSession) doubleLevel(idx int) int {
+	if idx >= len(gs.doubles) || gs.doubles[idx] == undecidedDouble {
+		return room.DoubleNone
+	}
+	return gs.doubles[idx]
+}
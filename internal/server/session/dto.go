@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
-	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
@@ -31,17 +30,10 @@ func (gs *GameSession) BuildGameStateDTO(playerID string, sessionManager *Sessio
 			IsLandlord: p.IsLandlord,
 			CardsCount: len(p.Hand),
 			Online:     sessionManager.IsOnline(p.ID),
+			Trustee:    p.Trustee,
 		}
 	}
-	phase := "waiting"
-	switch gs.state {
-	case GameStateBidding:
-		phase = "bidding"
-	case GameStatePlaying:
-		phase = "playing"
-	case GameStateEnded:
-		phase = "ended"
-	}
+	phase := gs.state.String()
 	currentTurnID := ""
 	switch gs.state {
 	case GameStateBidding:
@@ -50,25 +42,39 @@ func (gs *GameSession) BuildGameStateDTO(playerID string, sessionManager *Sessio
 		currentTurnID = gs.players[gs.currentPlayer].ID
 	}
 	var lastPlayed []card.Card
-	lastPlayerID := ""
+	lastPlayerID, lastHandType := "", ""
 	if !gs.lastPlayedHand.IsEmpty() {
 		lastPlayed = gs.lastPlayedHand.Cards
 		lastPlayerID = gs.players[gs.lastPlayerIdx].ID
+		lastHandType = gs.lastPlayedHand.Name()
+	}
+	breakdown := gs.multiplierBreakdown(nil)
+	timeBank := 0
+	if gs.state == GameStatePlaying {
+		timeBank = gs.timeBankSeconds(gs.currentPlayer)
 	}
 	return &protocol.GameStateDTO{
-		Phase:        phase,
-		Players:      players,
-		Hand:         convert.CardsToInfos(hand),
-		BottomCards:  convert.CardsToInfos(gs.bottomCards),
-		CurrentTurn:  currentTurnID,
-		LastPlayed:   convert.CardsToInfos(lastPlayed),
-		LastPlayerID: lastPlayerID,
-		MustPlay:     gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty(),
-		CanBeat:      true,
+		Phase:         phase,
+		Players:       players,
+		Hand:          convert.CardsToInfos(hand),
+		BottomCards:   convert.CardsToInfos(gs.bottomCards),
+		CurrentTurn:   currentTurnID,
+		LastPlayed:    convert.CardsToInfos(lastPlayed),
+		LastPlayerID:  lastPlayerID,
+		LastHandType:  lastHandType,
+		LastReading:   convert.ReadingToInfo(gs.lastPlayedHand.Reading()),
+		MustPlay:      gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty(),
+		CanBeat:       true,
+		WildRank:      int(gs.rules.Wild),
+		Mode:          gs.room.Options.Mode,
+		RevealedHands: gs.revealedHands(),
+		Multiplier:    breakdownTotal(breakdown),
+		Breakdown:     breakdown,
+		TimeBank:      timeBank,
 	}
 }
 
-// ResendTurnTo 在重连后向指定玩家补发"当前回合"通知（叫地主/出牌），携带计时器的剩余时间。它只向单个客户端发送、不广播、不重启计时器，用于恢复重连玩家的操作提示（按钮、倒计时、叫/抢区分）。
+// ResendTurnTo 在重连后向指定玩家补发"当前回合"通知（叫地主/加倍/出牌），携带计时器的剩余时间。它只向单个客户端发送、不广播、不重启计时器，用于恢复重连玩家的操作提示（按钮、倒计时、叫/抢区分）。
 func (gs *GameSession) ResendTurnTo(client types.ClientInterface) {
 	gs.mu.RLock()
 	defer gs.mu.RUnlock()
@@ -78,22 +84,35 @@ func (gs *GameSession) ResendTurnTo(client types.ClientInterface) {
 		player := gs.players[gs.currentBidder]
 		client.SendMessage(codec.MustNewMessage(protocol.MsgBidTurn, protocol.BidTurnPayload{
 			PlayerID:   player.ID,
-			Timeout:    gs.remainingTurnSeconds(gs.gameConfig.BidTimeout),
+			Timeout:    gs.remainingTurnSeconds(int(gs.bidTimeout().Seconds())),
 			IsGrab:     gs.landlordCaller != -1,
 			Multiplier: gs.bidMultiplier,
+			Options:    gs.bidOptions(),
+		}))
+	case GameStateDoubling:
+		client.SendMessage(codec.MustNewMessage(protocol.MsgDoubleTurn, protocol.DoubleTurnPayload{
+			Timeout:    gs.remainingTurnSeconds(gs.gameConfig.DoubleTimeout),
+			Multiplier: gs.landlordMultiplier(),
+			Pending:    gs.pendingDoubles(),
 		}))
 	case GameStatePlaying:
 		player := gs.players[gs.currentPlayer]
 		mustPlay := gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty()
 		canBeat := mustPlay
 		if !mustPlay {
-			canBeat = rule.FindSmallestBeatingCards(player.Hand, gs.lastPlayedHand) != nil
+			canBeat = gs.rules.CanBeatWithHand(player.Hand, gs.lastPlayedHand)
+		}
+		// 已在消耗备用时间时基础出牌时间为 0，剩余时间都在备用时间里
+		timeout := gs.remainingTurnSeconds(gs.gameConfig.TurnTimeout)
+		if gs.usingTimeBank() {
+			timeout = 0
 		}
 		client.SendMessage(codec.MustNewMessage(protocol.MsgPlayTurn, protocol.PlayTurnPayload{
 			PlayerID: player.ID,
-			Timeout:  gs.remainingTurnSeconds(gs.gameConfig.TurnTimeout),
+			Timeout:  timeout,
 			MustPlay: mustPlay,
 			CanBeat:  canBeat,
+			TimeBank: gs.timeBankSeconds(gs.currentPlayer),
 		}))
 	}
 }
@@ -0,0 +1,62 @@
+package session
+
+import (
+	"log"
+	"slices"
+
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+)
+
+// SetEventSink 设置对局结束时接收完整事件日志的 Sink，nil 表示不落地
+func (gs *GameSession) SetEventSink(sink gamelog.Sink) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.eventSink = sink
+}
+
+// EventLog 返回当前事件日志的快照
+func (gs *GameSession) EventLog() gamelog.Log {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+	l := gs.events
+	l.Events = slices.Clone(gs.events.Events)
+	return l
+}
+
+// newEventLog 创建带对局信息的空事件日志
+func (gs *GameSession) newEventLog() gamelog.Log {
+	players := make([]gamelog.Player, len(gs.players))
+	for i, p := range gs.players {
+		players[i] = gamelog.Player{ID: p.ID, Name: p.Name, Seat: p.Seat}
+	}
+	return gamelog.Log{
+		RoomCode: gs.room.Code,
+		Mode:     gs.room.Options.Mode,
+		RuleSet:  gs.room.Options.RuleSet,
+		BidMode:  gs.room.Options.BidMode,
+		Players:  players,
+	}
+}
+
+// record 追加一条事件（调用方需持有 gs.mu）
+func (gs *GameSession) record(e gamelog.Event) {
+	gs.events.Append(e)
+	if gs.events.StartedAt.IsZero() {
+		gs.events.StartedAt = gs.events.Events[0].Time
+	}
+}
+
+// flushEventLog 把完整事件日志异步交给 Sink，避免落地 IO 阻塞对局（调用方需持有 gs.mu）
+func (gs *GameSession) flushEventLog() {
+	if gs.eventSink == nil {
+		return
+	}
+	l := gs.events
+	l.Events = slices.Clone(gs.events.Events)
+	sink := gs.eventSink
+	go func() {
+		if err := sink.SaveGameLog(&l); err != nil {
+			log.Printf("⚠️ 房间 %s 对局日志保存失败: %v", l.RoomCode, err)
+		}
+	}()
+}
@@ -4,8 +4,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/palemoky/fight-the-landlord/internal/bot"
 	"github.com/palemoky/fight-the-landlord/internal/config"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
@@ -17,10 +21,27 @@ type GameState int
 const (
 	GameStateInit GameState = iota
 	GameStateBidding
+	GameStateDoubling
 	GameStatePlaying
 	GameStateEnded
 )
 
+// String 返回阶段名，与 GameStateDTO.Phase 一致
+func (s GameState) String() string {
+	switch s {
+	case GameStateBidding:
+		return "bidding"
+	case GameStateDoubling:
+		return "doubling"
+	case GameStatePlaying:
+		return "playing"
+	case GameStateEnded:
+		return "ended"
+	default:
+		return "waiting"
+	}
+}
+
 // GamePlayer 游戏中的玩家
 type GamePlayer struct {
 	ID         string
@@ -29,6 +50,9 @@ type GamePlayer struct {
 	Hand       []card.Card
 	IsLandlord bool
 	IsOffline  bool // 是否离线
+	IsBot      bool // 是否机器人
+	ShowHand   int  // 明牌倍数，0 表示未明牌
+	Trustee    bool // 是否托管（由决策引擎代为操作）
 }
 
 // GameSession 游戏会话
@@ -38,9 +62,18 @@ type GameSession struct {
 	gameConfig  config.GameConfig
 	state       GameState
 	players     []*GamePlayer // 按座位顺序
+	rules       rule.RuleSet  // 房间选定的牌型规则（癞子玩法中含本局癞子点数）
 
 	deck        card.Deck
 	bottomCards []card.Card
+	hiddenCards []card.Card // 二人玩法中无人使用的暗牌
+
+	// 可验证洗牌相关
+	clientSeeds []string         // 按座位排列的客户端种子
+	dealRound   int              // 已发牌次数（流局重发时递增，参与种子推导）
+	shuffled    card.Deck        // 本次发牌洗好的完整牌序（复式发牌时为轮换前的原始牌序），结算时揭示
+	dealSource  DealSource       // 复式赛发牌钩子，nil 表示照常洗牌
+	board       *duplicate.Board // 本次复式发牌，nil 表示照常洗牌
 
 	// 叫抢地主相关
 	currentBidder     int // 当前叫/抢地主的玩家索引
@@ -49,10 +82,20 @@ type GameSession struct {
 	bidPasses         int // 连续"不叫/不抢"次数（用于流局与结束判断）
 	grabActions       int // 抢地主阶段已进行的决策次数（每人最多一次，最多 3 次后强制结束）
 	bidMultiplier     int // 叫抢阶段产生的底倍
+	grabCount         int // 抢地主次数（叫抢模式下底倍 = 2^grabCount）
 	redealCount       int // 已发生的流局次数（达到上限后随机强制指定地主）
+	highestBid        int // 叫分模式下当前最高叫分，0 表示尚无人叫
+	bidTurns          int // 叫分模式下已决策的人数（每人叫一次）
+
+	// 底牌翻倍（定地主时结算）
+	bottomPattern rule.BottomPattern // 触发翻倍的底牌牌型，BottomNone 表示未翻倍
+	bottomBonus   int                // 底牌翻倍倍数，未翻倍为 1
+
+	// 加倍相关（按座位排列，nil 表示本局没有加倍阶段）
+	doubles []int
 
 	// 倍数相关（出牌阶段累计）
-	bombCount     int // 已打出的炸弹+王炸数量，每个翻一倍
+	bombCount     int // 炸弹+王炸带来的翻倍次数（癞子玩法中硬炸弹计两次）
 	landlordPlays int // 地主实际出牌次数（用于反春天判断）
 	farmerPlays   int // 农民实际出牌次数（用于春天判断）
 
@@ -69,30 +112,89 @@ type GameSession struct {
 	timerStartTime   time.Time     // 计时器开始时间
 	timerMu          sync.Mutex
 
+	// 出牌备用时间（按座位排列，nil 表示未启用）：基础出牌时间耗尽后继续消耗，受 timerMu 保护
+	timeBanks  []time.Duration
+	inTimeBank bool // 当前回合计时器是否在消耗备用时间
+
+	// 托管代打使用的决策引擎，nil 时使用规则启发式引擎
+	engine bot.DecisionEngine
+
+	// 对局事件日志（只追加），结束时交给 eventSink
+	events    gamelog.Log
+	eventSink gamelog.Sink
+
+	// 对局结束、玩家离开房间后调用（开启再来一局投票）
+	onGameOver func(final bool)
+
+	// 崩溃恢复：每次状态变化后把快照随房间写入 Redis
+	store           *storage.RedisStore
+	persistedEvents int               // 上次保存快照时的事件数，未变化则无需保存
+	stateDirty      bool              // 有不产生事件的状态变化（如托管）尚未保存
+	pendingSave     *storage.RoomData // 等待写入的最新房间数据
+	saving          bool              // 是否有写入协程在运行
+	saveMu          sync.Mutex
+
 	mu sync.RWMutex
 }
 
-// NewGameSession 创建游戏会话
+// NewGameSession 创建游戏会话。
+// 系列赛、锦标赛局间掉线的玩家照常入座，按离线处理并托管，由决策引擎代打直到重连
 func NewGameSession(r *room.Room, lb *storage.LeaderboardManager, gameCfg config.GameConfig) *GameSession {
 	playerOrder := r.PlayerOrder
 	players := make([]*GamePlayer, len(playerOrder))
+	clientSeeds := make([]string, len(playerOrder))
 	for i, id := range playerOrder {
 		rp := r.Players[id]
 		players[i] = &GamePlayer{
-			ID:   id,
-			Name: rp.Client.GetName(),
-			Seat: i,
+			ID:    id,
+			Name:  rp.Name,
+			Seat:  i,
+			IsBot: rp.IsBot,
+		}
+		if rp.Client != nil {
+			players[i].Name = rp.Client.GetName()
+			players[i].IsBot = rp.Client.IsBot()
+		} else {
+			players[i].IsOffline = true
+			players[i].Trustee = true
 		}
+		clientSeeds[i] = rp.ClientSeed
 	}
 
-	return &GameSession{
+	// 未经 StartGame 开局（如测试）时补上服务端种子
+	if len(r.ServerSeed) == 0 {
+		r.ServerSeed = fairness.NewServerSeed()
+	}
+
+	gs := &GameSession{
 		room:              r,
 		leaderboard:       lb,
 		gameConfig:        gameCfg,
 		state:             GameStateInit,
 		players:           players,
+		clientSeeds:       clientSeeds,
+		rules:             r.Rules(),
 		landlordCaller:    -1,
 		landlordCandidate: -1,
 		bidMultiplier:     1,
+		bottomBonus:       1,
+	}
+	if bank := gameCfg.TimeBankDuration(); bank > 0 {
+		gs.timeBanks = make([]time.Duration, len(players))
+		for i := range gs.timeBanks {
+			gs.timeBanks[i] = bank
+		}
 	}
+	gs.events = gs.newEventLog()
+	return gs
+}
+
+// Rules 返回本局使用的牌型规则
+func (gs *GameSession) Rules() rule.RuleSet {
+	return gs.rules
+}
+
+// nextSeat 返回 idx 的下家座位索引
+func (gs *GameSession) nextSeat(idx int) int {
+	return (idx + 1) % len(gs.players)
 }
@@ -2,6 +2,7 @@ package session
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -9,9 +10,11 @@ import (
 	"github.com/palemoky/fight-the-landlord/internal/apperrors"
 	"github.com/palemoky/fight-the-landlord/internal/config"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
 	"github.com/palemoky/fight-the-landlord/internal/testutil"
@@ -177,6 +180,259 @@ func TestHandleBid_AllPass_Redeal(t *testing.T) {
 	}
 }
 
+// newScoreBidSession 创建一个叫分模式的三人对局并开局
+func newScoreBidSession() *GameSession {
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.BidMode = room.BidModeScore
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil),
+		config.GameConfig{TurnTimeout: 30, BidTimeout: 15, ScoreBidTimeout: 20})
+	gs.Start()
+	return gs
+}
+
+func TestHandleBidScore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("叫 3 分立即成为地主", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+		assert.Equal(t, []int{1, 2, 3}, gs.bidOptions())
+		assert.Equal(t, 20*time.Second, gs.bidTimeout())
+
+		bidder := gs.players[gs.currentBidder]
+		require.NoError(t, gs.HandleBidScore(bidder.ID, 3))
+		assert.Equal(t, GameStatePlaying, gs.state)
+		assert.True(t, bidder.IsLandlord)
+		assert.Equal(t, 3, gs.bidMultiplier)
+	})
+
+	t.Run("一圈后最高分者当地主", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+
+		first := gs.players[gs.currentBidder]
+		require.NoError(t, gs.HandleBidScore(first.ID, 1))
+		assert.Equal(t, []int{2, 3}, gs.bidOptions())
+
+		second := gs.players[gs.currentBidder]
+		require.NoError(t, gs.HandleBidScore(second.ID, 2))
+		third := gs.players[gs.currentBidder]
+		require.NoError(t, gs.HandleBidScore(third.ID, 0))
+
+		assert.Equal(t, GameStatePlaying, gs.state)
+		assert.True(t, second.IsLandlord)
+		assert.Equal(t, 2, gs.bidMultiplier)
+	})
+
+	t.Run("不能叫不高于当前的分", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+
+		require.NoError(t, gs.HandleBidScore(gs.players[gs.currentBidder].ID, 2))
+		bidder := gs.players[gs.currentBidder]
+		assert.ErrorIs(t, gs.HandleBidScore(bidder.ID, 2), apperrors.ErrInvalidBid)
+		assert.ErrorIs(t, gs.HandleBidScore(bidder.ID, 4), apperrors.ErrInvalidBid)
+		assert.Equal(t, bidder.ID, gs.players[gs.currentBidder].ID)
+	})
+
+	t.Run("叫/不叫按最低分与不叫处理", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, true))
+		assert.Equal(t, 1, gs.highestBid)
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, false))
+		assert.Equal(t, 1, gs.highestBid)
+	})
+
+	t.Run("无人叫分则重新发牌", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+
+		for range 3 {
+			require.NoError(t, gs.HandleBidScore(gs.players[gs.currentBidder].ID, 0))
+		}
+		assert.Equal(t, GameStateBidding, gs.state)
+		assert.Equal(t, 0, gs.highestBid)
+		assert.Equal(t, 0, gs.bidTurns)
+		assert.Equal(t, []int{1, 2, 3}, gs.bidOptions())
+	})
+
+	t.Run("叫抢模式不接受叫分", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+		gs.room.Options.BidMode = room.BidModeGrab
+		assert.ErrorIs(t, gs.HandleBidScore(gs.players[gs.currentBidder].ID, 1), apperrors.ErrInvalidBid)
+	})
+}
+
+// newDoublingSession 创建一个开启加倍的三人对局，p1 叫地主后其余人不抢，进入加倍阶段
+func newDoublingSession(t *testing.T) *GameSession {
+	t.Helper()
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.Doubling = true
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil),
+		config.GameConfig{TurnTimeout: 30, BidTimeout: 15, DoubleTimeout: 10})
+	gs.Start()
+	require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, true))
+	for range 2 {
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, false))
+	}
+	t.Cleanup(gs.StopAllTimers)
+	return gs
+}
+
+func TestHandleDouble(t *testing.T) {
+	t.Parallel()
+
+	t.Run("定地主后进入加倍阶段，全部选择后地主出牌", func(t *testing.T) {
+		t.Parallel()
+		gs := newDoublingSession(t)
+		require.Equal(t, GameStateDoubling, gs.state)
+		assert.Len(t, gs.pendingDoubles(), 3)
+
+		require.NoError(t, gs.HandleDouble("p1", room.DoubleTwice))
+		require.NoError(t, gs.HandleDouble("p2", room.DoubleNone))
+		assert.Equal(t, GameStateDoubling, gs.state)
+		require.NoError(t, gs.HandleDouble("p3", room.DoubleSuper))
+
+		assert.Equal(t, GameStatePlaying, gs.state)
+		assert.True(t, gs.players[gs.currentPlayer].IsLandlord)
+		assert.Equal(t, []int{room.DoubleTwice, room.DoubleNone, room.DoubleSuper}, gs.doubles)
+	})
+
+	t.Run("无效或重复选择", func(t *testing.T) {
+		t.Parallel()
+		gs := newDoublingSession(t)
+
+		assert.ErrorIs(t, gs.HandleDouble("p1", 3), apperrors.ErrInvalidDouble)
+		assert.ErrorIs(t, gs.HandleDouble("nobody", room.DoubleNone), apperrors.ErrInvalidDouble)
+		require.NoError(t, gs.HandleDouble("p1", room.DoubleTwice))
+		assert.ErrorIs(t, gs.HandleDouble("p1", room.DoubleSuper), apperrors.ErrInvalidDouble)
+	})
+
+	t.Run("超时未选择视为不加倍", func(t *testing.T) {
+		t.Parallel()
+		gs := newDoublingSession(t)
+
+		require.NoError(t, gs.HandleDouble("p2", room.DoubleSuper))
+		gs.handleDoubleTimeout()
+		assert.Equal(t, GameStatePlaying, gs.state)
+		assert.Equal(t, []int{room.DoubleNone, room.DoubleSuper, room.DoubleNone}, gs.doubles)
+	})
+
+	t.Run("未开启加倍时不能加倍", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+		assert.ErrorIs(t, gs.HandleDouble("p1", room.DoubleTwice), apperrors.ErrGameNotStart)
+	})
+}
+
+func TestHandleShowHand(t *testing.T) {
+	t.Parallel()
+
+	t.Run("叫地主阶段明牌 ×5", func(t *testing.T) {
+		t.Parallel()
+		gs := newScoreBidSession()
+		t.Cleanup(gs.StopAllTimers)
+
+		require.NoError(t, gs.HandleShowHand("p2"))
+		assert.Equal(t, showHandAtDeal, gs.players[1].ShowHand)
+		assert.ErrorIs(t, gs.HandleShowHand("p2"), apperrors.ErrCannotShowHand)
+		assert.ErrorIs(t, gs.HandleShowHand("nobody"), apperrors.ErrCannotShowHand)
+		require.Len(t, gs.revealedHands(), 1)
+		assert.Equal(t, "p2", gs.revealedHands()[0].PlayerID)
+
+		gs.landlordPlays, gs.farmerPlays = 3, 3
+		assert.Equal(t, showHandAtDeal, gs.finalMultiplier(gs.players[0]))
+	})
+
+	t.Run("地主拿底牌后首次出牌前明牌 ×2", func(t *testing.T) {
+		t.Parallel()
+		gs := newDoublingSession(t)
+		var landlord, farmer *GamePlayer
+		for _, p := range gs.players {
+			if p.IsLandlord {
+				landlord = p
+			} else {
+				farmer = p
+			}
+		}
+
+		assert.ErrorIs(t, gs.HandleShowHand(farmer.ID), apperrors.ErrCannotShowHand)
+		require.NoError(t, gs.HandleShowHand(landlord.ID))
+		assert.Equal(t, showHandAfterBottom, landlord.ShowHand)
+		assert.Equal(t, showHandAfterBottom, gs.showHandFactor())
+	})
+
+	t.Run("地主出牌后不能再明牌", func(t *testing.T) {
+		t.Parallel()
+		gs := newDoublingSession(t)
+		gs.landlordPlays = 1
+		for _, p := range gs.players {
+			assert.ErrorIs(t, gs.HandleShowHand(p.ID), apperrors.ErrCannotShowHand)
+		}
+		assert.Equal(t, 1, gs.showHandFactor())
+	})
+}
+
+func TestGameSession_Spectator(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	spectator := testutil.NewSimpleClient("s1", "Spectator1")
+	r.AddSpectator(spectator)
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	t.Cleanup(gs.StopAllTimers)
+	gs.Start()
+
+	// 观战者收到叫地主通知，但收不到任何人的发牌
+	require.NotEmpty(t, spectator.SentMessages())
+	for _, msg := range spectator.SentMessages() {
+		assert.NotEqual(t, protocol.MsgDealCards, msg.Type)
+	}
+
+	// 叫地主阶段：没有手牌，底牌不揭晓
+	sm := NewSessionManager()
+	dto := gs.BuildSpectatorStateDTO(sm)
+	assert.Equal(t, "bidding", dto.Phase)
+	assert.Empty(t, dto.Hand)
+	require.Len(t, dto.BottomCards, 3)
+	assert.Zero(t, dto.BottomCards[0].Rank)
+	for _, p := range dto.Players {
+		assert.Equal(t, 17, p.CardsCount)
+	}
+
+	// 出牌阶段：底牌公开，依旧没有手牌
+	for i := range 3 {
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, i == 0))
+	}
+	dto = gs.BuildSpectatorStateDTO(sm)
+	assert.Equal(t, "playing", dto.Phase)
+	assert.Empty(t, dto.Hand)
+	assert.Equal(t, convert.CardsToInfos(gs.bottomCards), dto.BottomCards)
+
+	// 延迟公开的手牌包含所有玩家
+	hands := gs.playerHands()
+	require.Len(t, hands, 3)
+	for i, h := range hands {
+		assert.Equal(t, gs.players[i].ID, h.PlayerID)
+		assert.Len(t, h.Cards, len(gs.players[i].Hand))
+	}
+}
+
 func TestHandlePlayCards_Success(t *testing.T) {
 	t.Parallel()
 
@@ -209,7 +465,7 @@ func TestHandlePlayCards_Success(t *testing.T) {
 		convert.CardToInfo(gs.players[0].Hand[2]),
 	}
 
-	err := gs.HandlePlayCards("p1", cardsToPlay)
+	err := gs.HandlePlayCards("p1", cardsToPlay, rule.HandReading{})
 	require.NoError(t, err)
 
 	// Verify cards were removed
@@ -234,7 +490,7 @@ func TestHandlePlayCards_NotYourTurn(t *testing.T) {
 	gs.mu.Unlock()
 
 	// Try to play with wrong player
-	err := gs.HandlePlayCards("p2", []protocol.CardInfo{})
+	err := gs.HandlePlayCards("p2", []protocol.CardInfo{}, rule.HandReading{})
 	assert.ErrorIs(t, err, apperrors.ErrNotYourTurn)
 }
 
@@ -263,7 +519,7 @@ func TestHandlePlayCards_InvalidCards(t *testing.T) {
 		{Suit: int(card.Heart), Rank: int(card.RankA), Color: int(card.Red)},
 	}
 
-	err := gs.HandlePlayCards("p1", invalidCards)
+	err := gs.HandlePlayCards("p1", invalidCards, rule.HandReading{})
 	assert.ErrorIs(t, err, apperrors.ErrInvalidCards)
 }
 
@@ -433,6 +689,50 @@ func TestFinalMultiplierAndScores(t *testing.T) {
 		gs.farmerPlays = 3
 		mult := gs.finalMultiplier(gs.players[0])
 		assert.Equal(t, 8, mult) // 2 × 2 × 2
+		assert.Equal(t, &protocol.MultiplierBreakdown{Base: 2, ShowHand: 1, BottomBonus: 1, BombCount: 2},
+			gs.multiplierBreakdown(gs.players[0]))
+	})
+
+	t.Run("底牌翻倍取最高的一项", func(t *testing.T) {
+		t.Parallel()
+		gs := newSession()
+		gs.gameConfig.BottomBonus = config.BottomBonusConfig{Joker: 2, Pair: 2, Straight: 3, Flush: 4}
+		gs.bottomCards = []card.Card{
+			{Suit: card.Club, Rank: card.Rank5}, {Suit: card.Club, Rank: card.Rank6}, {Suit: card.Club, Rank: card.Rank7},
+		}
+		gs.evalBottomBonus()
+		assert.Equal(t, rule.BottomFlush, gs.bottomPattern)
+		assert.Equal(t, 4, gs.bottomBonus)
+		assert.Equal(t, 4, gs.landlordMultiplier())
+		gs.players[1].ShowHand = 2
+		assert.Equal(t, 8, gs.landlordMultiplier(), "定地主后的底倍计入明牌")
+		gs.players[1].ShowHand = 0
+
+		gs.landlordPlays, gs.farmerPlays = 3, 3
+		assert.Equal(t, 4, gs.finalMultiplier(gs.players[0]))
+		assert.Equal(t, 4, gs.multiplierBreakdown(gs.players[0]).BottomBonus)
+	})
+
+	t.Run("未配置底牌翻倍", func(t *testing.T) {
+		t.Parallel()
+		gs := newSession()
+		gs.bottomCards = []card.Card{
+			{Suit: card.Joker, Rank: card.RankRedJoker}, {Suit: card.Club, Rank: card.Rank6}, {Suit: card.Heart, Rank: card.Rank6},
+		}
+		gs.evalBottomBonus()
+		assert.Equal(t, rule.BottomNone, gs.bottomPattern)
+		assert.Equal(t, 1, gs.bottomBonus)
+	})
+
+	t.Run("抢地主次数单独列出", func(t *testing.T) {
+		t.Parallel()
+		gs := newSession()
+		gs.bidMultiplier, gs.grabCount = 4, 2 // 叫地主后被抢两次
+		gs.landlordPlays, gs.farmerPlays = 3, 3
+		b := gs.multiplierBreakdown(gs.players[0])
+		assert.Equal(t, 1, b.Base)
+		assert.Equal(t, 2, b.GrabCount)
+		assert.Equal(t, 4, breakdownTotal(b))
 	})
 
 	t.Run("春天翻倍", func(t *testing.T) {
@@ -443,6 +743,8 @@ func TestFinalMultiplierAndScores(t *testing.T) {
 		gs.farmerPlays = 0 // 农民一张未出
 		mult := gs.finalMultiplier(gs.players[0])
 		assert.Equal(t, 2, mult) // 春天 ×2
+		assert.True(t, gs.multiplierBreakdown(gs.players[0]).Spring)
+		assert.False(t, gs.multiplierBreakdown(nil).Spring) // 对局未结束不计春天
 	})
 
 	t.Run("反春天翻倍", func(t *testing.T) {
@@ -465,6 +767,21 @@ func TestFinalMultiplierAndScores(t *testing.T) {
 		assert.Equal(t, -3, scores[2].Score)
 	})
 
+	t.Run("加倍按地主与每名农民单独结算", func(t *testing.T) {
+		t.Parallel()
+		gs := newSession()
+		// 地主超级加倍，p2 加倍，地主获胜，倍数 2
+		gs.doubles = []int{room.DoubleSuper, room.DoubleTwice, room.DoubleNone}
+		scores := gs.computeScores(gs.players[0], 2)
+		require.Len(t, scores, 3)
+		assert.Equal(t, -16, scores[1].Score) // 2 × 4 × 2
+		assert.Equal(t, 16, scores[1].Multiplier)
+		assert.Equal(t, -8, scores[2].Score) // 2 × 4 × 1
+		assert.Equal(t, 24, scores[0].Score) // 地主赢两家之和
+		assert.Equal(t, 24, scores[0].Multiplier)
+		assert.Equal(t, room.DoubleSuper, scores[0].Double)
+	})
+
 	t.Run("农民获胜得分", func(t *testing.T) {
 		t.Parallel()
 		gs := newSession()
@@ -506,3 +823,229 @@ func TestHandleBid_MaxRedeals_ForceLandlord(t *testing.T) {
 	}
 	assert.Equal(t, 1, landlordCount)
 }
+
+func TestHandlePlayCards_RoomRuleSet(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.RuleSet = rule.RuleSetNoKickers
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+	assert.True(t, gs.Rules().BombNoKickers)
+
+	fourWithTwo := []card.Card{
+		{Suit: card.Spade, Rank: card.Rank8, Color: card.Black},
+		{Suit: card.Heart, Rank: card.Rank8, Color: card.Red},
+		{Suit: card.Club, Rank: card.Rank8, Color: card.Black},
+		{Suit: card.Diamond, Rank: card.Rank8, Color: card.Red},
+		{Suit: card.Spade, Rank: card.Rank3, Color: card.Black},
+		{Suit: card.Spade, Rank: card.Rank5, Color: card.Black},
+	}
+
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 0
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{}
+	gs.players[0].Hand = append([]card.Card{{Suit: card.Spade, Rank: card.RankA, Color: card.Black}}, fourWithTwo...)
+	gs.mu.Unlock()
+
+	// 房规禁止炸弹带牌，四带二被拒绝
+	err := gs.HandlePlayCards("p1", convert.CardsToInfos(fourWithTwo), rule.HandReading{})
+	require.ErrorIs(t, err, apperrors.ErrInvalidCards)
+
+	// 单独的炸弹仍然合法
+	require.NoError(t, gs.HandlePlayCards("p1", convert.CardsToInfos(fourWithTwo[:4]), rule.HandReading{}))
+	gs.StopAllTimers()
+}
+
+func TestHandlePlayCards_IntendedReading(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+
+	// 33334444 可以是 4444 带 3333、3333 带 4444 两种四带两对，也可以是飞机带单
+	var cards []card.Card
+	for _, rank := range []card.Rank{card.Rank3, card.Rank4} {
+		for _, suit := range []card.Suit{card.Spade, card.Heart, card.Club, card.Diamond} {
+			cards = append(cards, card.Card{Suit: suit, Rank: rank})
+		}
+	}
+
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 0
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{}
+	gs.players[0].Hand = append([]card.Card{{Suit: card.Spade, Rank: card.RankA}}, cards...)
+	gs.mu.Unlock()
+
+	// 不存在的读法被拒绝
+	err := gs.HandlePlayCards("p1", convert.CardsToInfos(cards), rule.HandReading{Type: rule.Straight, KeyRank: card.Rank3})
+	require.ErrorIs(t, err, apperrors.ErrInvalidCards)
+
+	// 选择 3333 带 4444（默认读法是 4444 带 3333），日志记录所选的读法
+	reading := rule.HandReading{Type: rule.FourWithTwoPairs, KeyRank: card.Rank3}
+	require.NoError(t, gs.HandlePlayCards("p1", convert.CardsToInfos(cards), reading))
+	gs.mu.RLock()
+	assert.Equal(t, reading, gs.lastPlayedHand.Reading())
+	events := gs.dealEvents()
+	assert.Equal(t, reading, convert.InfoToReading(events[len(events)-1].Reading))
+	gs.mu.RUnlock()
+	gs.StopAllTimers()
+}
+
+func TestHandlePlayCards_Laizi(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.Laizi = true
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+	wild := gs.Rules().Wild
+	require.GreaterOrEqual(t, wild, card.Rank3)
+	require.LessOrEqual(t, wild, card.Rank2)
+
+	// 固定癞子为 7，便于构造牌型
+	gs.mu.Lock()
+	gs.rules = gs.rules.WithWild(card.Rank7)
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 0
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{}
+	gs.players[0].Hand = []card.Card{
+		{Suit: card.Spade, Rank: card.RankK, Color: card.Black},
+		{Suit: card.Heart, Rank: card.RankK, Color: card.Red},
+		{Suit: card.Spade, Rank: card.Rank3, Color: card.Black},
+	}
+	gs.players[1].Hand = []card.Card{
+		{Suit: card.Spade, Rank: card.RankA, Color: card.Black},
+		{Suit: card.Heart, Rank: card.Rank7, Color: card.Red},
+		{Suit: card.Spade, Rank: card.Rank4, Color: card.Black},
+	}
+	gs.players[2].Hand = []card.Card{
+		{Suit: card.Spade, Rank: card.Rank5, Color: card.Black},
+		{Suit: card.Heart, Rank: card.Rank5, Color: card.Red},
+		{Suit: card.Club, Rank: card.Rank5, Color: card.Black},
+		{Suit: card.Diamond, Rank: card.Rank5, Color: card.Red},
+		{Suit: card.Spade, Rank: card.Rank6, Color: card.Black},
+	}
+	gs.mu.Unlock()
+
+	require.NoError(t, gs.HandlePlayCards("p1", convert.CardsToInfos(gs.players[0].Hand[:2]), rule.HandReading{}))
+
+	// A + 癞子当作对 A 压过对 K
+	require.NoError(t, gs.HandlePlayCards("p2", convert.CardsToInfos(gs.players[1].Hand[:2]), rule.HandReading{}))
+	assert.Equal(t, rule.Pair, gs.lastPlayedHand.Type)
+	assert.Equal(t, card.RankA, gs.lastPlayedHand.KeyRank)
+
+	// 四张 5 是硬炸弹，翻两倍
+	require.NoError(t, gs.HandlePlayCards("p3", convert.CardsToInfos(gs.players[2].Hand[:4]), rule.HandReading{}))
+	assert.Equal(t, rule.Bomb, gs.lastPlayedHand.Type)
+	assert.False(t, gs.lastPlayedHand.Soft)
+	assert.Equal(t, 2, gs.bombCount)
+	gs.StopAllTimers()
+}
+
+func TestGameSession_TimeBank(t *testing.T) {
+	t.Parallel()
+
+	clients := []*testutil.SimpleClient{
+		testutil.NewSimpleClient("p1", "Player1"),
+		testutil.NewSimpleClient("p2", "Player2"),
+		testutil.NewSimpleClient("p3", "Player3"),
+	}
+	r := room.NewMockRoom("TEST123", clients[0])
+	r.Players["p2"] = &room.RoomPlayer{Client: clients[1], Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: clients[2], Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	cfg := config.GameConfig{TurnTimeout: 30, BidTimeout: 15, OfflineWaitTimeout: 30, TimeBank: 60, TimeBankIncrement: 5}
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), cfg)
+	t.Cleanup(gs.StopAllTimers)
+	gs.Start()
+
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 1
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{Type: rule.Single, KeyRank: card.Rank3}
+	gs.notifyPlayTurn()
+	gs.mu.Unlock()
+	assert.Equal(t, []time.Duration{time.Minute, time.Minute, time.Minute}, gs.timeBanks)
+
+	// 回合时间用完后改为消耗备用时间，不会代打
+	events := len(gs.EventLog().Events)
+	gs.handlePlayTimeout()
+	assert.Len(t, gs.EventLog().Events, events)
+	assert.True(t, gs.usingTimeBank())
+	turn, err := codec.ParsePayload[protocol.PlayTurnPayload](lastMessage(clients[1], protocol.MsgPlayTurn))
+	require.NoError(t, err)
+	assert.Equal(t, 60, turn.TimeBank)
+
+	// 重连时补发的回合通知：回合时间为 0，剩余时间都在备用时间里
+	gs.ResendTurnTo(clients[1])
+	turn, err = codec.ParsePayload[protocol.PlayTurnPayload](lastMessage(clients[1], protocol.MsgPlayTurn))
+	require.NoError(t, err)
+	assert.Zero(t, turn.Timeout)
+	assert.InDelta(t, 59, turn.TimeBank, 1)
+
+	// 用掉 20 秒备用时间后出牌：扣除已用部分再补充增量，下家收到自己的备用时间
+	gs.timerMu.Lock()
+	gs.timerStartTime = gs.timerStartTime.Add(-20 * time.Second)
+	gs.timerMu.Unlock()
+	require.NoError(t, gs.HandlePass("p2"))
+	assert.False(t, gs.usingTimeBank())
+	assert.InDelta(t, 45*time.Second, gs.timeBanks[1], float64(time.Second))
+	turn, err = codec.ParsePayload[protocol.PlayTurnPayload](lastMessage(clients[2], protocol.MsgPlayTurn))
+	require.NoError(t, err)
+	assert.Equal(t, "p3", turn.PlayerID)
+	assert.Equal(t, 60, turn.TimeBank)
+
+	// 补充增量不超过初始备用时间
+	require.NoError(t, gs.HandlePass("p3"))
+	assert.Equal(t, time.Minute, gs.timeBanks[2])
+
+	// 离线时备用时间同样暂停，快照记下剩余的部分
+	gs.handlePlayTimeout()
+	gs.PlayerOffline("p1")
+	gs.mu.Lock()
+	data := gs.snapshot()
+	gs.mu.Unlock()
+	assert.True(t, data.InTimeBank)
+	assert.InDelta(t, 60000, data.TimeBankMillis[0], 1000)
+	assert.InDelta(t, 60, gs.BuildGameStateDTO("p1", NewSessionManager()).TimeBank, 1)
+
+	// 重连后备用时间也用完：由决策引擎代打，备用时间清零，代打不补充增量
+	gs.PlayerOnline("p1")
+	gs.handlePlayTimeout()
+	last := gs.EventLog().Events[len(gs.EventLog().Events)-1]
+	assert.Equal(t, gamelog.EventPlay, last.Type)
+	assert.Equal(t, "p1", last.PlayerID)
+	assert.Zero(t, gs.timeBanks[0])
+}
+
+// lastMessage 返回客户端最近收到的指定类型消息
+func lastMessage(c *testutil.SimpleClient, typ protocol.MessageType) *protocol.Message {
+	msgs := c.SentMessages()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		if msgs[i].Type == typ {
+			return msgs[i]
+		}
+	}
+	return nil
+}
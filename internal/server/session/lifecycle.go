@@ -3,11 +3,17 @@ package session
 import (
 	"cmp"
 	"context"
+	"encoding/hex"
 	"log"
 	"math/rand/v2"
 	"slices"
 
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
@@ -17,6 +23,7 @@ import (
 func (gs *GameSession) Start() {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
 	gs.startBiddingRound()
 }
@@ -32,13 +39,23 @@ func (gs *GameSession) startBiddingRound() {
 	gs.state = GameStateBidding
 	gs.room.State = RoomStateBidding
 
-	// 随机选择第一个叫地主的玩家
-	gs.currentBidder = rand.IntN(3)
+	gs.currentBidder = gs.firstBidder()
 
 	// 通知叫地主
 	gs.notifyBidTurn()
 }
 
+// firstBidder 第一个叫地主的玩家：复式发牌由牌组指定，系列赛按局数轮换，单局随机（调用方需持有 gs.mu）
+func (gs *GameSession) firstBidder() int {
+	if gs.board != nil {
+		return gs.board.FirstBidder
+	}
+	if gs.room.Options.IsSeries() && gs.room.HandNo > 0 {
+		return (gs.room.HandNo - 1) % len(gs.players)
+	}
+	return rand.IntN(len(gs.players))
+}
+
 // dealNewRound 重置本局状态并发牌（不进入叫地主流程；调用方需持有 gs.mu）
 func (gs *GameSession) dealNewRound() {
 	// 重置叫抢与倍数状态
@@ -47,34 +64,91 @@ func (gs *GameSession) dealNewRound() {
 	gs.bidPasses = 0
 	gs.grabActions = 0
 	gs.bidMultiplier = 1
+	gs.grabCount = 0
+	gs.highestBid = 0
+	gs.bidTurns = 0
+	gs.bottomPattern = rule.BottomNone
+	gs.bottomBonus = 1
+	gs.doubles = nil
 	gs.bombCount = 0
 	gs.landlordPlays = 0
 	gs.farmerPlays = 0
 	for _, p := range gs.players {
 		p.Hand = nil
 		p.IsLandlord = false
+		p.ShowHand = 0
 		if rp := gs.room.Players[p.ID]; rp != nil {
 			rp.IsLandlord = false
 		}
 	}
 
-	// 创建并洗牌
-	gs.deck = card.NewDeck()
-	gs.deck.Shuffle()
+	// 创建并洗牌（四人玩法用两副牌，二人玩法去掉 3 和 4）：
+	// 牌序由服务端种子、各玩家客户端种子与发牌轮次共同决定，结算时可据此验证。
+	// 复式赛的原始牌序由各桌共用的本局种子推导，按本桌座位轮换后发出
+	layout := gs.room.Options.Layout()
+	if gs.board = gs.injectedBoard(); gs.board != nil {
+		gs.shuffled = slices.Clone(gs.board.Base)
+		gs.deck = slices.Clone(gs.board.Deck)
+	} else {
+		gs.shuffled = fairness.Shuffle(layout.NewDeck(), gs.room.ServerSeed, gs.clientSeeds, gs.dealRound)
+		gs.deck = slices.Clone(gs.shuffled)
+	}
+
+	// 癞子玩法：每次发牌重新选癞子点数，与牌序由同一组种子推导，服务端无法事后挑选
+	if gs.room.Options.Laizi {
+		gs.rules = gs.rules.WithWild(fairness.WildRank(gs.room.ServerSeed, gs.clientSeeds, gs.dealRound, layout.WildRanks()))
+	}
+	gs.dealRound++
 
 	// 发牌
 	gs.deal()
 }
 
+// DealSource 复式赛发牌钩子：按局数与本局的流局次数返回本桌的发牌，nil 表示照常洗牌。
+// 同一局、同一流局次数须总是由同一副牌按座位轮换得到，各桌才能比较成绩
+type DealSource func(hand, redeal int) *duplicate.Board
+
+// SetDealSource 设置复式赛发牌钩子（需在 Start 之前调用）。
+// 复式赛各桌要打同一副牌，牌序只由赛事的种子推导，不采用玩家的客户端种子
+func (gs *GameSession) SetDealSource(src DealSource) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.dealSource = src
+	if src != nil {
+		gs.clientSeeds = make([]string, len(gs.players))
+	}
+}
+
+// injectedBoard 向发牌钩子取本次发牌，张数与本玩法不符时忽略（调用方需持有 gs.mu）
+func (gs *GameSession) injectedBoard() *duplicate.Board {
+	if gs.dealSource == nil {
+		return nil
+	}
+	board := gs.dealSource(gs.room.HandNo, gs.redealCount)
+	if board == nil {
+		return nil
+	}
+	if want := len(gs.room.Options.Layout().NewDeck()); len(board.Deck) != want {
+		log.Printf("⚠️ 房间 %s 复式发牌张数 %d 与玩法不符（应为 %d），改为随机洗牌", gs.room.Code, len(board.Deck), want)
+		return nil
+	}
+	return board
+}
+
 // redeal 流局（无人叫地主）重新发牌（调用方需持有 gs.mu）
-// 连续流局达到 maxRedeals 次后，重新发牌并随机强制指定地主，避免无限流局。
+// 连续流局达到 maxRedeals 次后，重新发牌并随机强制指定地主，避免无限流局；
+// 复式发牌时由牌组指定，各桌落在拿同一手牌的玩家
 func (gs *GameSession) redeal() {
 	gs.redealCount++
 
 	if gs.redealCount >= maxRedeals {
-		log.Printf("🔄 房间 %s 连续 %d 次流局，重新发牌并随机强制指定地主", gs.room.Code, gs.redealCount)
+		log.Printf("🔄 房间 %s 连续 %d 次流局，重新发牌并强制指定地主", gs.room.Code, gs.redealCount)
 		gs.dealNewRound()
-		gs.setLandlord(rand.IntN(3))
+		landlord := rand.IntN(len(gs.players))
+		if gs.board != nil {
+			landlord = gs.board.ForcedLandlord
+		}
+		gs.setLandlord(landlord)
 		return
 	}
 
@@ -84,16 +158,19 @@ func (gs *GameSession) redeal() {
 
 // deal 发牌
 func (gs *GameSession) deal() {
-	// 每人发 17 张
-	for range 17 {
-		for i := range 3 {
-			gs.players[i].Hand = append(gs.players[i].Hand, gs.deck[0])
+	layout := gs.room.Options.Layout()
+
+	// 每人发 HandSize 张（经典 17 张，四人 25 张）
+	for range layout.HandSize {
+		for _, p := range gs.players {
+			p.Hand = append(p.Hand, gs.deck[0])
 			gs.deck = gs.deck[1:]
 		}
 	}
 
-	// 剩余 3 张为底牌
-	gs.bottomCards = gs.deck
+	// 接着发底牌（经典 3 张，四人 8 张），剩余为无人使用的暗牌（仅二人玩法有）
+	gs.bottomCards = gs.deck[:layout.BottomSize]
+	gs.hiddenCards = gs.deck[layout.BottomSize:]
 
 	// 排序手牌
 	for _, p := range gs.players {
@@ -102,44 +179,71 @@ func (gs *GameSession) deal() {
 		})
 	}
 
+	hands := make([][]card.Card, len(gs.players))
+	for i, p := range gs.players {
+		hands[i] = slices.Clone(p.Hand)
+	}
+	gs.record(gamelog.Event{
+		Type:        gamelog.EventDeal,
+		Round:       gs.dealRound,
+		Hands:       hands,
+		BottomCards: slices.Clone(gs.bottomCards),
+		WildRank:    gs.rules.Wild,
+	})
+	gs.scheduleSpectatorHands()
+
 	// 发送手牌给各玩家（先不显示底牌）
 	for _, p := range gs.players {
-		rp := gs.room.Players[p.ID]
-		client := rp.Client
-		client.SendMessage(codec.MustNewMessage(protocol.MsgDealCards, protocol.DealCardsPayload{
+		gs.room.Players[p.ID].Send(codec.MustNewMessage(protocol.MsgDealCards, protocol.DealCardsPayload{
 			Cards:       convert.CardsToInfos(p.Hand),
-			BottomCards: make([]protocol.CardInfo, 3), // 暂时不显示
+			BottomCards: make([]protocol.CardInfo, len(gs.bottomCards)), // 暂时不显示
+			WildRank:    int(gs.rules.Wild),
 		}))
 	}
 }
 
+// SetOnGameOver 设置每局结束后的回调；final 表示对局结束（系列赛打满局数），此时玩家已离开房间
+func (gs *GameSession) SetOnGameOver(fn func(final bool)) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.onGameOver = fn
+}
+
 // endGame 结束游戏
 func (gs *GameSession) endGame(winner *GamePlayer) {
 	gs.state = GameStateEnded
 	gs.room.State = RoomStateEnded
 
 	// 计算最终倍数与各玩家得分
-	multiplier := gs.finalMultiplier(winner)
+	breakdown := gs.multiplierBreakdown(winner)
+	multiplier := breakdownTotal(breakdown)
 	scores := gs.computeScores(winner, multiplier)
 
-	// 收集所有玩家剩余手牌
-	playerHands := make([]protocol.PlayerHand, len(gs.players))
-	for i, p := range gs.players {
-		playerHands[i] = protocol.PlayerHand{
-			PlayerID:   p.ID,
-			PlayerName: p.Name,
-			Cards:      convert.CardsToInfos(p.Hand),
-		}
-	}
-
-	// 广播游戏结束
+	gs.record(gamelog.Event{
+		Type:       gamelog.EventGameOver,
+		PlayerID:   winner.ID,
+		Multiplier: multiplier,
+		Scores:     scores,
+		Breakdown:  breakdown,
+	})
+	gs.flushEventLog()
+
+	// 揭示本局种子后立即换上下一局的种子，承诺随结算一起下发，客户端收到后再上报下一局的种子
+	proof := gs.shuffleProof()
+	nextCommit := gs.room.RotateSeed()
+
+	// 推送含春天/反春天的最终倍数，再广播游戏结束
+	gs.broadcastMultiplier(winner)
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgGameOver, protocol.GameOverPayload{
-		WinnerID:    winner.ID,
-		WinnerName:  winner.Name,
-		IsLandlord:  winner.IsLandlord,
-		PlayerHands: playerHands,
-		Multiplier:  multiplier,
-		Scores:      scores,
+		WinnerID:       winner.ID,
+		WinnerName:     winner.Name,
+		IsLandlord:     winner.IsLandlord,
+		PlayerHands:    gs.playerHands(),
+		Multiplier:     multiplier,
+		Scores:         scores,
+		Proof:          proof,
+		Breakdown:      breakdown,
+		NextSeedCommit: nextCommit,
 	}))
 
 	role := "农民"
@@ -149,60 +253,116 @@ func (gs *GameSession) endGame(winner *GamePlayer) {
 	log.Printf("🎮 游戏结束！房间 %s，获胜者: %s (%s)，倍数: %d",
 		gs.room.Code, winner.Name, role, multiplier)
 
+	// 系列赛累计得分并公布排名；未打满局数时玩家留在房间，重新准备后开始下一局
+	standings := gs.room.FinishHand(scores)
+	if standings != nil {
+		gs.room.Broadcast(codec.MustNewMessage(protocol.MsgSeriesStandings, *standings))
+	}
+
 	// 游戏结束，解散房间
-	for _, p := range gs.players {
-		rp := gs.room.Players[p.ID]
-		if rp != nil {
-			rp.Client.SetRoom("")
+	final := standings == nil || standings.Final
+	if final {
+		for _, p := range gs.players {
+			rp := gs.room.Players[p.ID]
+			if rp != nil && rp.Client != nil {
+				rp.Client.SetRoom("")
+			}
 		}
 	}
+	if gs.onGameOver != nil {
+		gs.onGameOver(final)
+	}
 
 	// 记录游戏结果到排行榜
 	gs.recordGameResults(winner)
 }
 
-// finalMultiplier 计算本局最终倍数：底倍 × 炸弹/王炸 × 春天/反春天
+// finalMultiplier 计算本局最终倍数：底分 × 抢地主 × 明牌 × 底牌 × 炸弹/王炸 × 春天/反春天
 func (gs *GameSession) finalMultiplier(winner *GamePlayer) int {
-	mult := max(gs.bidMultiplier, 1)
+	return breakdownTotal(gs.multiplierBreakdown(winner))
+}
 
-	// 炸弹与王炸：每个翻一倍
-	for range gs.bombCount {
-		mult *= 2
+// multiplierBreakdown 统计当前的倍数构成；winner 为 nil 表示对局未结束，不计春天/反春天
+func (gs *GameSession) multiplierBreakdown(winner *GamePlayer) *protocol.MultiplierBreakdown {
+	b := &protocol.MultiplierBreakdown{
+		// 叫抢倍数 = 底分 × 2^抢地主次数
+		Base:        max(gs.bidMultiplier>>gs.grabCount, 1),
+		GrabCount:   gs.grabCount,
+		ShowHand:    gs.showHandFactor(),
+		BottomBonus: gs.bottomBonus,
+		BombCount:   gs.bombCount,
+	}
+	if winner == nil {
+		return b
 	}
 
 	// 春天：地主获胜且农民一张牌都没出过
 	// 反春天：农民获胜且地主只在首攻出过一手牌
 	switch {
 	case winner.IsLandlord && gs.farmerPlays == 0:
-		mult *= 2
+		b.Spring = true
 	case !winner.IsLandlord && gs.landlordPlays == 1:
-		mult *= 2
+		b.AntiSpring = true
 	}
+	return b
+}
 
+// breakdownTotal 按倍数构成计算总倍数
+func breakdownTotal(b *protocol.MultiplierBreakdown) int {
+	mult := max(b.Base, 1) << b.GrabCount
+	mult *= max(b.ShowHand, 1) * max(b.BottomBonus, 1)
+	// 炸弹与王炸：每个翻一倍，癞子玩法中硬炸弹翻两倍
+	mult <<= b.BombCount
+	if b.Spring || b.AntiSpring {
+		mult *= 2
+	}
 	return mult
 }
 
-// computeScores 按最终倍数计算各玩家得分（地主独自对抗两名农民）
+// broadcastMultiplier 向全桌推送当前倍数及其构成（调用方需持有 gs.mu）
+func (gs *GameSession) broadcastMultiplier(winner *GamePlayer) {
+	b := gs.multiplierBreakdown(winner)
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgMultiplierUpdate, protocol.MultiplierUpdatePayload{
+		Multiplier: breakdownTotal(b),
+		Breakdown:  b,
+	}))
+}
+
+// computeScores 按最终倍数计算各玩家得分：地主与每名农民单独结算，
+// 倍数为最终倍数 × 地主加倍 × 该农民加倍；地主的输赢为对各农民的总和
 func (gs *GameSession) computeScores(winner *GamePlayer, mult int) []protocol.PlayerScore {
-	landlordWins := winner.IsLandlord
+	landlordFactor := 1
+	for i, p := range gs.players {
+		if p.IsLandlord {
+			landlordFactor = room.DoubleFactor(gs.doubleLevel(i))
+		}
+	}
+
+	mults := make([]int, len(gs.players))
+	landlordMult := 0
+	for i, p := range gs.players {
+		if !p.IsLandlord {
+			mults[i] = mult * landlordFactor * room.DoubleFactor(gs.doubleLevel(i))
+			landlordMult += mults[i]
+		}
+	}
+
 	scores := make([]protocol.PlayerScore, len(gs.players))
 	for i, p := range gs.players {
-		var score int
-		switch {
-		case p.IsLandlord && landlordWins:
-			score = 2 * mult
-		case p.IsLandlord && !landlordWins:
-			score = -2 * mult
-		case !p.IsLandlord && landlordWins:
-			score = -mult
-		default: // 农民获胜
-			score = mult
+		if p.IsLandlord {
+			mults[i] = landlordMult
+		}
+		score := mults[i]
+		if p.IsLandlord != winner.IsLandlord {
+			score = -score
 		}
 		scores[i] = protocol.PlayerScore{
 			PlayerID:   p.ID,
 			PlayerName: p.Name,
 			IsLandlord: p.IsLandlord,
 			Score:      score,
+			Multiplier: mults[i],
+			Double:     gs.doubleLevel(i),
 		}
 	}
 	return scores
@@ -220,10 +380,10 @@ func (gs *GameSession) recordGameResults(winner *GamePlayer) {
 	landlordWins := winner.IsLandlord
 
 	for _, p := range gs.players {
-		rp := gs.room.Players[p.ID]
-		if rp != nil && rp.Client.IsBot() {
+		if p.IsBot {
 			continue // Bot 不计入排行榜
 		}
+		rp := gs.room.Players[p.ID]
 
 		isWinner := false
 		if landlordWins {
@@ -234,7 +394,7 @@ func (gs *GameSession) recordGameResults(winner *GamePlayer) {
 
 		// 获取玩家名称
 		playerName := p.Name
-		if rp != nil {
+		if rp != nil && rp.Client != nil {
 			playerName = rp.Client.GetName()
 		}
 
@@ -244,3 +404,22 @@ func (gs *GameSession) recordGameResults(winner *GamePlayer) {
 		}
 	}
 }
+
+// shuffleProof 揭示本局洗牌的服务端种子与完整牌序，供玩家对照开局承诺验证发牌；
+// 复式发牌时揭示轮换前的原始牌序与本桌的轮换座位数
+func (gs *GameSession) shuffleProof() *protocol.ShuffleProof {
+	if gs.shuffled == nil {
+		return nil
+	}
+	proof := &protocol.ShuffleProof{
+		Mode:        gs.room.Options.Mode,
+		ServerSeed:  hex.EncodeToString(gs.room.ServerSeed),
+		ClientSeeds: gs.clientSeeds,
+		Round:       gs.dealRound - 1,
+		Deck:        convert.CardsToInfos(gs.shuffled),
+	}
+	if gs.board != nil {
+		proof.Shift = gs.board.Shift
+	}
+	return proof
+}
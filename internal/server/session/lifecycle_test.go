@@ -1,12 +1,23 @@
 package session
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/fairness"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
 	"github.com/palemoky/fight-the-landlord/internal/server/storage"
 	"github.com/palemoky/fight-the-landlord/internal/testutil"
 )
@@ -155,3 +166,300 @@ func TestGameSession_PlayerOfflineHandling(t *testing.T) {
 	assert.True(t, gs.players[0].IsOffline)
 	gs.mu.RUnlock()
 }
+
+func TestStartGame_FourPlayerMode(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	for i, id := range []string{"p2", "p3", "p4"} {
+		r.Players[id] = &room.RoomPlayer{Client: testutil.NewSimpleClient(id, "Player"+id), Seat: i + 1}
+	}
+	r.PlayerOrder = []string{"p1", "p2", "p3", "p4"}
+	r.Options.Mode = room.ModeFour
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+
+	// 两副牌：每人 25 张，底牌 8 张
+	require.Len(t, gs.players, 4)
+	for i, p := range gs.players {
+		assert.Len(t, p.Hand, 25, "Player %d should have 25 cards", i)
+	}
+	assert.Len(t, gs.bottomCards, 8)
+	assert.True(t, gs.rules.TwoDecks)
+
+	// 座位轮转覆盖四人
+	assert.Equal(t, 3, gs.nextSeat(2))
+	assert.Equal(t, 0, gs.nextSeat(3))
+
+	// 地主对抗三名农民
+	gs.players[0].IsLandlord = true
+	scores := gs.computeScores(gs.players[0], 2)
+	require.Len(t, scores, 4)
+	assert.Equal(t, 6, scores[0].Score)
+	for _, s := range scores[1:] {
+		assert.Equal(t, -2, s.Score)
+	}
+}
+
+func TestStartGame_TwoPlayerMode(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.PlayerOrder = []string{"p1", "p2"}
+	r.Options.Mode = room.ModeTwo
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+
+	// 去掉 3、4 共 46 张：每人 17 张，底牌 3 张，暗牌 9 张
+	require.Len(t, gs.players, 2)
+	for _, p := range gs.players {
+		assert.Len(t, p.Hand, 17)
+	}
+	assert.Len(t, gs.bottomCards, 3)
+	assert.Len(t, gs.hiddenCards, 9)
+	for _, p := range gs.players {
+		for _, c := range p.Hand {
+			assert.Greater(t, c.Rank, card.Rank4)
+		}
+	}
+
+	// 一人不出即开始新一轮
+	gs.mu.Lock()
+	gs.state = GameStatePlaying
+	gs.currentPlayer = 1
+	gs.lastPlayerIdx = 0
+	gs.lastPlayedHand = rule.ParsedHand{Type: rule.Single, KeyRank: card.Rank5}
+	gs.mu.Unlock()
+	require.NoError(t, gs.HandlePass("p2"))
+	assert.True(t, gs.lastPlayedHand.IsEmpty())
+	assert.Equal(t, 0, gs.currentPlayer)
+
+	// 地主对抗一名农民
+	gs.players[0].IsLandlord = true
+	scores := gs.computeScores(gs.players[1], 2)
+	assert.Equal(t, -2, scores[0].Score)
+	assert.Equal(t, 2, scores[1].Score)
+}
+
+func TestEndGame_ShuffleProofVerifies(t *testing.T) {
+	t.Parallel()
+
+	c1 := testutil.NewSimpleClient("p1", "Player1")
+	r := room.NewMockRoom("TEST123", c1)
+	r.Players["p1"].ClientSeed = "seed-1"
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.Start()
+	commit := fairness.Commit(r.ServerSeed)
+
+	// 流局重发后证明应对应最后一次发牌
+	gs.mu.Lock()
+	gs.dealNewRound()
+	dealt := slices.Clone(gs.players[1].Hand)
+	gs.mu.Unlock()
+
+	gs.players[0].IsLandlord = true
+	gs.endGame(gs.players[0])
+
+	msgs := c1.SentMessages()
+	last := msgs[len(msgs)-1]
+	require.Equal(t, protocol.MsgGameOver, last.Type)
+	payload, err := codec.ParsePayload[protocol.GameOverPayload](last)
+	require.NoError(t, err)
+	proof := payload.Proof
+	require.NotNil(t, proof)
+	assert.Equal(t, []string{"seed-1", "", ""}, proof.ClientSeeds)
+	assert.Equal(t, 1, proof.Round)
+
+	deck := convert.InfosToCards(proof.Deck)
+	layout := r.Options.Layout()
+	require.NoError(t, fairness.Verify(commit, proof.ServerSeed, proof.ClientSeeds, proof.Round, layout.NewDeck(), deck))
+	assert.ElementsMatch(t, dealt, fairness.HandOf(deck, len(gs.players), layout.HandSize, 1))
+
+	// 结算后换上下一局的种子，旧的客户端种子作废
+	assert.Equal(t, fairness.Commit(r.ServerSeed), payload.NextSeedCommit)
+	assert.NotEqual(t, commit, payload.NextSeedCommit)
+	assert.Empty(t, r.Players["p1"].ClientSeed)
+}
+
+func TestStartGame_DealSource(t *testing.T) {
+	t.Parallel()
+
+	c1 := testutil.NewSimpleClient("p1", "Player1")
+	r := room.NewMockRoom("TEST123", c1)
+	r.Players["p1"].ClientSeed = "ignored"
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.HandNo = 2
+
+	// 本桌相对原始牌序轮换 1 个座位，开局公布的是复式牌组本局的种子
+	layout := r.Options.Layout()
+	set := duplicate.NewSet(layout)
+	set.AddTable(r.Code, 1)
+	r.SetSeedSource(set.Seed)
+	r.ServerSeed = set.Seed(r.HandNo)
+	var calls [][2]int
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.SetDealSource(func(hand, redeal int) *duplicate.Board {
+		calls = append(calls, [2]int{hand, redeal})
+		return set.Deal(r.Code, hand, redeal)
+	})
+	gs.Start()
+
+	// 按注入的牌序轮流发牌，由牌组指定的座位先叫
+	board := set.Deal(r.Code, 2, 0)
+	for seat, p := range gs.players {
+		assert.ElementsMatch(t, fairness.HandOf(board.Deck, len(gs.players), layout.HandSize, seat), p.Hand)
+	}
+	assert.Equal(t, board.Deck[len(board.Deck)-layout.BottomSize:], card.Deck(gs.bottomCards))
+	assert.Equal(t, board.FirstBidder, gs.currentBidder)
+
+	// 流局重发时带上流局次数；连续流局后按牌组指定地主
+	gs.mu.Lock()
+	for range maxRedeals {
+		gs.redeal()
+	}
+	gs.mu.Unlock()
+	assert.Equal(t, [][2]int{{2, 0}, {2, 1}, {2, 2}, {2, 3}}, calls)
+	forced := set.Deal(r.Code, 2, maxRedeals).ForcedLandlord
+	assert.True(t, gs.players[forced].IsLandlord)
+
+	// 揭示原始牌序与轮换座位数，可对照开局承诺验证；复式桌不采用玩家的种子，也不换种子
+	gs.endGame(gs.players[forced])
+	msgs := c1.SentMessages()
+	i := slices.IndexFunc(msgs, func(m *protocol.Message) bool { return m.Type == protocol.MsgGameOver })
+	require.NotEqual(t, -1, i)
+	payload, err := codec.ParsePayload[protocol.GameOverPayload](msgs[i])
+	require.NoError(t, err)
+	proof := payload.Proof
+	require.NotNil(t, proof)
+	assert.Equal(t, []string{"", "", ""}, proof.ClientSeeds)
+	assert.Equal(t, 1, proof.Shift)
+	assert.Equal(t, maxRedeals, proof.Round)
+	deck := convert.InfosToCards(proof.Deck)
+	require.NoError(t, fairness.Verify(fairness.Commit(set.Seed(2)), proof.ServerSeed, proof.ClientSeeds, proof.Round, layout.NewDeck(), deck))
+	farmer := (forced + 1) % len(gs.players)
+	assert.ElementsMatch(t, fairness.HandOf(deck, len(gs.players), layout.HandSize, (farmer+proof.Shift)%len(gs.players)), gs.players[farmer].Hand)
+	assert.Empty(t, payload.NextSeedCommit)
+
+	// 张数不符时忽略注入，照常洗牌
+	gs2 := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs2.SetDealSource(func(int, int) *duplicate.Board { return &duplicate.Board{Deck: board.Deck[:10]} })
+	gs2.Start()
+	assert.Nil(t, gs2.board)
+	assert.NotNil(t, gs2.shuffleProof())
+}
+
+func TestEndGame_EventLog(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	saved := make(chan *gamelog.Log, 1)
+	gs.SetEventSink(gamelog.SinkFunc(func(l *gamelog.Log) error {
+		saved <- l
+		return nil
+	}))
+	gs.Start()
+
+	// 第一人叫地主，其余不抢
+	for i := range 3 {
+		bidder := gs.players[gs.currentBidder]
+		require.NoError(t, gs.HandleBid(bidder.ID, i == 0))
+	}
+	landlord := gs.players[gs.currentPlayer]
+	gs.PlayerOffline("p2")
+	gs.PlayerOnline("p2")
+	gs.endGame(landlord)
+
+	var l *gamelog.Log
+	select {
+	case l = <-saved:
+	case <-time.After(time.Second):
+		t.Fatal("对局日志未交给 Sink")
+	}
+
+	assert.Equal(t, "TEST123", l.RoomCode)
+	assert.Len(t, l.Players, 3)
+	assert.False(t, l.StartedAt.IsZero())
+
+	types := make([]gamelog.EventType, len(l.Events))
+	for i, e := range l.Events {
+		types[i] = e.Type
+		assert.Equal(t, i+1, e.Seq)
+		assert.False(t, e.Time.IsZero())
+	}
+	assert.Equal(t, []gamelog.EventType{
+		gamelog.EventDeal,
+		gamelog.EventBid, gamelog.EventBid, gamelog.EventBid,
+		gamelog.EventLandlord,
+		gamelog.EventOffline, gamelog.EventOnline,
+		gamelog.EventGameOver,
+	}, types)
+
+	deal := l.Events[0]
+	require.Len(t, deal.Hands, 3)
+	assert.Len(t, deal.Hands[0], 17)
+	assert.Len(t, deal.BottomCards, 3)
+	assert.Equal(t, landlord.ID, l.Events[4].PlayerID)
+	assert.Equal(t, landlord.ID, l.Events[len(l.Events)-1].PlayerID)
+	assert.NotEmpty(t, l.Events[len(l.Events)-1].Scores)
+}
+
+func TestEndGame_Series(t *testing.T) {
+	t.Parallel()
+
+	clients := []*testutil.SimpleClient{
+		testutil.NewSimpleClient("p1", "Player1"),
+		testutil.NewSimpleClient("p2", "Player2"),
+		testutil.NewSimpleClient("p3", "Player3"),
+	}
+	r := room.NewMockRoom("TEST123", clients[0])
+	r.Players["p2"] = &room.RoomPlayer{Client: clients[1], Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: clients[2], Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.Hands = 3
+	for _, c := range clients {
+		c.SetRoom(r.Code)
+	}
+
+	// 首叫玩家按局数轮换
+	for hand := 1; hand <= 3; hand++ {
+		r.HandNo = hand
+		gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+		gs.Start()
+		assert.Equal(t, (hand-1)%3, gs.currentBidder)
+
+		winner := gs.players[0]
+		winner.IsLandlord = true
+		gs.endGame(winner)
+		gs.StopAllTimers()
+
+		msgs := clients[1].SentMessages()
+		last := msgs[len(msgs)-1]
+		require.Equal(t, protocol.MsgSeriesStandings, last.Type)
+		standings, err := codec.ParsePayload[protocol.SeriesStandingsPayload](last)
+		require.NoError(t, err)
+		assert.Equal(t, hand, standings.Hand)
+		assert.Equal(t, "Player1", standings.Standings[0].PlayerName, "地主连赢，累计得分第一")
+
+		// 打满局数前玩家留在房间等待下一局，最后一局后离开房间
+		final := hand == 3
+		assert.Equal(t, final, standings.Final)
+		assert.Equal(t, final, clients[1].GetRoom() == "")
+		if !final {
+			assert.Equal(t, room.RoomStateWaiting, r.State)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/duplicate"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+)
+
+// SetStore 设置保存对局快照的 Redis 存储，nil 表示不持久化（需在 Start 之前调用）
+func (gs *GameSession) SetStore(rs *storage.RedisStore) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	gs.store = rs
+}
+
+// persist 对局有新事件或其他状态变化时保存快照，对局结束后清除快照（调用方需持有 gs.mu）
+func (gs *GameSession) persist() {
+	if !gs.store.IsReady() || (!gs.stateDirty && len(gs.events.Events) == gs.persistedEvents) {
+		return
+	}
+	gs.persistedEvents = len(gs.events.Events)
+	gs.stateDirty = false
+
+	var data *storage.GameSessionData
+	if gs.state != GameStateEnded {
+		data = gs.snapshot()
+	}
+	gs.room.SetGameData(data)
+	gs.enqueueSave(gs.room.ToRoomData())
+}
+
+// enqueueSave 按顺序异步写入房间数据，写入期间到来的多次更新只保留最新一次
+func (gs *GameSession) enqueueSave(data *storage.RoomData) {
+	gs.saveMu.Lock()
+	defer gs.saveMu.Unlock()
+	gs.pendingSave = data
+	if gs.saving {
+		return
+	}
+	gs.saving = true
+	go gs.saveLoop()
+}
+
+// saveLoop 依次写入待保存的房间数据，直到没有新的更新
+func (gs *GameSession) saveLoop() {
+	for {
+		gs.saveMu.Lock()
+		data := gs.pendingSave
+		gs.pendingSave = nil
+		if data == nil {
+			gs.saving = false
+			gs.saveMu.Unlock()
+			return
+		}
+		gs.saveMu.Unlock()
+
+		if err := gs.store.SaveRoom(context.Background(), data.Code, data); err != nil {
+			log.Printf("⚠️ 房间 %s 对局快照保存失败: %v", data.Code, err)
+		}
+	}
+}
+
+// snapshot 生成对局的完整快照（调用方需持有 gs.mu）
+func (gs *GameSession) snapshot() *storage.GameSessionData {
+	players := make([]storage.GamePlayerData, len(gs.players))
+	for i, p := range gs.players {
+		players[i] = storage.GamePlayerData{
+			ID:         p.ID,
+			Name:       p.Name,
+			Seat:       p.Seat,
+			Hand:       cardsData(p.Hand),
+			IsLandlord: p.IsLandlord,
+			IsBot:      p.IsBot,
+			ShowHand:   p.ShowHand,
+			Trustee:    p.Trustee,
+		}
+	}
+	events, err := json.Marshal(gs.events.Events)
+	if err != nil {
+		log.Printf("⚠️ 房间 %s 对局事件日志序列化失败: %v", gs.room.Code, err)
+	}
+
+	return &storage.GameSessionData{
+		State:       int(gs.state),
+		Players:     players,
+		WildRank:    int(gs.rules.Wild),
+		BottomCards: cardsData(gs.bottomCards),
+		HiddenCards: cardsData(gs.hiddenCards),
+
+		ClientSeeds: slices.Clone(gs.clientSeeds),
+		DealRound:   gs.dealRound,
+		Shuffled:    cardsData(gs.shuffled),
+		Board:       boardData(gs.board),
+
+		CurrentBidder:     gs.currentBidder,
+		LandlordCaller:    gs.landlordCaller,
+		LandlordCandidate: gs.landlordCandidate,
+		BidPasses:         gs.bidPasses,
+		GrabActions:       gs.grabActions,
+		BidMultiplier:     gs.bidMultiplier,
+		GrabCount:         gs.grabCount,
+		RedealCount:       gs.redealCount,
+		HighestBid:        gs.highestBid,
+		BidTurns:          gs.bidTurns,
+
+		BottomPattern: int(gs.bottomPattern),
+		BottomBonus:   gs.bottomBonus,
+		Doubles:       slices.Clone(gs.doubles),
+		BombCount:     gs.bombCount,
+		LandlordPlays: gs.landlordPlays,
+		FarmerPlays:   gs.farmerPlays,
+
+		CurrentPlayer:     gs.currentPlayer,
+		LastPlayedHand:    handData(gs.lastPlayedHand),
+		LastPlayerIdx:     gs.lastPlayerIdx,
+		ConsecutivePasses: gs.consecutivePasses,
+
+		RemainingMillis: gs.remainingTimer().Milliseconds(),
+		TimeBankMillis:  gs.timeBankMillis(),
+		InTimeBank:      gs.usingTimeBank(),
+		Events:          events,
+	}
+}
+
+// timeBankMillis 按座位排列的剩余备用时间（毫秒），未启用时为 nil
+func (gs *GameSession) timeBankMillis() []int64 {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+
+	if gs.timeBanks == nil {
+		return nil
+	}
+	millis := make([]int64, len(gs.timeBanks))
+	for i, bank := range gs.timeBanks {
+		millis[i] = bank.Milliseconds()
+	}
+	return millis
+}
+
+// remainingTimer 当前回合计时器的剩余时间；计时暂停（当前玩家离线）时为暂停时的剩余时间
+func (gs *GameSession) remainingTimer() time.Duration {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+	return gs.timerRemaining()
+}
+
+// timerRemaining 同 remainingTimer（调用方需持有 gs.timerMu）
+func (gs *GameSession) timerRemaining() time.Duration {
+	if gs.turnTimer == nil {
+		return gs.remainingTime
+	}
+	return max(time.Until(gs.timerStartTime.Add(gs.remainingTime)), 0)
+}
+
+// RestoreGameSession 由快照重建对局。恢复后所有玩家都视为离线，调用 Resume 重新开始计时。
+// 机器人不会重连，恢复后改为托管，轮到时立即代打而不是等满离线等待或回合超时
+func RestoreGameSession(r *room.Room, data *storage.GameSessionData, lb *storage.LeaderboardManager, gameCfg config.GameConfig) *GameSession {
+	players := make([]*GamePlayer, len(data.Players))
+	for i, p := range data.Players {
+		players[i] = &GamePlayer{
+			ID:         p.ID,
+			Name:       p.Name,
+			Seat:       p.Seat,
+			Hand:       cardsFromData(p.Hand),
+			IsLandlord: p.IsLandlord,
+			IsOffline:  true,
+			IsBot:      p.IsBot,
+			ShowHand:   p.ShowHand,
+			Trustee:    p.Trustee || p.IsBot,
+		}
+	}
+
+	gs := &GameSession{
+		room:        r,
+		leaderboard: lb,
+		gameConfig:  gameCfg,
+		state:       GameState(data.State),
+		players:     players,
+		rules:       r.Rules().WithWild(card.Rank(data.WildRank)),
+		bottomCards: cardsFromData(data.BottomCards),
+		hiddenCards: cardsFromData(data.HiddenCards),
+
+		clientSeeds: data.ClientSeeds,
+		dealRound:   data.DealRound,
+		shuffled:    cardsFromData(data.Shuffled),
+		board:       boardFromData(data.Board, data.Shuffled),
+
+		currentBidder:     data.CurrentBidder,
+		landlordCaller:    data.LandlordCaller,
+		landlordCandidate: data.LandlordCandidate,
+		bidPasses:         data.BidPasses,
+		grabActions:       data.GrabActions,
+		bidMultiplier:     data.BidMultiplier,
+		grabCount:         data.GrabCount,
+		redealCount:       data.RedealCount,
+		highestBid:        data.HighestBid,
+		bidTurns:          data.BidTurns,
+
+		bottomPattern: rule.BottomPattern(data.BottomPattern),
+		bottomBonus:   data.BottomBonus,
+		doubles:       data.Doubles,
+		bombCount:     data.BombCount,
+		landlordPlays: data.LandlordPlays,
+		farmerPlays:   data.FarmerPlays,
+
+		currentPlayer:     data.CurrentPlayer,
+		lastPlayedHand:    handFromData(data.LastPlayedHand),
+		lastPlayerIdx:     data.LastPlayerIdx,
+		consecutivePasses: data.ConsecutivePasses,
+
+		remainingTime: time.Duration(data.RemainingMillis) * time.Millisecond,
+		inTimeBank:    data.InTimeBank,
+	}
+	if data.TimeBankMillis != nil {
+		gs.timeBanks = make([]time.Duration, len(data.TimeBankMillis))
+		for i, ms := range data.TimeBankMillis {
+			gs.timeBanks[i] = time.Duration(ms) * time.Millisecond
+		}
+	}
+	gs.events = gs.newEventLog()
+	if err := json.Unmarshal(data.Events, &gs.events.Events); err != nil {
+		log.Printf("⚠️ 房间 %s 对局事件日志反序列化失败: %v", r.Code, err)
+	}
+	if len(gs.events.Events) > 0 {
+		gs.events.StartedAt = gs.events.Events[0].Time
+	}
+	gs.persistedEvents = len(gs.events.Events)
+	return gs
+}
+
+// cardsData 把牌转换为快照中的存储格式
+func cardsData(cards []card.Card) []storage.CardData {
+	if cards == nil {
+		return nil
+	}
+	data := make([]storage.CardData, len(cards))
+	for i, c := range cards {
+		data[i] = storage.CardData{Suit: int(c.Suit), Rank: int(c.Rank), Color: int(c.Color)}
+	}
+	return data
+}
+
+// cardsFromData 由快照中的存储格式还原牌
+func cardsFromData(data []storage.CardData) []card.Card {
+	if data == nil {
+		return nil
+	}
+	cards := make([]card.Card, len(data))
+	for i, c := range data {
+		cards[i] = card.Card{Suit: card.Suit(c.Suit), Rank: card.Rank(c.Rank), Color: card.CardColor(c.Color)}
+	}
+	return cards
+}
+
+// handData 把已解析的一手牌转换为快照中的存储格式
+func handData(h rule.ParsedHand) storage.HandData {
+	return storage.HandData{Type: int(h.Type), KeyRank: int(h.KeyRank), Length: h.Length, Cards: cardsData(h.Cards), Soft: h.Soft}
+}
+
+// handFromData 由快照中的存储格式还原一手牌
+func handFromData(d storage.HandData) rule.ParsedHand {
+	return rule.ParsedHand{Type: rule.HandType(d.Type), KeyRank: card.Rank(d.KeyRank), Length: d.Length, Cards: cardsFromData(d.Cards), Soft: d.Soft}
+}
+
+// boardData 把复式发牌的座位换算转换为存储格式，牌序随 Shuffled 保存
+func boardData(b *duplicate.Board) *storage.BoardData {
+	if b == nil {
+		return nil
+	}
+	return &storage.BoardData{Shift: b.Shift, FirstBidder: b.FirstBidder, ForcedLandlord: b.ForcedLandlord}
+}
+
+// boardFromData 由存储格式还原复式发牌，base 为保存的原始牌序
+func boardFromData(d *storage.BoardData, base []storage.CardData) *duplicate.Board {
+	if d == nil {
+		return nil
+	}
+	return &duplicate.Board{Base: cardsFromData(base), Shift: d.Shift, FirstBidder: d.FirstBidder, ForcedLandlord: d.ForcedLandlord}
+}
+
+// Resume 恢复对局后重新开始计时：轮到的玩家按离线等待处理，加倍阶段按剩余时间继续倒计时；
+// 托管中的玩家照常代打
+func (gs *GameSession) Resume() {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	defer gs.scheduleTrustee()
+
+	switch gs.state {
+	case GameStateBidding:
+		gs.pauseTurnForOffline(gs.currentBidder)
+	case GameStatePlaying:
+		gs.pauseTurnForOffline(gs.currentPlayer)
+	case GameStateDoubling:
+		gs.timerMu.Lock()
+		defer gs.timerMu.Unlock()
+		gs.timerStartTime = time.Now()
+		gs.turnTimer = time.AfterFunc(gs.remainingTime, func() {
+			gs.handleDoubleTimeout()
+		})
+	}
+}
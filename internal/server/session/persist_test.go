@@ -0,0 +1,236 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/palemoky/fight-the-landlord/internal/config"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
+	"github.com/palemoky/fight-the-landlord/internal/testutil"
+)
+
+func newTestStore(t *testing.T) *storage.RedisStore {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return storage.NewRedisStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+}
+
+// loadGameData 等待 Redis 中的房间快照满足 cond
+func loadGameData(t *testing.T, store *storage.RedisStore, code string, cond func(*storage.RoomData) bool) *storage.RoomData {
+	t.Helper()
+	var data *storage.RoomData
+	require.Eventually(t, func() bool {
+		var err error
+		data, err = store.LoadRoom(context.Background(), code)
+		return err == nil && data != nil && cond(data)
+	}, time.Second, 10*time.Millisecond)
+	return data
+}
+
+func TestGameSession_PersistAndRestore(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+	r.Options.Laizi = true
+
+	cfg := config.GameConfig{TurnTimeout: 30, BidTimeout: 15, OfflineWaitTimeout: 30}
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), cfg)
+	gs.SetStore(store)
+	t.Cleanup(gs.StopAllTimers)
+	gs.Start()
+
+	// 叫抢地主后地主出一张牌
+	for i := range 3 {
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, i == 0))
+	}
+	landlord := gs.players[gs.currentPlayer]
+	played := landlord.Hand[len(landlord.Hand)-1]
+	require.NoError(t, gs.HandlePlayCards(landlord.ID, convert.CardsToInfos(landlord.Hand[len(landlord.Hand)-1:]), rule.HandReading{}))
+	// 托管不产生事件，同样要保存
+	require.NoError(t, gs.SetTrustee(landlord.ID, true))
+
+	gs.mu.RLock()
+	want := gs.snapshot()
+	gs.mu.RUnlock()
+	data := loadGameData(t, store, r.Code, func(d *storage.RoomData) bool {
+		return d.GameData != nil && string(d.GameData.Events) == string(want.Events) && d.GameData.Players[landlord.Seat].Trustee
+	})
+
+	// 模拟服务重启：由 Redis 数据重建房间与对局
+	rm := room.NewRoomManager(nil, cfg)
+	restoredRoom := rm.RestoreRoom(data)
+	assert.Equal(t, r.ServerSeed, restoredRoom.ServerSeed)
+	assert.Equal(t, r.Options, restoredRoom.Options)
+	assert.True(t, restoredRoom.Players[landlord.ID].IsLandlord)
+
+	restored := RestoreGameSession(restoredRoom, data.GameData, storage.NewLeaderboardManager(nil), cfg)
+	t.Cleanup(restored.StopAllTimers)
+	got := restored.snapshot()
+	assert.InDelta(t, want.RemainingMillis, got.RemainingMillis, 1000)
+	want.RemainingMillis, got.RemainingMillis = 0, 0
+	assert.Equal(t, want, got)
+	assert.Equal(t, gs.lastPlayedHand, restored.lastPlayedHand)
+	assert.Len(t, restored.events.Events, len(gs.events.Events))
+	assert.Equal(t, gs.rules, restored.rules)
+	assert.Equal(t, played, restored.lastPlayedHand.Cards[0])
+	for _, p := range restored.players {
+		assert.True(t, p.IsOffline)
+		assert.Equal(t, p.ID == landlord.ID, p.Trustee)
+	}
+
+	// 恢复后对局可以继续：下家重连后不出
+	restored.Resume()
+	next := restored.players[restored.currentPlayer]
+	restored.PlayerOnline(next.ID)
+	require.NoError(t, restored.HandlePass(next.ID))
+	assert.NotEqual(t, next.ID, restored.players[restored.currentPlayer].ID)
+}
+
+func TestGameSession_RestoreBotActs(t *testing.T) {
+	t.Parallel()
+
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	cfg := config.GameConfig{TurnTimeout: 30, BidTimeout: 15, OfflineWaitTimeout: 30}
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), cfg)
+	t.Cleanup(gs.StopAllTimers)
+	gs.Start()
+	for i := range 3 {
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, i == 0))
+	}
+
+	// 地主的下家是机器人，地主出牌后轮到机器人时服务重启
+	gs.mu.Lock()
+	landlord := gs.players[gs.currentPlayer]
+	bot := gs.players[gs.nextSeat(gs.currentPlayer)]
+	bot.IsBot = true
+	gs.mu.Unlock()
+	require.NoError(t, gs.HandlePlayCards(landlord.ID, convert.CardsToInfos(landlord.Hand[:1]), rule.HandReading{}))
+
+	gs.mu.RLock()
+	data := gs.snapshot()
+	gs.mu.RUnlock()
+	require.Equal(t, bot.Seat, data.CurrentPlayer)
+
+	restored := RestoreGameSession(r, data, storage.NewLeaderboardManager(nil), cfg)
+	t.Cleanup(restored.StopAllTimers)
+	assert.True(t, restored.players[bot.Seat].Trustee, "机器人恢复后改为托管")
+	restored.Resume()
+
+	// 机器人按托管立即代打，不等离线等待或回合超时
+	require.Eventually(t, func() bool {
+		restored.mu.RLock()
+		defer restored.mu.RUnlock()
+		return restored.currentPlayer != bot.Seat
+	}, 3*time.Second, 20*time.Millisecond)
+}
+
+func TestGameSession_PersistClearsEndedGame(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	r := room.NewMockRoom("TEST123", testutil.NewSimpleClient("p1", "Player1"))
+	r.Players["p2"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p2", "Player2"), Seat: 1}
+	r.Players["p3"] = &room.RoomPlayer{Client: testutil.NewSimpleClient("p3", "Player3"), Seat: 2}
+	r.PlayerOrder = []string{"p1", "p2", "p3"}
+
+	gs := NewGameSession(r, storage.NewLeaderboardManager(nil), config.GameConfig{TurnTimeout: 30, BidTimeout: 15})
+	gs.SetStore(store)
+	t.Cleanup(gs.StopAllTimers)
+	gs.Start()
+	loadGameData(t, store, r.Code, func(d *storage.RoomData) bool { return d.GameData != nil })
+
+	// 地主只剩一张牌时出完，对局结束后快照被清除
+	for i := range 3 {
+		require.NoError(t, gs.HandleBid(gs.players[gs.currentBidder].ID, i == 0))
+	}
+	landlord := gs.players[gs.currentPlayer]
+	landlord.Hand = landlord.Hand[:1]
+	require.NoError(t, gs.HandlePlayCards(landlord.ID, convert.CardsToInfos(landlord.Hand), rule.HandReading{}))
+
+	data := loadGameData(t, store, r.Code, func(d *storage.RoomData) bool { return d.GameData == nil })
+	assert.Equal(t, int(room.RoomStateEnded), data.State)
+}
+
+func TestSessionManager_ReconnectAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	before := NewSessionManager()
+	before.SetStore(store)
+	session := before.CreateSession("p1", "Player1")
+	require.Eventually(t, func() bool {
+		data, err := store.LoadSession(context.Background(), "p1")
+		return err == nil && data != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// 重启后的会话管理器内存中没有该会话，从 Redis 载入
+	after := NewSessionManager()
+	after.SetStore(store)
+	assert.False(t, after.CanReconnect("wrong-token", "p1"))
+	assert.True(t, after.CanReconnect(session.ReconnectToken, "p1"))
+	assert.False(t, after.IsOnline("p1"))
+	assert.Equal(t, "Player1", after.GetSession("p1").PlayerName)
+	assert.False(t, after.CanReconnect(session.ReconnectToken, "p2"))
+}
+
+// 玩家全部准备后由房间管理器开局：会话开始时保存快照需要获取房间锁，开局不能在持有房间锁时进行
+func TestGameSession_PersistOnReadyStart(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+	cfg := config.GameConfig{TurnTimeout: 30, BidTimeout: 15, OfflineWaitTimeout: 30, RoomTimeout: 10}
+	rm := room.NewRoomManager(store, cfg)
+	started := make(chan *GameSession, 1)
+	rm.SetOnGameStart(func(r *room.Room) {
+		gs := NewGameSession(r, storage.NewLeaderboardManager(nil), cfg)
+		gs.SetStore(store)
+		t.Cleanup(gs.StopAllTimers)
+		gs.Start()
+		started <- gs
+	})
+
+	clients := []*testutil.SimpleClient{
+		testutil.NewSimpleClient("p1", "Player1"),
+		testutil.NewSimpleClient("p2", "Player2"),
+		testutil.NewSimpleClient("p3", "Player3"),
+	}
+	r, err := rm.CreateRoom(clients[0], room.RoomOptions{})
+	require.NoError(t, err)
+	for _, c := range clients[1:] {
+		_, err := rm.JoinRoom(c, r.Code)
+		require.NoError(t, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, c := range clients {
+			assert.NoError(t, rm.SetPlayerReady(c, true))
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("SetPlayerReady 开局时死锁")
+	}
+
+	<-started
+	loadGameData(t, store, r.Code, func(d *storage.RoomData) bool { return d.GameData != nil })
+}
@@ -6,16 +6,23 @@ import (
 
 	"github.com/palemoky/fight-the-landlord/internal/apperrors"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
 )
 
-// HandlePlayCards 处理出牌
-func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.CardInfo) error {
+// HandlePlayCards 处理出牌，reading 为出牌者指定的读法（一手牌有多种读法时使用），零值表示自动选择
+func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.CardInfo, reading rule.HandReading) error {
+	return gs.playCards(playerID, cardInfos, reading, false)
+}
+
+// playCards 处理出牌，auto 表示由系统代打（超时、离线或托管），代打不补充备用时间
+func (gs *GameSession) playCards(playerID string, cardInfos []protocol.CardInfo, reading rule.HandReading, auto bool) error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
 	if gs.state != GameStatePlaying {
 		return apperrors.ErrGameNotStart
@@ -34,20 +41,22 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 		return apperrors.ErrInvalidCards
 	}
 
-	// 解析牌型
-	handToPlay, err := rule.ParseHand(cards)
-	if err != nil {
+	// 解析牌型：按出牌者指定的读法解释（未指定时自动选择），非新一轮还要能打过上家
+	last := gs.lastPlayedHand
+	if gs.lastPlayerIdx == gs.currentPlayer {
+		last = rule.ParsedHand{}
+	}
+	handToPlay, valid, ok := gs.rules.ParseHandAs(cards, last, reading)
+	if !valid {
 		return apperrors.ErrInvalidCards
 	}
-
-	// 检查是否能打过上家
-	isNewRound := gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty()
-	if !isNewRound && !rule.CanBeat(handToPlay, gs.lastPlayedHand) {
+	if !ok {
 		return apperrors.ErrCannotBeat
 	}
 
-	// 所有验证通过后才取消计时器
-	gs.stopTimer()
+	// 所有验证通过后才取消计时器，玩家亲自出牌时同时取消托管
+	gs.stopPlayTimer(!auto)
+	gs.cancelTrustee(gs.currentPlayer, auto)
 
 	// 出牌成功，更新状态
 	gs.lastPlayedHand = handToPlay
@@ -55,9 +64,8 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 	gs.consecutivePasses = 0
 
 	// 累计倍数与出牌次数（用于结算）
-	if handToPlay.Type == rule.Bomb || handToPlay.Type == rule.Rocket {
-		gs.bombCount++ // 炸弹 / 王炸各翻一倍
-	}
+	doublings := gs.rules.BombDoublings(handToPlay)
+	gs.bombCount += doublings
 	if currentPlayer.IsLandlord {
 		gs.landlordPlays++
 	} else {
@@ -73,6 +81,14 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 	slices.SortFunc(sortedCards, func(a, b card.Card) int {
 		return cmp.Compare(b.Rank, a.Rank)
 	})
+	gs.record(gamelog.Event{
+		Type:     gamelog.EventPlay,
+		PlayerID: playerID,
+		Cards:    sortedCards,
+		HandType: handToPlay.Name(),
+		Reading:  convert.ReadingToInfo(handToPlay.Reading()),
+	})
+	gs.scheduleSpectatorHands()
 
 	// 广播出牌信息
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgCardPlayed, protocol.CardPlayedPayload{
@@ -80,8 +96,15 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 		PlayerName: currentPlayer.Name,
 		Cards:      convert.CardsToInfos(sortedCards), // 使用排序后的牌
 		CardsLeft:  len(currentPlayer.Hand),
-		HandType:   handToPlay.Type.String(),
+		HandType:   handToPlay.Name(),
+		Reading:    convert.ReadingToInfo(handToPlay.Reading()),
 	}))
+	if currentPlayer.ShowHand > 0 {
+		gs.broadcastHand(currentPlayer)
+	}
+	if doublings > 0 {
+		gs.broadcastMultiplier(nil)
+	}
 
 	// 检查是否获胜
 	if len(currentPlayer.Hand) == 0 {
@@ -90,7 +113,7 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 	}
 
 	// 下一个玩家
-	gs.currentPlayer = (gs.currentPlayer + 1) % 3
+	gs.currentPlayer = gs.nextSeat(gs.currentPlayer)
 	gs.notifyPlayTurn()
 
 	return nil
@@ -98,8 +121,14 @@ func (gs *GameSession) HandlePlayCards(playerID string, cardInfos []protocol.Car
 
 // HandlePass 处理不出
 func (gs *GameSession) HandlePass(playerID string) error {
+	return gs.pass(playerID, false)
+}
+
+// pass 处理不出，auto 表示由系统代打，代打不补充备用时间
+func (gs *GameSession) pass(playerID string, auto bool) error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
 	if gs.state != GameStatePlaying {
 		return apperrors.ErrGameNotStart
@@ -116,10 +145,12 @@ func (gs *GameSession) HandlePass(playerID string) error {
 		return apperrors.ErrMustPlay
 	}
 
-	// 取消超时计时器
-	gs.stopTimer()
+	// 取消超时计时器，玩家亲自不出时同时取消托管
+	gs.stopPlayTimer(!auto)
+	gs.cancelTrustee(gs.currentPlayer, auto)
 
 	gs.consecutivePasses++
+	gs.record(gamelog.Event{Type: gamelog.EventPass, PlayerID: playerID})
 
 	// 广播不出
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgPlayerPass, protocol.PlayerPassPayload{
@@ -127,15 +158,15 @@ func (gs *GameSession) HandlePass(playerID string) error {
 		PlayerName: currentPlayer.Name,
 	}))
 
-	// 如果连续两人不出，新一轮开始
-	if gs.consecutivePasses >= 2 {
+	// 其余玩家都不出，新一轮开始
+	if gs.consecutivePasses >= len(gs.players)-1 {
 		gs.lastPlayedHand = rule.ParsedHand{}
-		gs.lastPlayerIdx = (gs.currentPlayer + 1) % 3
+		gs.lastPlayerIdx = gs.nextSeat(gs.currentPlayer)
 		gs.consecutivePasses = 0
 	}
 
 	// 下一个玩家
-	gs.currentPlayer = (gs.currentPlayer + 1) % 3
+	gs.currentPlayer = gs.nextSeat(gs.currentPlayer)
 	gs.notifyPlayTurn()
 
 	return nil
@@ -183,9 +214,8 @@ func (gs *GameSession) notifyPlayTurn() {
 	// 计算是否能打过上家
 	canBeat := mustPlay // 如果必须出牌，则肯定能出（新一轮）
 	if !mustPlay {
-		// 检查是否有能打过上家的牌
-		beatingCards := rule.FindSmallestBeatingCards(player.Hand, gs.lastPlayedHand)
-		canBeat = beatingCards != nil
+		// 检查是否有能打过上家的牌：只搜同牌型与炸弹，持锁期间开销有限
+		canBeat = gs.rules.CanBeatWithHand(player.Hand, gs.lastPlayedHand)
 	}
 
 	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgPlayTurn, protocol.PlayTurnPayload{
@@ -193,6 +223,8 @@ func (gs *GameSession) notifyPlayTurn() {
 		Timeout:  gs.gameConfig.TurnTimeout,
 		MustPlay: mustPlay,
 		CanBeat:  canBeat,
+		TimeBank: gs.timeBankSeconds(gs.currentPlayer),
 	}))
 	gs.startPlayTimer()
+	gs.scheduleTrustee()
 }
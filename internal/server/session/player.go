@@ -1,10 +1,14 @@
 package session
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"log"
 	"sync"
 	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/server/storage"
 )
 
 const (
@@ -25,12 +29,22 @@ type PlayerSession struct {
 	IsOnline       bool      // 是否在线
 
 	mu sync.RWMutex
+
+	pendingWrite *sessionWrite // 等待写入 Redis 的最新会话数据
+	writing      bool          // 是否有写入协程在运行
+	writeMu      sync.Mutex
+}
+
+// sessionWrite 一次会话写入，data 为 nil 表示删除
+type sessionWrite struct {
+	data *storage.PlayerSessionData
 }
 
 // SessionManager 会话管理器
 type SessionManager struct {
 	sessions map[string]*PlayerSession // playerID -> session
 	tokens   map[string]string         // token -> playerID
+	store    *storage.RedisStore       // 会话同步写入 Redis，服务重启后仍可凭令牌重连
 	mu       sync.RWMutex
 }
 
@@ -47,11 +61,114 @@ func NewSessionManager() *SessionManager {
 	return sm
 }
 
-// CreateSession 创建新会话
-func (sm *SessionManager) CreateSession(playerID, playerName string) *PlayerSession {
+// SetStore 设置会话的 Redis 存储，nil 表示仅保存在内存中
+func (sm *SessionManager) SetStore(rs *storage.RedisStore) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.store = rs
+}
+
+// save 异步把会话写入 Redis
+func (sm *SessionManager) save(session *PlayerSession) {
+	sm.mu.RLock()
+	store := sm.store
+	sm.mu.RUnlock()
+	if !store.IsReady() {
+		return
+	}
+
+	// 持有 writeMu 时读取会话，先后两次保存入队的顺序与读到的状态一致
+	session.writeMu.Lock()
+	defer session.writeMu.Unlock()
+	session.mu.RLock()
+	data := &storage.PlayerSessionData{
+		PlayerID:       session.PlayerID,
+		PlayerName:     session.PlayerName,
+		ReconnectToken: session.ReconnectToken,
+		RoomCode:       session.RoomCode,
+		IsOnline:       session.IsOnline,
+	}
+	if !session.DisconnectedAt.IsZero() {
+		data.DisconnectedAt = session.DisconnectedAt.Unix()
+	}
+	session.mu.RUnlock()
+	session.enqueueWriteLocked(store, &sessionWrite{data: data})
+}
+
+// enqueueWriteLocked 按顺序异步写入会话，写入期间到来的多次更新只保留最新一次（调用方需持有 session.writeMu）。
+// 断线后很快重连时，离线与上线两次写入不会乱序，Redis 中不会留下过期的在线状态
+func (session *PlayerSession) enqueueWriteLocked(store *storage.RedisStore, w *sessionWrite) {
+	session.pendingWrite = w
+	if session.writing {
+		return
+	}
+	session.writing = true
+	go session.writeLoop(store)
+}
+
+// writeLoop 依次写入待保存的会话数据，直到没有新的更新
+func (session *PlayerSession) writeLoop(store *storage.RedisStore) {
+	for {
+		session.writeMu.Lock()
+		w := session.pendingWrite
+		session.pendingWrite = nil
+		if w == nil {
+			session.writing = false
+			session.writeMu.Unlock()
+			return
+		}
+		session.writeMu.Unlock()
+
+		if w.data == nil {
+			if err := store.DeleteSession(context.Background(), session.PlayerID); err != nil {
+				log.Printf("⚠️ 玩家 %s 会话删除失败: %v", session.PlayerID, err)
+			}
+			continue
+		}
+		if err := store.SaveSession(context.Background(), w.data); err != nil {
+			log.Printf("⚠️ 玩家 %s 会话保存失败: %v", w.data.PlayerID, err)
+		}
+	}
+}
+
+// loadSession 从 Redis 载入内存中没有的会话（如服务重启前创建的），载入的会话视为刚刚断线
+func (sm *SessionManager) loadSession(playerID string) *PlayerSession {
+	sm.mu.RLock()
+	store := sm.store
+	sm.mu.RUnlock()
+	if !store.IsReady() {
+		return nil
+	}
+
+	data, err := store.LoadSession(context.Background(), playerID)
+	if err != nil {
+		log.Printf("⚠️ 玩家 %s 会话读取失败: %v", playerID, err)
+		return nil
+	}
+	if data == nil || data.ReconnectToken == "" {
+		return nil
+	}
+
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
+	if session, ok := sm.sessions[playerID]; ok {
+		return session
+	}
+	session := &PlayerSession{
+		PlayerID:       data.PlayerID,
+		PlayerName:     data.PlayerName,
+		ReconnectToken: data.ReconnectToken,
+		RoomCode:       data.RoomCode,
+		DisconnectedAt: time.Now(),
+	}
+	sm.sessions[playerID] = session
+	sm.tokens[session.ReconnectToken] = playerID
+	return session
+}
 
+// CreateSession 创建新会话
+func (sm *SessionManager) CreateSession(playerID, playerName string) *PlayerSession {
+	sm.mu.Lock()
 	token := generateToken()
 
 	session := &PlayerSession{
@@ -63,7 +180,9 @@ func (sm *SessionManager) CreateSession(playerID, playerName string) *PlayerSess
 
 	sm.sessions[playerID] = session
 	sm.tokens[token] = playerID
+	sm.mu.Unlock()
 
+	sm.save(session)
 	return session
 }
 
@@ -98,6 +217,7 @@ func (sm *SessionManager) SetOffline(playerID string) {
 		session.IsOnline = false
 		session.DisconnectedAt = time.Now()
 		session.mu.Unlock()
+		sm.save(session)
 	}
 }
 
@@ -112,6 +232,7 @@ func (sm *SessionManager) SetOnline(playerID string) {
 		session.IsOnline = true
 		session.DisconnectedAt = time.Time{}
 		session.mu.Unlock()
+		sm.save(session)
 	}
 }
 
@@ -125,6 +246,7 @@ func (sm *SessionManager) SetRoom(playerID, roomCode string) {
 		session.mu.Lock()
 		session.RoomCode = roomCode
 		session.mu.Unlock()
+		sm.save(session)
 	}
 }
 
@@ -133,14 +255,31 @@ func (sm *SessionManager) DeleteSession(playerID string) {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
 
-	if session, ok := sm.sessions[playerID]; ok {
+	session, ok := sm.sessions[playerID]
+	if ok {
 		delete(sm.tokens, session.ReconnectToken)
 		delete(sm.sessions, playerID)
 	}
+	store := sm.store
+	if !store.IsReady() {
+		return
+	}
+	if !ok {
+		go func() { _ = store.DeleteSession(context.Background(), playerID) }()
+		return
+	}
+	// 删除排在该会话尚未完成的写入之后，避免被稍晚落地的旧写入重新建出来
+	session.writeMu.Lock()
+	session.enqueueWriteLocked(store, &sessionWrite{})
+	session.writeMu.Unlock()
 }
 
-// CanReconnect 检查玩家是否可以重连
+// CanReconnect 检查玩家是否可以重连；内存中没有的会话会尝试从 Redis 载入
 func (sm *SessionManager) CanReconnect(token, playerID string) bool {
+	if sm.GetSession(playerID) == nil && sm.loadSession(playerID) == nil {
+		return false
+	}
+
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
 
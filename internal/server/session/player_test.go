@@ -1,10 +1,12 @@
 package session
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSessionManager_CRUD(t *testing.T) {
@@ -233,3 +235,42 @@ func TestSessionManager_DeleteSession_NonExistent(t *testing.T) {
 	// Should not panic
 	sm.DeleteSession("non-existent")
 }
+
+func TestSessionManager_SaveOrder(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	sm := NewSessionManager()
+	sm.SetStore(store)
+	session := sm.CreateSession("p1", "Player1")
+
+	// 断线后立即重连：写入按顺序落地，Redis 中最终为在线
+	for range 20 {
+		sm.SetOffline("p1")
+		sm.SetRoom("p1", "123")
+		sm.SetOnline("p1")
+	}
+	require.Eventually(t, func() bool {
+		session.writeMu.Lock()
+		defer session.writeMu.Unlock()
+		return !session.writing
+	}, time.Second, 10*time.Millisecond)
+
+	data, err := store.LoadSession(context.Background(), "p1")
+	require.NoError(t, err)
+	require.NotNil(t, data)
+	assert.True(t, data.IsOnline)
+	assert.Zero(t, data.DisconnectedAt)
+	assert.Equal(t, "123", data.RoomCode)
+
+	// 删除排在未完成的写入之后
+	sm.SetOffline("p1")
+	sm.DeleteSession("p1")
+	require.Eventually(t, func() bool {
+		session.writeMu.Lock()
+		defer session.writeMu.Unlock()
+		return !session.writing
+	}, time.Second, 10*time.Millisecond)
+	data, err = store.LoadSession(context.Background(), "p1")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
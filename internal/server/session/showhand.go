@@ -0,0 +1,83 @@
+package session
+
+import (
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// 明牌倍数
+const (
+	showHandAtDeal      = 5 // 发牌后、定地主前明牌
+	showHandAfterBottom = 2 // 地主拿到底牌后、首次出牌前明牌
+)
+
+// HandleShowHand 处理明牌：叫地主阶段任何玩家都可明牌（×5），
+// 地主拿到底牌后到首次出牌前也可明牌（×2）。每名玩家只能明牌一次。
+func (gs *GameSession) HandleShowHand(playerID string) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	defer gs.persist()
+
+	var player *GamePlayer
+	for _, p := range gs.players {
+		if p.ID == playerID {
+			player = p
+			break
+		}
+	}
+	if player == nil || player.ShowHand > 0 {
+		return apperrors.ErrCannotShowHand
+	}
+
+	switch {
+	case gs.state == GameStateBidding:
+		player.ShowHand = showHandAtDeal
+	case player.IsLandlord && gs.landlordPlays == 0 &&
+		(gs.state == GameStateDoubling || gs.state == GameStatePlaying):
+		player.ShowHand = showHandAfterBottom
+	default:
+		return apperrors.ErrCannotShowHand
+	}
+
+	gs.record(gamelog.Event{Type: gamelog.EventShowHand, PlayerID: player.ID, Level: player.ShowHand})
+	gs.broadcastHand(player)
+	gs.broadcastMultiplier(nil)
+	return nil
+}
+
+// broadcastHand 向全桌公开明牌玩家的当前手牌
+func (gs *GameSession) broadcastHand(player *GamePlayer) {
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgHandRevealed, protocol.HandRevealedPayload{
+		PlayerID:   player.ID,
+		PlayerName: player.Name,
+		Cards:      convert.CardsToInfos(player.Hand),
+		Multiplier: player.ShowHand,
+	}))
+}
+
+// revealedHands 返回明牌玩家的当前手牌（用于重连快照）
+func (gs *GameSession) revealedHands() []protocol.PlayerHand {
+	var hands []protocol.PlayerHand
+	for _, p := range gs.players {
+		if p.ShowHand > 0 {
+			hands = append(hands, protocol.PlayerHand{
+				PlayerID:   p.ID,
+				PlayerName: p.Name,
+				Cards:      convert.CardsToInfos(p.Hand),
+			})
+		}
+	}
+	return hands
+}
+
+// showHandFactor 本局明牌倍数：有多人明牌时取最高的一个，无人明牌为 1
+func (gs *GameSession) showHandFactor() int {
+	factor := 1
+	for _, p := range gs.players {
+		factor = max(factor, p.ShowHand)
+	}
+	return factor
+}
@@ -0,0 +1,48 @@
+package session
+
+import (
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+)
+
+// BuildSpectatorStateDTO 构建观战用的游戏状态 DTO：不含任何玩家的手牌，叫地主阶段底牌不揭晓
+func (gs *GameSession) BuildSpectatorStateDTO(sessionManager *SessionManager) *protocol.GameStateDTO {
+	// 玩家 ID 不会为空，因此不会带上任何人的手牌
+	dto := gs.BuildGameStateDTO("", sessionManager)
+	if dto.Phase == GameStateBidding.String() {
+		dto.BottomCards = make([]protocol.CardInfo, len(dto.BottomCards))
+	}
+	return dto
+}
+
+// SpectatorHandDelay 返回各家手牌对观战者延迟公开的秒数，0 表示不公开
+func (gs *GameSession) SpectatorHandDelay() int {
+	return gs.gameConfig.SpectatorHandDelay
+}
+
+// scheduleSpectatorHands 手牌变化后，按配置的延迟把此刻各家手牌推送给观战者（调用方需持有 gs.mu）
+func (gs *GameSession) scheduleSpectatorHands() {
+	delay := gs.gameConfig.SpectatorHandDelayDuration()
+	if delay <= 0 {
+		return
+	}
+	msg := codec.MustNewMessage(protocol.MsgSpectatorHands, protocol.SpectatorHandsPayload{Hands: gs.playerHands()})
+	r := gs.room
+	time.AfterFunc(delay, func() { r.BroadcastSpectators(msg) })
+}
+
+// playerHands 收集所有玩家当前手牌（调用方需持有 gs.mu）
+func (gs *GameSession) playerHands() []protocol.PlayerHand {
+	hands := make([]protocol.PlayerHand, len(gs.players))
+	for i, p := range gs.players {
+		hands[i] = protocol.PlayerHand{
+			PlayerID:   p.ID,
+			PlayerName: p.Name,
+			Cards:      convert.CardsToInfos(p.Hand),
+		}
+	}
+	return hands
+}
@@ -4,9 +4,8 @@ import (
 	"log"
 	"time"
 
-	"github.com/palemoky/fight-the-landlord/internal/game/rule"
-	"github.com/palemoky/fight-the-landlord/internal/protocol"
-	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 )
 
 // --- 超时控制 ---
@@ -15,16 +14,58 @@ func (gs *GameSession) startBidTimer() {
 	gs.timerMu.Lock()
 	defer gs.timerMu.Unlock()
 
-	bidTimeout := gs.gameConfig.BidTimeoutDuration()
+	bidTimeout := gs.bidTimeout()
 	gs.timerStartTime = time.Now()
 	gs.remainingTime = bidTimeout
 	gs.turnTimer = time.AfterFunc(bidTimeout, func() {
-		// 超时自动不叫
-		currentPlayer := gs.players[gs.currentBidder]
-		_ = gs.HandleBid(currentPlayer.ID, false)
+		gs.handleBidTimeout()
 	})
 }
 
+// handleBidTimeout 叫地主超时，自动不叫
+func (gs *GameSession) handleBidTimeout() {
+	gs.mu.Lock()
+	if gs.state != GameStateBidding {
+		gs.mu.Unlock()
+		return
+	}
+	playerID := gs.players[gs.currentBidder].ID
+	gs.record(gamelog.Event{Type: gamelog.EventTimeout, PlayerID: playerID, Phase: GameStateBidding.String()})
+	gs.mu.Unlock()
+
+	_ = gs.handleBid(playerID, false, true)
+}
+
+func (gs *GameSession) startDoubleTimer() {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+
+	doubleTimeout := gs.gameConfig.DoubleTimeoutDuration()
+	gs.timerStartTime = time.Now()
+	gs.remainingTime = doubleTimeout
+	gs.turnTimer = time.AfterFunc(doubleTimeout, func() {
+		gs.handleDoubleTimeout()
+	})
+}
+
+// handleDoubleTimeout 加倍超时，尚未选择的玩家自动不加倍
+func (gs *GameSession) handleDoubleTimeout() {
+	gs.mu.Lock()
+	if gs.state != GameStateDoubling {
+		gs.mu.Unlock()
+		return
+	}
+	pending := gs.pendingDoubles()
+	for _, playerID := range pending {
+		gs.record(gamelog.Event{Type: gamelog.EventTimeout, PlayerID: playerID, Phase: GameStateDoubling.String()})
+	}
+	gs.mu.Unlock()
+
+	for _, playerID := range pending {
+		_ = gs.handleDouble(playerID, room.DoubleNone, true)
+	}
+}
+
 func (gs *GameSession) startPlayTimer() {
 	gs.timerMu.Lock()
 	defer gs.timerMu.Unlock()
@@ -32,11 +73,13 @@ func (gs *GameSession) startPlayTimer() {
 	turnTimeout := gs.gameConfig.TurnTimeoutDuration()
 	gs.timerStartTime = time.Now()
 	gs.remainingTime = turnTimeout
+	gs.inTimeBank = false
 	gs.turnTimer = time.AfterFunc(turnTimeout, func() {
 		gs.handlePlayTimeout()
 	})
 }
 
+// handlePlayTimeout 出牌超时：还有备用时间时继续计时，备用时间也用完后由决策引擎按玩家身份（地主/农民）代打
 func (gs *GameSession) handlePlayTimeout() {
 	gs.mu.Lock()
 
@@ -44,25 +87,83 @@ func (gs *GameSession) handlePlayTimeout() {
 		gs.mu.Unlock()
 		return
 	}
+	if gs.drawTimeBank() {
+		gs.mu.Unlock()
+		return
+	}
 
 	currentPlayer := gs.players[gs.currentPlayer]
+	gs.record(gamelog.Event{Type: gamelog.EventTimeout, PlayerID: currentPlayer.ID, Phase: GameStatePlaying.String()})
+	action := gs.prepareAutoAction(gs.currentPlayer)
+	gs.mu.Unlock()
 
-	// 尝试找到最小能打过的牌
-	cardsToPlay := rule.FindSmallestBeatingCards(currentPlayer.Hand, gs.lastPlayedHand)
+	_ = gs.performAutoAction(action)
+}
 
-	if cardsToPlay != nil {
-		// 找到了能打的牌，出牌
-		playerID := currentPlayer.ID
-		cardInfos := convert.CardsToInfos(cardsToPlay)
-		gs.mu.Unlock()
-		_ = gs.HandlePlayCards(playerID, cardInfos)
-		return
+// drawTimeBank 基础出牌时间耗尽后改为消耗当前玩家的备用时间；
+// 已在消耗备用时间或备用时间已用完时返回 false（调用方需持有 gs.mu）
+func (gs *GameSession) drawTimeBank() bool {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+
+	if gs.timeBanks == nil {
+		return false
+	}
+	idx := gs.currentPlayer
+	if gs.inTimeBank || gs.timeBanks[idx] <= 0 {
+		gs.timeBanks[idx] = 0
+		gs.inTimeBank = false
+		return false
 	}
 
-	// 没有能打的牌，自动 PASS
-	playerID := currentPlayer.ID
-	gs.mu.Unlock()
-	_ = gs.HandlePass(playerID)
+	gs.inTimeBank = true
+	gs.timerStartTime = time.Now()
+	gs.remainingTime = gs.timeBanks[idx]
+	gs.turnTimer = time.AfterFunc(gs.remainingTime, func() {
+		gs.handlePlayTimeout()
+	})
+	log.Printf("⌛ 玩家 %s 出牌超时，开始消耗备用时间 (%v)", gs.players[idx].Name, gs.remainingTime)
+	return true
+}
+
+// stopPlayTimer 出牌或不出后取消计时器并结算当前玩家的备用时间：在备用时间内行动时扣除已用的部分；
+// earned 为 true（玩家亲自行动）时再补充每步增量，超时或托管代打不补充（调用方需持有 gs.mu）
+func (gs *GameSession) stopPlayTimer(earned bool) {
+	gs.timerMu.Lock()
+	if gs.timeBanks != nil {
+		idx := gs.currentPlayer
+		if gs.inTimeBank {
+			gs.timeBanks[idx] = gs.timerRemaining()
+			gs.inTimeBank = false
+		}
+		if earned {
+			gs.timeBanks[idx] = min(gs.timeBanks[idx]+gs.gameConfig.TimeBankIncrementDuration(), gs.gameConfig.TimeBankDuration())
+		}
+	}
+	gs.timerMu.Unlock()
+
+	gs.stopTimer()
+}
+
+// timeBankSeconds 玩家剩余的备用时间（秒）；正在消耗备用时间时按计时器实时计算（调用方需持有 gs.mu）
+func (gs *GameSession) timeBankSeconds(idx int) int {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+
+	if gs.timeBanks == nil {
+		return 0
+	}
+	if gs.inTimeBank && idx == gs.currentPlayer && gs.state == GameStatePlaying {
+		return int(gs.timerRemaining().Seconds())
+	}
+	return int(gs.timeBanks[idx].Seconds())
+}
+
+// usingTimeBank 当前回合计时器是否在消耗备用时间
+func (gs *GameSession) usingTimeBank() bool {
+	gs.timerMu.Lock()
+	defer gs.timerMu.Unlock()
+	return gs.inTimeBank
 }
 
 func (gs *GameSession) stopTimer() {
@@ -90,6 +191,7 @@ func (gs *GameSession) StopAllTimers() {
 func (gs *GameSession) PlayerOffline(playerID string) {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
 	// 找到玩家
 	playerIdx := -1
@@ -104,6 +206,7 @@ func (gs *GameSession) PlayerOffline(playerID string) {
 	if playerIdx == -1 {
 		return
 	}
+	gs.record(gamelog.Event{Type: gamelog.EventOffline, PlayerID: playerID})
 
 	// 检查是否是当前回合玩家
 	isBidding := gs.state == GameStateBidding && gs.currentBidder == playerIdx
@@ -112,7 +215,11 @@ func (gs *GameSession) PlayerOffline(playerID string) {
 	if !isBidding && !isPlaying {
 		return // 不是当前回合，无需暂停
 	}
+	gs.pauseTurnForOffline(playerIdx)
+}
 
+// pauseTurnForOffline 当前回合玩家离线：暂停回合计时并开始离线等待计时（调用方需持有 gs.mu）
+func (gs *GameSession) pauseTurnForOffline(playerIdx int) {
 	gs.timerMu.Lock()
 	defer gs.timerMu.Unlock()
 
@@ -125,9 +232,14 @@ func (gs *GameSession) PlayerOffline(playerID string) {
 		}
 		gs.turnTimer = nil
 	}
+	// 离线期间备用时间同样暂停，记下尚未用掉的部分
+	if gs.inTimeBank {
+		gs.timeBanks[playerIdx] = gs.remainingTime
+	}
 
 	// 启动离线等待计时器
 	offlineTimeout := gs.gameConfig.OfflineWaitTimeoutDuration()
+	playerID := gs.players[playerIdx].ID
 	gs.offlineWaitTimer = time.AfterFunc(offlineTimeout, func() {
 		gs.handleOfflineTimeout(playerID)
 	})
@@ -139,6 +251,7 @@ func (gs *GameSession) PlayerOffline(playerID string) {
 func (gs *GameSession) PlayerOnline(playerID string) {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
+	defer gs.persist()
 
 	// 找到玩家
 	playerIdx := -1
@@ -153,6 +266,16 @@ func (gs *GameSession) PlayerOnline(playerID string) {
 	if playerIdx == -1 {
 		return
 	}
+	gs.record(gamelog.Event{Type: gamelog.EventOnline, PlayerID: playerID})
+
+	// 检查是否是当前回合玩家，如果是则恢复计时器
+	// （离线等待计时器只为当前回合玩家启动，其他玩家重连时不能取消它）
+	isBidding := gs.state == GameStateBidding && gs.currentBidder == playerIdx
+	isPlaying := gs.state == GameStatePlaying && gs.currentPlayer == playerIdx
+
+	if !isBidding && !isPlaying {
+		return
+	}
 
 	gs.timerMu.Lock()
 	defer gs.timerMu.Unlock()
@@ -163,21 +286,12 @@ func (gs *GameSession) PlayerOnline(playerID string) {
 		gs.offlineWaitTimer = nil
 	}
 
-	// 检查是否是当前回合玩家，如果是则恢复计时器
-	isBidding := gs.state == GameStateBidding && gs.currentBidder == playerIdx
-	isPlaying := gs.state == GameStatePlaying && gs.currentPlayer == playerIdx
-
-	if !isBidding && !isPlaying {
-		return
-	}
-
-	// 恢复计时器
-	if gs.remainingTime > 0 {
+	// 恢复计时器（回合计时仍在运行说明掉线未被察觉，无需恢复；剩余时间耗尽时立即超时）
+	if gs.turnTimer == nil {
 		gs.timerStartTime = time.Now()
 		if isBidding {
 			gs.turnTimer = time.AfterFunc(gs.remainingTime, func() {
-				currentPlayer := gs.players[gs.currentBidder]
-				_ = gs.HandleBid(currentPlayer.ID, false)
+				gs.handleBidTimeout()
 			})
 		} else {
 			gs.turnTimer = time.AfterFunc(gs.remainingTime, func() {
@@ -207,27 +321,20 @@ func (gs *GameSession) handleOfflineTimeout(playerID string) {
 	}
 
 	log.Printf("⏰ 玩家 %s 离线超时，自动执行操作", gs.players[playerIdx].Name)
+	gs.record(gamelog.Event{Type: gamelog.EventTimeout, PlayerID: playerID, Phase: gs.state.String()})
 
-	// 根据当前状态执行自动操作
+	// 根据当前状态执行自动操作：叫地主保守处理为不叫，不替无响应的玩家当地主
 	if gs.state == GameStateBidding && gs.currentBidder == playerIdx {
 		gs.mu.Unlock()
-		_ = gs.HandleBid(playerID, false)
+		_ = gs.handleBid(playerID, false, true)
 		return
 	}
 
+	// 出牌由决策引擎代打，离线不等于直接认输
 	if gs.state == GameStatePlaying && gs.currentPlayer == playerIdx {
-		currentPlayer := gs.players[playerIdx]
-		mustPlay := gs.lastPlayerIdx == gs.currentPlayer || gs.lastPlayedHand.IsEmpty()
-
-		if mustPlay && len(currentPlayer.Hand) > 0 {
-			// 出最小的牌
-			minCard := currentPlayer.Hand[len(currentPlayer.Hand)-1]
-			gs.mu.Unlock()
-			_ = gs.HandlePlayCards(playerID, []protocol.CardInfo{convert.CardToInfo(minCard)})
-			return
-		}
+		action := gs.prepareAutoAction(playerIdx)
 		gs.mu.Unlock()
-		_ = gs.HandlePass(playerID)
+		_ = gs.performAutoAction(action)
 		return
 	}
 
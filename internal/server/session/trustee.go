@@ -0,0 +1,101 @@
+package session
+
+import (
+	"log"
+	"slices"
+	"time"
+
+	"github.com/palemoky/fight-the-landlord/internal/apperrors"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
+)
+
+// trusteeDelay 轮到托管玩家后稍等片刻再代打，让其他玩家看清每一手
+const trusteeDelay = time.Second
+
+// SetTrustee 开启或取消玩家托管，状态变化时通知全桌；开启时若正轮到该玩家则立即代打
+func (gs *GameSession) SetTrustee(playerID string, enabled bool) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	if gs.state != GameStateBidding && gs.state != GameStateDoubling && gs.state != GameStatePlaying {
+		return apperrors.ErrGameNotStart
+	}
+	idx := gs.seatOf(playerID)
+	if idx == -1 {
+		return apperrors.ErrNotInRoom
+	}
+	gs.setTrustee(idx, enabled)
+	return nil
+}
+
+// cancelTrustee 玩家亲自完成一次操作（已通过校验，auto 为 false）时取消托管；
+// 系统代为操作或未托管时什么也不做（调用方需持有 gs.mu）
+func (gs *GameSession) cancelTrustee(idx int, auto bool) {
+	if !auto {
+		gs.setTrustee(idx, false)
+	}
+}
+
+// setTrustee 更新托管状态、保存快照并广播（调用方需持有 gs.mu）
+func (gs *GameSession) setTrustee(idx int, enabled bool) {
+	p := gs.players[idx]
+	if p.Trustee == enabled {
+		return
+	}
+	p.Trustee = enabled
+	gs.stateDirty = true
+	gs.persist()
+	gs.room.Broadcast(codec.MustNewMessage(protocol.MsgTrusteeChanged, protocol.TrusteeChangedPayload{
+		PlayerID:   p.ID,
+		PlayerName: p.Name,
+		Enabled:    enabled,
+	}))
+
+	if enabled {
+		log.Printf("🤖 玩家 %s 开启托管", p.Name)
+		gs.scheduleTrustee()
+	} else {
+		log.Printf("🙋 玩家 %s 取消托管", p.Name)
+	}
+}
+
+// scheduleTrustee 当前需要操作的玩家中有人托管时安排代打（调用方需持有 gs.mu）
+func (gs *GameSession) scheduleTrustee() {
+	for _, id := range gs.actingPlayers() {
+		if gs.players[gs.seatOf(id)].Trustee {
+			time.AfterFunc(trusteeDelay, func() { gs.runTrustee(id) })
+		}
+	}
+}
+
+// actingPlayers 返回当前需要操作的玩家 ID：叫地主与出牌阶段为当前玩家，加倍阶段为尚未选择的玩家（调用方需持有 gs.mu）
+func (gs *GameSession) actingPlayers() []string {
+	switch gs.state {
+	case GameStateBidding:
+		return []string{gs.players[gs.currentBidder].ID}
+	case GameStateDoubling:
+		return gs.pendingDoubles()
+	case GameStatePlaying:
+		return []string{gs.players[gs.currentPlayer].ID}
+	default:
+		return nil
+	}
+}
+
+// runTrustee 为托管玩家代打一次；期间已取消托管或已不轮到该玩家时放弃
+func (gs *GameSession) runTrustee(playerID string) {
+	gs.mu.Lock()
+	idx := gs.seatOf(playerID)
+	if idx == -1 || !gs.players[idx].Trustee || !slices.Contains(gs.actingPlayers(), playerID) {
+		gs.mu.Unlock()
+		return
+	}
+	action := gs.prepareAutoAction(idx)
+	gs.mu.Unlock()
+
+	// 仍然失败时不做处理，回合计时器到点后会按超时处理
+	if err := gs.performAutoAction(action); err != nil {
+		log.Printf("⚠️ 玩家 %s 托管代打失败: %v", action.name, err)
+	}
+}
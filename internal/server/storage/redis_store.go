@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -18,6 +19,8 @@ const (
 
 	// 房间数据过期时间
 	roomExpiration = 2 * time.Hour
+	// 会话数据过期时间（覆盖服务重启后的重连窗口）
+	sessionExpiration = 2 * time.Hour
 )
 
 // game.RoomData 房间数据（用于 Redis 序列化）
@@ -27,7 +30,34 @@ type RoomData struct {
 	Players     []PlayerData     `json:"players"`
 	PlayerOrder []string         `json:"player_order"`
 	CreatedAt   int64            `json:"created_at"`
+	Options     RoomOptionsData  `json:"options"`
+	ServerSeed  []byte           `json:"server_seed,omitempty"` // 本局洗牌的服务端种子（恢复后结算时仍需揭示）
 	GameData    *GameSessionData `json:"game_data,omitempty"`
+
+	// 系列赛进度
+	HandNo int                  `json:"hand_no,omitempty"`
+	Series []SeriesStandingData `json:"series,omitempty"`
+
+	Tournament string `json:"tournament,omitempty"` // 所属锦标赛 ID，空表示普通房间
+}
+
+// SeriesStandingData 系列赛中一名玩家的累计成绩
+type SeriesStandingData struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	Total      int    `json:"total"`
+	Last       int    `json:"last"`
+	Wins       int    `json:"wins"`
+}
+
+// RoomOptionsData 房间玩法设置
+type RoomOptionsData struct {
+	RuleSet  string `json:"rule_set,omitempty"`
+	Laizi    bool   `json:"laizi,omitempty"`
+	Mode     string `json:"mode,omitempty"`
+	BidMode  string `json:"bid_mode,omitempty"`
+	Doubling bool   `json:"doubling,omitempty"`
+	Hands    int    `json:"hands,omitempty"`
 }
 
 // PlayerData 玩家数据
@@ -39,13 +69,85 @@ type PlayerData struct {
 	IsLandlord bool   `json:"is_landlord"`
 }
 
-// game.GameSessionData 游戏会话数据（简化版，用于恢复基本信息）
+// GameSessionData 进行中对局的完整快照，服务重启后据此原样恢复对局
 type GameSessionData struct {
-	State         int     `json:"state"`
-	CurrentPlayer int     `json:"current_player"`
-	LandlordIdx   int     `json:"landlord_idx"`
-	PlayerHands   [][]int `json:"player_hands"` // 简化为点数列表
-	BottomCards   []int   `json:"bottom_cards"`
+	State       int              `json:"state"`
+	Players     []GamePlayerData `json:"players"` // 按座位排列
+	WildRank    int              `json:"wild_rank,omitempty"`
+	BottomCards []CardData       `json:"bottom_cards"`
+	HiddenCards []CardData       `json:"hidden_cards"`
+
+	// 可验证洗牌
+	ClientSeeds []string   `json:"client_seeds"`
+	DealRound   int        `json:"deal_round"`
+	Shuffled    []CardData `json:"shuffled"`
+	Board       *BoardData `json:"board,omitempty"` // 复式发牌的座位换算，照常洗牌时为 nil
+
+	// 叫抢地主状态机
+	CurrentBidder     int `json:"current_bidder"`
+	LandlordCaller    int `json:"landlord_caller"`
+	LandlordCandidate int `json:"landlord_candidate"`
+	BidPasses         int `json:"bid_passes"`
+	GrabActions       int `json:"grab_actions"`
+	BidMultiplier     int `json:"bid_multiplier"`
+	GrabCount         int `json:"grab_count"`
+	RedealCount       int `json:"redeal_count"`
+	HighestBid        int `json:"highest_bid"`
+	BidTurns          int `json:"bid_turns"`
+
+	// 倍数计数
+	BottomPattern int   `json:"bottom_pattern"`
+	BottomBonus   int   `json:"bottom_bonus"`
+	Doubles       []int `json:"doubles,omitempty"`
+	BombCount     int   `json:"bomb_count"`
+	LandlordPlays int   `json:"landlord_plays"`
+	FarmerPlays   int   `json:"farmer_plays"`
+
+	// 出牌
+	CurrentPlayer     int      `json:"current_player"`
+	LastPlayedHand    HandData `json:"last_played_hand"`
+	LastPlayerIdx     int      `json:"last_player_idx"`
+	ConsecutivePasses int      `json:"consecutive_passes"`
+
+	RemainingMillis int64           `json:"remaining_ms"`           // 当前回合计时器剩余时间
+	TimeBankMillis  []int64         `json:"time_bank_ms,omitempty"` // 按座位排列的剩余备用时间，未启用时为空
+	InTimeBank      bool            `json:"in_time_bank,omitempty"` // 当前回合计时器是否在消耗备用时间
+	Events          json.RawMessage `json:"events"`                 // 对局事件日志（事件数组，沿用回放文件中的事件格式）
+}
+
+// GamePlayerData 对局中的玩家
+type GamePlayerData struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Seat       int        `json:"seat"`
+	Hand       []CardData `json:"hand"`
+	IsLandlord bool       `json:"is_landlord"`
+	IsBot      bool       `json:"is_bot,omitempty"`
+	ShowHand   int        `json:"show_hand,omitempty"`
+	Trustee    bool       `json:"trustee,omitempty"`
+}
+
+// CardData 一张牌
+type CardData struct {
+	Suit  int `json:"suit"`
+	Rank  int `json:"rank"`
+	Color int `json:"color"`
+}
+
+// BoardData 复式发牌中本桌相对原始牌序的座位换算（原始牌序即 Shuffled）
+type BoardData struct {
+	Shift          int `json:"shift"`
+	FirstBidder    int `json:"first_bidder"`
+	ForcedLandlord int `json:"forced_landlord"`
+}
+
+// HandData 一手已解析的牌
+type HandData struct {
+	Type    int        `json:"type"`
+	KeyRank int        `json:"key_rank"`
+	Length  int        `json:"length,omitempty"`
+	Cards   []CardData `json:"cards"`
+	Soft    bool       `json:"soft,omitempty"`
 }
 
 // RedisStore Redis 存储
@@ -187,7 +289,14 @@ func (rs *RedisStore) SaveSession(ctx context.Context, session *PlayerSessionDat
 	}
 
 	key := sessionKeyPrefix + session.PlayerID
-	return rs.client.HSet(ctx, key, data).Err()
+	pipe := rs.client.TxPipeline()
+	pipe.HSet(ctx, key, data)
+	if session.DisconnectedAt == 0 {
+		pipe.HDel(ctx, key, "disconnected_at")
+	}
+	pipe.Expire(ctx, key, sessionExpiration)
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
 // LoadSession 从 Redis 加载会话
@@ -208,6 +317,9 @@ func (rs *RedisStore) LoadSession(ctx context.Context, playerID string) (*Player
 		RoomCode:       data["room_code"],
 		IsOnline:       data["is_online"] == "1",
 	}
+	if v, ok := data["disconnected_at"]; ok {
+		session.DisconnectedAt, _ = strconv.ParseInt(v, 10, 64)
+	}
 
 	return session, nil
 }
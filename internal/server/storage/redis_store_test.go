@@ -92,3 +92,35 @@ func TestRedisStore_MatchQueue(t *testing.T) {
 	assert.Len(t, result, 1)
 	assert.Equal(t, "p2", result[0])
 }
+
+func TestRedisStore_SaveLoadSession(t *testing.T) {
+	store, mr := newTestRedisStore(t)
+	defer mr.Close()
+	ctx := context.Background()
+
+	session := &PlayerSessionData{
+		PlayerID:       "p1",
+		PlayerName:     "Alice",
+		ReconnectToken: "token",
+		RoomCode:       "123456",
+		IsOnline:       false,
+		DisconnectedAt: 1700000000,
+	}
+	assert.NoError(t, store.SaveSession(ctx, session))
+	assert.Equal(t, sessionExpiration, mr.TTL(sessionKeyPrefix+"p1"))
+
+	loaded, err := store.LoadSession(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, session, loaded)
+
+	// 重新上线后清除断线时间
+	session.IsOnline, session.DisconnectedAt = true, 0
+	assert.NoError(t, store.SaveSession(ctx, session))
+	loaded, err = store.LoadSession(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, session, loaded)
+
+	loaded, err = store.LoadSession(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, loaded)
+}
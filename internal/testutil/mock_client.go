@@ -3,6 +3,9 @@
 package testutil
 
 import (
+	"slices"
+	"sync"
+
 	"github.com/stretchr/testify/mock"
 
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
@@ -48,6 +51,8 @@ type SimpleClient struct {
 	Name     string
 	RoomCode string
 	Messages []*protocol.Message
+
+	mu sync.Mutex // 保护 RoomCode 与 Messages，房间与对局可能在其他 goroutine 中发消息
 }
 
 // NewSimpleClient 创建简单的 mock 客户端
@@ -59,15 +64,32 @@ func NewSimpleClient(id, name string) *SimpleClient {
 	}
 }
 
-func (m *SimpleClient) GetID() string                     { return m.ID }
-func (m *SimpleClient) GetName() string                   { return m.Name }
-func (m *SimpleClient) GetRoom() string                   { return m.RoomCode }
-func (m *SimpleClient) SetRoom(code string)               { m.RoomCode = code }
-func (m *SimpleClient) SendMessage(msg *protocol.Message) { m.Messages = append(m.Messages, msg) }
-func (m *SimpleClient) Close()                            {}
-func (m *SimpleClient) IsBot() bool                       { return false }
+func (m *SimpleClient) GetID() string   { return m.ID }
+func (m *SimpleClient) GetName() string { return m.Name }
+func (m *SimpleClient) Close()          {}
+func (m *SimpleClient) IsBot() bool     { return false }
+
+func (m *SimpleClient) GetRoom() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.RoomCode
+}
+
+func (m *SimpleClient) SetRoom(code string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.RoomCode = code
+}
+
+func (m *SimpleClient) SendMessage(msg *protocol.Message) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Messages = append(m.Messages, msg)
+}
 
 // SentMessages 返回已发送的消息列表（用于测试断言）
 func (m *SimpleClient) SentMessages() []*protocol.Message {
-	return m.Messages
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.Messages)
 }
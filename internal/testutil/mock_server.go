@@ -43,3 +43,7 @@ func (m *MockServer) RegisterClient(id string, client types.ClientInterface) {
 func (m *MockServer) UnregisterClient(id string) {
 	m.Called(id)
 }
+
+func (m *MockServer) RebindClient(client types.ClientInterface, id, name string) {
+	m.Called(client, id, name)
+}
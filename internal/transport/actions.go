@@ -1,6 +1,8 @@
 package transport
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"time"
 
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
@@ -9,9 +11,12 @@ import (
 
 // --- 便捷方法 ---
 
-// CreateRoom 创建房间
-func (c *Client) CreateRoom() error {
-	return c.SendMessage(codec.MustNewMessage(protocol.MsgCreateRoom, nil))
+// CreateRoom 创建房间，opts 为零值时按经典规则建房
+func (c *Client) CreateRoom(opts protocol.CreateRoomPayload) error {
+	if opts == (protocol.CreateRoomPayload{}) {
+		return c.SendMessage(codec.MustNewMessage(protocol.MsgCreateRoom, nil))
+	}
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgCreateRoom, opts))
 }
 
 // JoinRoom 加入房间
@@ -26,9 +31,31 @@ func (c *Client) LeaveRoom() error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgLeaveRoom, nil))
 }
 
-// QuickMatch 快速匹配
-func (c *Client) QuickMatch() error {
-	return c.SendMessage(codec.MustNewMessage(protocol.MsgQuickMatch, nil))
+// SetTrustee 开启/取消托管
+func (c *Client) SetTrustee(enabled bool) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgTrustee, protocol.TrusteePayload{
+		Enabled: enabled,
+	}))
+}
+
+// SpectateRoom 观战进行中的房间
+func (c *Client) SpectateRoom(roomCode string) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgSpectateRoom, protocol.SpectateRoomPayload{
+		RoomCode: roomCode,
+	}))
+}
+
+// StopSpectating 退出观战
+func (c *Client) StopSpectating() error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgStopSpectating, nil))
+}
+
+// QuickMatch 快速匹配，mode 为人数玩法模式，空表示经典三人局
+func (c *Client) QuickMatch(mode string) error {
+	if mode == "" {
+		return c.SendMessage(codec.MustNewMessage(protocol.MsgQuickMatch, nil))
+	}
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgQuickMatch, protocol.QuickMatchPayload{Mode: mode}))
 }
 
 // Ready 准备
@@ -41,6 +68,14 @@ func (c *Client) CancelReady() error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgCancelReady, nil))
 }
 
+// Rematch 对局结束后同意/拒绝在 roomCode 房间再来一局
+func (c *Client) Rematch(roomCode string, accept bool) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgRematchRequest, protocol.RematchRequestPayload{
+		RoomCode: roomCode,
+		Accept:   accept,
+	}))
+}
+
 // Bid 叫地主
 func (c *Client) Bid(bid bool) error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgBid, protocol.BidPayload{
@@ -48,10 +83,31 @@ func (c *Client) Bid(bid bool) error {
 	}))
 }
 
-// PlayCards 出牌
-func (c *Client) PlayCards(cards []protocol.CardInfo) error {
+// BidScore 叫分模式下叫分，0 表示不叫
+func (c *Client) BidScore(score int) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgBid, protocol.BidPayload{
+		Bid:   score > 0,
+		Score: score,
+	}))
+}
+
+// Double 加倍阶段选择，level 见 room.DoubleNone 等
+func (c *Client) Double(level int) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgDouble, protocol.DoublePayload{
+		Level: level,
+	}))
+}
+
+// ShowHand 明牌
+func (c *Client) ShowHand() error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgShowHand, nil))
+}
+
+// PlayCards 出牌，reading 为指定的读法（一手牌有多种读法时），零值表示由服务端选择
+func (c *Client) PlayCards(cards []protocol.CardInfo, reading protocol.HandReading) error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgPlayCards, protocol.PlayCardsPayload{
-		Cards: cards,
+		Cards:   cards,
+		Reading: reading,
 	}))
 }
 
@@ -79,9 +135,42 @@ func (c *Client) GetRoomList() error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgGetRoomList, nil))
 }
 
+// GetTournaments 获取锦标赛列表
+func (c *Client) GetTournaments() error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgGetTournaments, nil))
+}
+
+// RegisterTournament 报名（register 为 true）或退出锦标赛
+func (c *Client) RegisterTournament(tournamentID string, register bool) error {
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgTournamentRegister, protocol.TournamentRegisterPayload{
+		TournamentID: tournamentID,
+		Register:     register,
+	}))
+}
+
 // Ping 发送心跳
 func (c *Client) Ping() error {
 	return c.SendMessage(codec.MustNewMessage(protocol.MsgPing, protocol.PingPayload{
 		Timestamp: time.Now().UnixMilli(),
 	}))
 }
+
+// SendClientSeed 生成新的客户端洗牌种子并上报，服务端用它参与下一局洗牌
+func (c *Client) SendClientSeed() error {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	seed := hex.EncodeToString(buf)
+
+	c.mu.Lock()
+	c.clientSeed = seed
+	c.mu.Unlock()
+
+	return c.SendMessage(codec.MustNewMessage(protocol.MsgClientSeed, protocol.ClientSeedPayload{Seed: seed}))
+}
+
+// ClientSeed 返回最近一次上报的客户端洗牌种子
+func (c *Client) ClientSeed() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.clientSeed
+}
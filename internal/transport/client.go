@@ -33,6 +33,7 @@ type Client struct {
 	PlayerID       string
 	PlayerName     string
 	ReconnectToken string // 重连令牌
+	clientSeed     string // 最近一次上报的洗牌种子
 
 	// 网络延迟（毫秒）
 	Latency int64
@@ -90,6 +90,10 @@ func (c *Client) processMessage(msg *protocol.Message) {
 func (c *Client) handleInternalMessage(msg *protocol.Message) bool {
 	switch msg.Type {
 	case protocol.MsgConnected:
+		// 重连时新连接会先收到一份临时身份，须保留原身份与令牌用于发送重连请求
+		if c.reconnecting.Load() {
+			break
+		}
 		var payload protocol.ConnectedPayload
 		if err := payloadconv.DecodePayload(msg.Type, msg.Payload, &payload); err == nil {
 			c.PlayerID = payload.PlayerID
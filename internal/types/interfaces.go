@@ -12,6 +12,7 @@ type ServerInterface interface {
 	GetClientByID(id string) ClientInterface
 	RegisterClient(id string, client ClientInterface)
 	UnregisterClient(id string)
+	RebindClient(client ClientInterface, id, name string) // 重连时把新连接改用原玩家身份
 }
 
 // ClientInterface 定义客户端接口
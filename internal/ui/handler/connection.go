@@ -16,6 +16,11 @@ func handleMsgConnected(m model.Model, msg *protocol.Message) tea.Cmd {
 	var payload protocol.ConnectedPayload
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
 
+	// 重连中的新连接：传输层保留了原身份，等待 MsgReconnected 恢复状态
+	if payload.PlayerID != m.Client().PlayerID {
+		return nil
+	}
+
 	m.SetPlayerInfo(payload.PlayerID, payload.PlayerName)
 	m.Client().ReconnectToken = payload.ReconnectToken
 
@@ -62,9 +67,12 @@ func handleMsgReconnected(m model.Model, msg *protocol.Message) tea.Cmd {
 
 	// 用服务器快照覆盖本地状态，避免显示掉线前的过期数据
 	restoreGameState(m, payload.GameState)
-	if payload.GameState.Phase == "bidding" {
+	switch payload.GameState.Phase {
+	case "bidding":
 		m.SetPhase(model.PhaseBidding)
-	} else {
+	case "doubling":
+		m.SetPhase(model.PhaseDoubling)
+	default:
 		m.SetPhase(model.PhasePlaying)
 	}
 	return nil
@@ -90,7 +98,7 @@ func handleMsgError(m model.Model, msg *protocol.Message) tea.Cmd {
 	}
 
 	// 游戏中的错误显示在输入框
-	if m.Phase() == model.PhaseBidding || m.Phase() == model.PhasePlaying {
+	if m.Phase() == model.PhaseBidding || m.Phase() == model.PhaseDoubling || m.Phase() == model.PhasePlaying {
 		m.Input().Placeholder = payload.Message
 		return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
 			return model.ClearInputErrorMsg{}
@@ -1,14 +1,18 @@
 package handler
 
 import (
+	"cmp"
 	"fmt"
 	"math/rand/v2"
+	"slices"
 	"time"
 
 	"charm.land/bubbles/v2/timer"
 	tea "charm.land/bubbletea/v2"
 
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
 	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
@@ -46,12 +50,14 @@ func restoreGameState(m model.Model, dto *protocol.GameStateDTO) {
 
 	// 当前回合
 	st.CurrentTurn = dto.CurrentTurn
+	st.WildRank = card.Rank(dto.WildRank)
 
-	// 上家出牌（DTO 不含出牌者名字与牌型，分别从玩家列表查名、按牌重新识别牌型）
+	// 上家出牌（DTO 不含出牌者名字，从玩家列表查名）
 	st.LastPlayed = convert.InfosToCards(dto.LastPlayed)
 	st.LastPlayedBy = dto.LastPlayerID
 	st.LastPlayedName = ""
-	st.LastHandType = ""
+	st.LastHandType = dto.LastHandType
+	st.LastReading = convert.InfoToReading(dto.LastReading)
 	if len(st.LastPlayed) > 0 {
 		for _, p := range dto.Players {
 			if p.ID == dto.LastPlayerID {
@@ -59,14 +65,13 @@ func restoreGameState(m model.Model, dto *protocol.GameStateDTO) {
 				break
 			}
 		}
-		if ph, err := rule.ParseHand(st.LastPlayed); err == nil {
-			st.LastHandType = ph.Type.String()
-		}
 	}
 
 	// 记牌器：快照不含完整出牌历史，只能按可见信息尽力重建
 	// （自己的手牌、底牌(若为地主)、可见的上家牌）；后续出牌事件会继续修正。
-	st.CardCounter.Reset()
+	st.Mode = dto.Mode
+	layout, _ := room.LayoutByMode(st.Mode)
+	st.CardCounter.SetDeck(layout.NewDeck())
 	st.CardCounter.DeductCards(st.Hand)
 	if st.IsLandlord {
 		st.CardCounter.DeductCards(st.BottomCards)
@@ -75,16 +80,79 @@ func restoreGameState(m model.Model, dto *protocol.GameStateDTO) {
 		st.CardCounter.DeductCards(st.LastPlayed)
 	}
 
+	// 当前倍数
+	st.Multiplier = dto.Multiplier
+	st.Breakdown = dto.Breakdown
+
+	// 明牌玩家的手牌
+	st.RevealedHands = nil
+	for _, h := range dto.RevealedHands {
+		storeRevealedHand(st, h.PlayerID, h.Cards)
+	}
+
 	m.Game().SetMustPlay(dto.MustPlay)
+	m.Game().SetTimeBank(time.Duration(dto.TimeBank) * time.Second)
+}
+
+// storeRevealedHand 记录明牌玩家的当前手牌
+func storeRevealedHand(st *gameClient.GameState, playerID string, infos []protocol.CardInfo) {
+	if st.RevealedHands == nil {
+		st.RevealedHands = make(map[string][]card.Card)
+	}
+	cards := convert.InfosToCards(infos)
+	slices.SortFunc(cards, func(a, b card.Card) int { return cmp.Compare(b.Rank, a.Rank) })
+	st.RevealedHands[playerID] = cards
 }
 
 func handleMsgGameStart(m model.Model, msg *protocol.Message) tea.Cmd {
 	var payload protocol.GameStartPayload
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
 	m.Game().State().Players = payload.Players
+	m.Game().State().RuleSet = payload.RuleSet
+	m.Game().State().Mode = payload.Mode
+	m.Game().State().SeedCommit = payload.SeedCommit
+	m.Game().State().Doubles = nil
+	m.Game().State().RevealedHands = nil
+	m.Game().State().Breakdown = nil
+	m.Game().State().SeriesHand = payload.Hand
+	m.Game().State().SeriesHands = payload.Hands
+	if payload.Hand <= 1 {
+		m.Game().State().Standings = nil
+	}
 	// 新一局重置自己的地主标记，避免沿用上一局导致手牌区误显示地主图标
 	m.Game().State().IsLandlord = false
-	return nil
+	if m.Phase() == model.PhaseSpectating {
+		resetSpectatorRound(m.Game().State())
+	}
+	return checkSeedCommit(m, payload.SeedCommit)
+}
+
+// checkSeedCommit 开局时核对种子承诺：只有与上报种子前收到的承诺一致，自己的种子才算参与了本局洗牌
+func checkSeedCommit(m model.Model, commit string) tea.Cmd {
+	st := m.Game().State()
+	pending := st.NextSeedCommit
+	st.NextSeedCommit = ""
+	st.ClientSeed = ""
+	switch pending {
+	case "":
+		return nil
+	case commit:
+		st.ClientSeed = m.Client().ClientSeed()
+		return nil
+	}
+	m.SetNotification(model.NotifyError, "⚠️ 本局种子承诺与上报种子前公布的不一致，发牌可能不公平", true)
+	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+		return model.ClearSystemNotificationMsg{}
+	})
+}
+
+// reportClientSeed 收到房间下一局服务端种子的承诺后才上报自己的种子，服务端无法再按客户端种子挑选服务端种子
+func reportClientSeed(m model.Model, commit string) {
+	if commit == "" {
+		return
+	}
+	m.Game().State().NextSeedCommit = commit
+	_ = m.Client().SendClientSeed()
 }
 
 func handleMsgDealCards(m model.Model, msg *protocol.Message) tea.Cmd {
@@ -92,15 +160,25 @@ func handleMsgDealCards(m model.Model, msg *protocol.Message) tea.Cmd {
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
 	m.Game().State().Hand = convert.InfosToCards(payload.Cards)
 	m.Game().State().SortHand()
+	m.Game().State().WildRank = card.Rank(payload.WildRank)
 	if len(payload.BottomCards) > 0 && payload.BottomCards[0].Rank > 0 {
 		m.Game().State().BottomCards = convert.InfosToCards(payload.BottomCards)
+	} else {
+		// 底牌未揭晓说明是（重新）发牌，记下初始手牌供结算后验证
+		m.Game().State().DealtHand = slices.Clone(m.Game().State().Hand)
+		m.Game().State().RevealedHands = nil
+		m.Game().State().BottomPattern = ""
+		m.Game().State().BottomBonus = 0
+		m.Game().State().Multiplier = 0
+		m.Game().State().Breakdown = nil
 	}
 
+	layout, _ := room.LayoutByMode(m.Game().State().Mode)
 	for i := range m.Game().State().Players {
-		m.Game().State().Players[i].CardsCount = 17
+		m.Game().State().Players[i].CardsCount = layout.HandSize
 	}
 
-	m.Game().State().CardCounter.Reset()
+	m.Game().State().CardCounter.SetDeck(layout.NewDeck())
 	m.Game().State().CardCounter.DeductCards(m.Game().State().Hand)
 
 	// 新一局清空上一手出牌状态，避免跨局误判“压死”
@@ -108,6 +186,7 @@ func handleMsgDealCards(m model.Model, msg *protocol.Message) tea.Cmd {
 	m.Game().State().LastPlayedBy = ""
 	m.Game().State().LastPlayedName = ""
 	m.Game().State().LastHandType = ""
+	m.Game().State().LastReading = rule.HandReading{}
 
 	// 地主确定后服务端会再发一次 MsgDealCards 更新地主手牌（含底牌），
 	// 此时已处于 PhaseBidding，不应重复播放发牌音效。
@@ -121,18 +200,16 @@ func handleMsgDealCards(m model.Model, msg *protocol.Message) tea.Cmd {
 func handleMsgBidTurn(m model.Model, msg *protocol.Message) tea.Cmd {
 	var payload protocol.BidTurnPayload
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
-	m.SetPhase(model.PhaseBidding)
+	setGamePhase(m, model.PhaseBidding, "bidding")
 	m.Game().SetBidTurn(payload.PlayerID)
 	m.Game().SetBellPlayed(false)
 	m.Game().State().IsGrabTurn = payload.IsGrab
+	m.Game().State().BidOptions = payload.Options
 	m.Game().State().Multiplier = payload.Multiplier
 
-	action := "叫地主"
-	if payload.IsGrab {
-		action = "抢地主"
-	}
+	action := m.Game().State().BidAction()
 	if payload.PlayerID == m.PlayerID() {
-		m.Input().Placeholder = fmt.Sprintf("%s? (Y/N)", action)
+		m.Input().Placeholder = m.Game().State().BidPrompt()
 		m.Input().Focus()
 	} else {
 		for _, p := range m.Game().State().Players {
@@ -189,7 +266,8 @@ func handleMsgLandlord(m model.Model, msg *protocol.Message) tea.Cmd {
 	for i, p := range m.Game().State().Players {
 		m.Game().State().Players[i].IsLandlord = (p.ID == payload.PlayerID)
 		if p.ID == payload.PlayerID {
-			m.Game().State().Players[i].CardsCount = 20
+			layout, _ := room.LayoutByMode(m.Game().State().Mode)
+			m.Game().State().Players[i].CardsCount = layout.HandSize + layout.BottomSize
 		}
 	}
 	if payload.PlayerID == m.PlayerID() {
@@ -198,6 +276,8 @@ func handleMsgLandlord(m model.Model, msg *protocol.Message) tea.Cmd {
 	}
 	m.Game().State().IsGrabTurn = false
 	m.Game().State().Multiplier = payload.Multiplier
+	m.Game().State().BottomPattern = payload.BottomPattern
+	m.Game().State().BottomBonus = payload.BottomBonus
 
 	m.PlaySound("landlord")
 	// 地主确定、正式开打后才起背景音乐，避免与发牌声/叫牌语音重叠
@@ -205,14 +285,113 @@ func handleMsgLandlord(m model.Model, msg *protocol.Message) tea.Cmd {
 	return nil
 }
 
+func handleMsgDoubleTurn(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.DoubleTurnPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	setGamePhase(m, model.PhaseDoubling, "doubling")
+	m.Game().State().Multiplier = payload.Multiplier
+	m.Game().State().DoublePending = slices.Contains(payload.Pending, m.PlayerID())
+
+	if m.Game().State().DoublePending {
+		m.Input().Placeholder = model.DoublePrompt
+		m.Input().Focus()
+	} else {
+		m.Input().Placeholder = "等待其他玩家选择加倍..."
+		m.Input().Blur()
+	}
+	m.Game().SetTimerDuration(time.Duration(payload.Timeout) * time.Second)
+	m.Game().SetTimerStartTime(time.Now())
+	t := timer.New(m.Game().TimerDuration(), timer.WithInterval(time.Second))
+	m.SetTimer(t)
+	return t.Start()
+}
+
+func handleMsgDoubleResult(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.DoubleResultPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	state := m.Game().State()
+	if state.Doubles == nil {
+		state.Doubles = make(map[string]int)
+	}
+	state.Doubles[payload.PlayerID] = payload.Level
+
+	if payload.PlayerID == m.PlayerID() {
+		state.DoublePending = false
+		m.Input().Placeholder = "等待其他玩家选择加倍..."
+		m.Input().Blur()
+	}
+
+	if payload.Level == room.DoubleNone {
+		m.PlaySound("nodouble")
+	} else {
+		m.PlaySound("double")
+	}
+	return nil
+}
+
+// handleMsgHandRevealed 有玩家明牌或明牌玩家手牌变化时更新其手牌
+func handleMsgHandRevealed(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.HandRevealedPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	state := m.Game().State()
+	_, shown := state.RevealedHands[payload.PlayerID]
+	storeRevealedHand(state, payload.PlayerID, payload.Cards)
+
+	// 首次明牌时提示全桌，之后的手牌更新不再打扰
+	if !shown {
+		m.SetNotification(model.NotifyInfo, fmt.Sprintf("🃏 %s 明牌 ×%d", payload.PlayerName, payload.Multiplier), true)
+		m.PlaySound("reveal")
+		return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return model.ClearSystemNotificationMsg{}
+		})
+	}
+	return nil
+}
+
+// handleMsgMultiplierUpdate 同步服务端推送的当前倍数及其构成
+func handleMsgMultiplierUpdate(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.MultiplierUpdatePayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	m.Game().State().Multiplier = payload.Multiplier
+	m.Game().State().Breakdown = payload.Breakdown
+	return nil
+}
+
+// handleMsgTrusteeChanged 更新玩家托管状态，自己开关托管时给出提示
+func handleMsgTrusteeChanged(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.TrusteeChangedPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	players := m.Game().State().Players
+	for i := range players {
+		if players[i].ID == payload.PlayerID {
+			players[i].Trustee = payload.Enabled
+			break
+		}
+	}
+
+	if payload.PlayerID != m.PlayerID() {
+		return nil
+	}
+	if payload.Enabled {
+		m.SetNotification(model.NotifyInfo, "🤖 已开启托管，按 G 或亲自操作即可取消", true)
+	} else {
+		m.SetNotification(model.NotifyInfo, "🙋 已取消托管", true)
+	}
+	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+		return model.ClearSystemNotificationMsg{}
+	})
+}
+
 func handleMsgPlayTurn(m model.Model, msg *protocol.Message) tea.Cmd {
 	var payload protocol.PlayTurnPayload
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
-	m.SetPhase(model.PhasePlaying)
+	setGamePhase(m, model.PhasePlaying, "playing")
 	m.Game().State().CurrentTurn = payload.PlayerID
 	m.Game().SetMustPlay(payload.MustPlay)
 	m.Game().SetCanBeat(payload.CanBeat)
 	m.Game().SetBellPlayed(false)
+	m.Game().ClearPendingPlay()
+	m.Game().ClearHints()
 	if payload.PlayerID == m.PlayerID() {
 		switch {
 		case payload.MustPlay:
@@ -233,8 +412,9 @@ func handleMsgPlayTurn(m model.Model, msg *protocol.Message) tea.Cmd {
 		m.Input().Blur()
 	}
 	m.Game().SetTimerDuration(time.Duration(payload.Timeout) * time.Second)
+	m.Game().SetTimeBank(time.Duration(payload.TimeBank) * time.Second)
 	m.Game().SetTimerStartTime(time.Now())
-	t := timer.New(m.Game().TimerDuration(), timer.WithInterval(time.Second))
+	t := timer.New(m.Game().TimerDuration()+m.Game().TimeBank(), timer.WithInterval(time.Second))
 	m.SetTimer(t)
 	return t.Start()
 }
@@ -249,10 +429,7 @@ func handleMsgCardPlayed(m model.Model, msg *protocol.Message) tea.Cmd {
 	m.Game().State().LastPlayedName = payload.PlayerName
 	m.Game().State().LastPlayed = convert.InfosToCards(payload.Cards)
 	m.Game().State().LastHandType = payload.HandType
-	// 炸弹 / 王炸：本地同步倍数翻倍（与服务端结算一致）
-	if payload.HandType == rule.Bomb.String() || payload.HandType == rule.Rocket.String() {
-		m.Game().State().Multiplier = max(m.Game().State().Multiplier, 1) * 2
-	}
+	m.Game().State().LastReading = convert.InfoToReading(payload.Reading)
 	for i, p := range m.Game().State().Players {
 		if p.ID == payload.PlayerID {
 			m.Game().State().Players[i].CardsCount = payload.CardsLeft
@@ -274,7 +451,7 @@ func handleMsgCardPlayed(m model.Model, msg *protocol.Message) tea.Cmd {
 func playCardPlayedSounds(m model.Model, payload protocol.CardPlayedPayload, isBeat bool) {
 	m.PlaySound("play")
 	switch {
-	case isBeat && payload.HandType != rule.Bomb.String() && payload.HandType != rule.Rocket.String():
+	case isBeat && !isBombType(payload.HandType):
 		// 普通接牌压过上家：优先报牌型（单/对/三张报点数），其间穿插“压死”男声
 		switch payload.HandType {
 		case rule.Single.String(), rule.Pair.String(), rule.Trio.String():
@@ -324,7 +501,18 @@ func handleMsgGameOver(m model.Model, msg *protocol.Message) tea.Cmd {
 	m.Game().State().Winner = payload.WinnerName
 	m.Game().State().WinnerIsLandlord = payload.IsLandlord
 	m.Game().State().FinalMultiplier = payload.Multiplier
+	m.Game().State().Breakdown = payload.Breakdown
+	m.Game().State().Multiplier = payload.Multiplier
 	m.Game().State().Scores = payload.Scores
+	reportClientSeed(m, payload.NextSeedCommit)
+
+	// 观战者直接在观战界面展示结算和各家余牌，不保存发牌记录也不分输赢
+	if m.Phase() == model.PhaseSpectating {
+		storeSpectatorHands(m.Game().State(), payload.PlayerHands)
+		m.StopBGM()
+		return nil
+	}
+	saveDealRecord(m, payload.Proof)
 
 	if m.Game().State().IsLandlord == m.Game().State().WinnerIsLandlord {
 		m.PlaySound("win")
@@ -338,6 +526,93 @@ func handleMsgGameOver(m model.Model, msg *protocol.Message) tea.Cmd {
 	})
 }
 
+// handleMsgSeriesStandings 记录系列赛排名，结算页据此展示；局间有人离开时系列赛提前结束，直接展示最终排名
+func handleMsgSeriesStandings(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.SeriesStandingsPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	m.Game().State().Standings = &payload
+
+	if payload.Final && m.Phase() == model.PhaseWaiting {
+		m.SetPhase(model.PhaseGameOver)
+		m.Input().Placeholder = "按回车返回大厅"
+		m.Input().Focus()
+		m.SetNotification(model.NotifyInfo, "🏁 有玩家离开，系列赛提前结束", true)
+		return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return model.ClearSystemNotificationMsg{}
+		})
+	}
+	return nil
+}
+
+// handleMsgRematchStatus 记录再来一局投票状态：全员同意回到房间等待开局，未成行时同意的玩家已被放回匹配队列
+func handleMsgRematchStatus(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.RematchStatusPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	st := m.Game().State()
+	// 已离开结算页（返回大厅或进入其他房间）
+	if payload.RoomCode != st.RoomCode || m.Phase() != model.PhaseGameOver {
+		return nil
+	}
+	agreed := slices.Contains(payload.Agreed, m.PlayerID())
+	st.Rematch = &payload
+
+	var text string
+	switch payload.Result {
+	case protocol.RematchStarted:
+		st.ResetForNextHand()
+		m.SetPhase(model.PhaseWaiting)
+		text = "🔁 全员同意，再来一局！"
+	case protocol.RematchFailed:
+		if !agreed {
+			text = "再来一局未成行"
+			break
+		}
+		st.Reset()
+		m.SetPhase(model.PhaseMatching)
+		m.SetMatchingStartTime(time.Now())
+		text = "再来一局未成行，已为你重新匹配"
+	default:
+		return nil
+	}
+	m.SetNotification(model.NotifyInfo, text, true)
+	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+		return model.ClearSystemNotificationMsg{}
+	})
+}
+
+// saveDealRecord 保存本局发牌记录供 "ddz verify" 核对
+func saveDealRecord(m model.Model, proof *protocol.ShuffleProof) {
+	if proof == nil {
+		return
+	}
+
+	st := m.Game().State()
+	seat := -1
+	for _, p := range st.Players {
+		if p.ID == m.PlayerID() {
+			seat = p.Seat
+			break
+		}
+	}
+	path, err := gameClient.DefaultDealRecordPath()
+	if err != nil {
+		return
+	}
+	_ = gameClient.SaveDealRecord(path, gameClient.DealRecord{
+		SeedCommit: st.SeedCommit,
+		ClientSeed: st.ClientSeed,
+		WildRank:   st.WildRank,
+		Seat:       seat,
+		Hand:       convert.CardsToInfos(st.DealtHand),
+		Proof:      *proof,
+	})
+}
+
+// isBombType 判断牌型名称是否为炸弹类（炸弹、软炸弹、王炸）
+func isBombType(handType string) bool {
+	return handType == rule.Bomb.String() || handType == rule.SoftBombName || handType == rule.Rocket.String()
+}
+
 // playCardVoice 用男声播报刚打出的牌：单/对/三张报点数，其余报牌型。
 // 文件名与 internal/sound/sounds 下的英文命名一一对应。
 func playCardVoice(m model.Model, handType string, cards []card.Card) {
@@ -358,7 +633,7 @@ func playCardVoice(m model.Model, handType string, cards []card.Card) {
 		m.PlaySound("type_pairstraight")
 	case rule.Plane.String(), rule.PlaneWithSingles.String(), rule.PlaneWithPairs.String():
 		m.PlaySequence("type_plane", "plane")
-	case rule.Bomb.String():
+	case rule.Bomb.String(), rule.SoftBombName:
 		m.PlaySequence("type_bomb", "bomb")
 	case rule.FourWithTwo.String():
 		m.PlaySound("type_four_two")
@@ -31,15 +31,30 @@ var messageHandlers = map[protocol.MessageType]messageHandler{
 	protocol.MsgRoomListResult: handleMsgRoomListResult,
 
 	// Game
-	protocol.MsgGameStart:  handleMsgGameStart,
-	protocol.MsgDealCards:  handleMsgDealCards,
-	protocol.MsgBidTurn:    handleMsgBidTurn,
-	protocol.MsgBidResult:  handleMsgBidResult,
-	protocol.MsgLandlord:   handleMsgLandlord,
-	protocol.MsgPlayTurn:   handleMsgPlayTurn,
-	protocol.MsgCardPlayed: handleMsgCardPlayed,
-	protocol.MsgPlayerPass: handleMsgPlayerPass,
-	protocol.MsgGameOver:   handleMsgGameOver,
+	protocol.MsgGameStart:        handleMsgGameStart,
+	protocol.MsgDealCards:        handleMsgDealCards,
+	protocol.MsgBidTurn:          handleMsgBidTurn,
+	protocol.MsgBidResult:        handleMsgBidResult,
+	protocol.MsgLandlord:         handleMsgLandlord,
+	protocol.MsgDoubleTurn:       handleMsgDoubleTurn,
+	protocol.MsgDoubleResult:     handleMsgDoubleResult,
+	protocol.MsgHandRevealed:     handleMsgHandRevealed,
+	protocol.MsgMultiplierUpdate: handleMsgMultiplierUpdate,
+	protocol.MsgPlayTurn:         handleMsgPlayTurn,
+	protocol.MsgCardPlayed:       handleMsgCardPlayed,
+	protocol.MsgPlayerPass:       handleMsgPlayerPass,
+	protocol.MsgTrusteeChanged:   handleMsgTrusteeChanged,
+	protocol.MsgSeriesStandings:  handleMsgSeriesStandings,
+	protocol.MsgRematchStatus:    handleMsgRematchStatus,
+	protocol.MsgGameOver:         handleMsgGameOver,
+
+	// Spectate
+	protocol.MsgSpectateStarted: handleMsgSpectateStarted,
+	protocol.MsgSpectatorHands:  handleMsgSpectatorHands,
+
+	// Tournament
+	protocol.MsgTournamentList:      handleMsgTournamentList,
+	protocol.MsgTournamentStandings: handleMsgTournamentStandings,
 
 	// Stats
 	protocol.MsgStatsResult:       handleMsgStatsResult,
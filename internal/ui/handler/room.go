@@ -13,6 +13,7 @@ func handleMsgRoomCreated(m model.Model, msg *protocol.Message) tea.Cmd {
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
 	m.Game().State().RoomCode = payload.RoomCode
 	m.Game().State().Players = []protocol.PlayerInfo{payload.Player}
+	reportClientSeed(m, payload.SeedCommit)
 	m.SetPhase(model.PhaseWaiting)
 	m.Input().Placeholder = "输入 R 准备"
 	return nil
@@ -23,6 +24,7 @@ func handleMsgRoomJoined(m model.Model, msg *protocol.Message) tea.Cmd {
 	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
 	m.Game().State().RoomCode = payload.RoomCode
 	m.Game().State().Players = payload.Players
+	reportClientSeed(m, payload.SeedCommit)
 	m.SetPhase(model.PhaseWaiting)
 	m.Input().Placeholder = "输入 R 准备"
 	m.PlaySound("join")
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"cmp"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
+	payloadconv "github.com/palemoky/fight-the-landlord/internal/protocol/convert/payload"
+	"github.com/palemoky/fight-the-landlord/internal/ui/model"
+)
+
+// handleMsgSpectateStarted 开始观战：用服务端快照初始化牌桌并进入只读的观战界面
+func handleMsgSpectateStarted(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.SpectateStartedPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+
+	st := m.Game().State()
+	st.Reset()
+	st.RoomCode = payload.RoomCode
+	st.HandDelay = payload.HandDelay
+	if payload.GameState != nil {
+		restoreGameState(m, payload.GameState)
+		st.SpectateStage = payload.GameState.Phase
+		if st.SpectateStage == "bidding" {
+			m.Game().SetBidTurn(payload.GameState.CurrentTurn)
+		}
+	}
+
+	m.SetPhase(model.PhaseSpectating)
+	m.Input().Placeholder = "按 ESC 退出观战"
+	m.Input().Blur()
+	return nil
+}
+
+// handleMsgSpectatorHands 记录延迟公开的各家手牌
+func handleMsgSpectatorHands(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.SpectatorHandsPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	storeSpectatorHands(m.Game().State(), payload.Hands)
+	return nil
+}
+
+// storeSpectatorHands 覆盖观战者看到的各家手牌
+func storeSpectatorHands(st *gameClient.GameState, hands []protocol.PlayerHand) {
+	st.SpectatorHands = make(map[string][]card.Card, len(hands))
+	for _, h := range hands {
+		cards := convert.InfosToCards(h.Cards)
+		slices.SortFunc(cards, func(a, b card.Card) int { return cmp.Compare(b.Rank, a.Rank) })
+		st.SpectatorHands[h.PlayerID] = cards
+	}
+}
+
+// setGamePhase 切换对局阶段；观战时停留在观战界面，只记下对局所处阶段
+func setGamePhase(m model.Model, phase model.GamePhase, stage string) {
+	if m.Phase() == model.PhaseSpectating {
+		m.Game().State().SpectateStage = stage
+		return
+	}
+	m.SetPhase(phase)
+}
+
+// resetSpectatorRound 观战的房间开始新一局时清掉上一局的出牌与结算
+func resetSpectatorRound(st *gameClient.GameState) {
+	st.BottomCards = nil
+	st.CurrentTurn = ""
+	st.LastPlayed = nil
+	st.LastPlayedBy = ""
+	st.LastPlayedName = ""
+	st.LastHandType = ""
+	st.LastReading = rule.HandReading{}
+	st.Multiplier = 0
+	st.BottomPattern = ""
+	st.BottomBonus = 0
+	st.SpectatorHands = nil
+	st.Winner = ""
+	st.WinnerIsLandlord = false
+	st.FinalMultiplier = 0
+	st.Scores = nil
+	st.SpectateStage = "bidding"
+
+	// 观战者收不到发牌消息，按玩法布局补上各家初始牌数
+	layout, _ := room.LayoutByMode(st.Mode)
+	for i := range st.Players {
+		st.Players[i].CardsCount = layout.HandSize
+	}
+}
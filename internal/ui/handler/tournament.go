@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	payloadconv "github.com/palemoky/fight-the-landlord/internal/protocol/convert/payload"
+	"github.com/palemoky/fight-the-landlord/internal/ui/model"
+)
+
+func handleMsgTournamentList(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.TournamentListPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	m.Lobby().SetTournaments(payload.Tournaments)
+	return nil
+}
+
+// handleMsgTournamentStandings 记录锦标赛排名，锦标赛页面据此展示；每轮结束时提示晋级或淘汰
+func handleMsgTournamentStandings(m model.Model, msg *protocol.Message) tea.Cmd {
+	var payload protocol.TournamentStandingsPayload
+	_ = payloadconv.DecodePayload(msg.Type, msg.Payload, &payload)
+	m.Lobby().SetTournamentStandings(&payload)
+
+	var text string
+	for i, s := range payload.Standings {
+		if s.PlayerID != m.PlayerID() {
+			continue
+		}
+		switch {
+		case payload.Final && i == 0:
+			text = fmt.Sprintf("🏆 恭喜夺得「%s」冠军！", payload.Name)
+		case payload.Final:
+			text = fmt.Sprintf("🏁 「%s」结束，你获得第 %d 名", payload.Name, i+1)
+		case s.Eliminated:
+			text = fmt.Sprintf("🏁 「%s」第 %d 轮被淘汰，获得第 %d 名", payload.Name, payload.Round, i+1)
+		default:
+			text = fmt.Sprintf("🏆 「%s」第 %d 轮晋级，下一轮即将开始", payload.Name, payload.Round)
+		}
+		break
+	}
+	if text == "" {
+		return nil
+	}
+	m.SetNotification(model.NotifyInfo, text, true)
+	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+		return model.ClearSystemNotificationMsg{}
+	})
+}
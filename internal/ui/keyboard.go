@@ -3,12 +3,17 @@ package ui
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
 	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/room"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/codec"
 	"github.com/palemoky/fight-the-landlord/internal/protocol/convert"
@@ -75,7 +80,7 @@ func handleLobbyChatInput(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 
 // handleQuickMessageMenu handles the quick message menu in-game
 func handleQuickMessageMenu(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
-	if m.Phase() != model.PhaseBidding && m.Phase() != model.PhasePlaying {
+	if m.Phase() != model.PhaseBidding && m.Phase() != model.PhaseDoubling && m.Phase() != model.PhasePlaying {
 		return false, nil
 	}
 
@@ -208,6 +213,8 @@ func HandleKeyPress(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 		playMenuFeedback(m)
 		cmd := handleEnter(m)
 		return false, cmd
+	case tea.KeyTab:
+		return handleHintKey(m)
 	default:
 		if msg.String() == "ctrl+c" {
 			return handleEscKey(m)
@@ -222,7 +229,7 @@ func HandleKeyPress(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 // playMenuFeedback 在大厅 / 房间列表用上下键导航或回车选择时给出按键音反馈
 func playMenuFeedback(m model.Model) {
 	switch m.Phase() {
-	case model.PhaseLobby, model.PhaseRoomList:
+	case model.PhaseLobby, model.PhaseRoomList, model.PhaseTournament:
 		m.PlaySound("menu")
 	}
 }
@@ -234,14 +241,26 @@ func handleEscKey(m model.Model) (bool, tea.Cmd) {
 	}
 
 	switch m.Phase() {
-	case model.PhaseRoomList, model.PhaseMatching, model.PhaseLeaderboard, model.PhaseStats, model.PhaseRules, model.PhaseGameOver:
+	case model.PhaseRoomList, model.PhaseMatching, model.PhaseLeaderboard, model.PhaseStats, model.PhaseRules, model.PhaseTournament:
+		m.EnterLobby()
+		return true, nil
+	case model.PhaseGameOver:
+		// 系列赛尚未打完时玩家仍在房间中，离开即结束系列赛
+		if m.Game().State().SeriesContinues() {
+			_ = m.Client().LeaveRoom()
+			m.Game().State().Reset()
+		}
+		declineRematch(m)
 		m.EnterLobby()
 		return true, nil
 	case model.PhaseWaiting:
 		_ = m.Client().LeaveRoom()
 		m.EnterLobby()
 		return true, nil
-	case model.PhaseBidding, model.PhasePlaying:
+	case model.PhaseSpectating:
+		leaveSpectating(m)
+		return true, nil
+	case model.PhaseBidding, model.PhaseDoubling, model.PhasePlaying:
 		m.SetNotification(model.NotifyError, "⚠️ 游戏进行中，无法退出！", true)
 		return true, clearSystemNotification()
 	}
@@ -250,6 +269,13 @@ func handleEscKey(m model.Model) (bool, tea.Cmd) {
 	return true, tea.Quit
 }
 
+// leaveSpectating 退出观战并回到大厅
+func leaveSpectating(m model.Model) {
+	_ = m.Client().StopSpectating()
+	m.Game().State().Reset()
+	m.EnterLobby()
+}
+
 func handleRuneKey(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 	runes := []rune(msg.Key().Text)
 	if len(runes) == 0 {
@@ -266,8 +292,25 @@ func handleRuneKey(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 		return true, clearSystemNotification()
 	}
 
-	// Handle game toggles (only during bidding/playing)
-	if m.Phase() == model.PhaseBidding || m.Phase() == model.PhasePlaying {
+	// 结算页 R 键同意再来一局
+	if m.Phase() == model.PhaseGameOver && (runes[0] == 'r' || runes[0] == 'R') {
+		st := m.Game().State()
+		if st.RematchPending() && !st.RematchAgreed(m.PlayerID()) {
+			_ = m.Client().Rematch(st.RoomCode, true)
+		}
+		return true, nil
+	}
+
+	// 观战界面只读，Q 键退出观战
+	if m.Phase() == model.PhaseSpectating {
+		if runes[0] == 'q' || runes[0] == 'Q' {
+			leaveSpectating(m)
+		}
+		return true, nil
+	}
+
+	// Handle game toggles (only during bidding/doubling/playing)
+	if m.Phase() == model.PhaseBidding || m.Phase() == model.PhaseDoubling || m.Phase() == model.PhasePlaying {
 		switch runes[0] {
 		case 'c', 'C':
 			m.Game().SetCardCounterEnabled(!m.Game().CardCounterEnabled())
@@ -275,25 +318,54 @@ func handleRuneKey(m model.Model, msg tea.KeyMsg) (bool, tea.Cmd) {
 		case 'h', 'H':
 			m.Game().SetShowingHelp(!m.Game().ShowingHelp())
 			return true, nil
+		case 'g', 'G':
+			_ = m.Client().SetTrustee(!m.Game().State().IsTrustee(m.PlayerID()))
+			return true, nil
 		}
 	}
 
 	return false, nil
 }
 
+// handleHintKey 轮到自己出牌时按 Tab 把提示的出牌填入输入框，再按一次换下一种出法；
+// 提示从小到大排列，炸弹与王炸在最后，没有能压过上家的牌时提示 PASS
+func handleHintKey(m model.Model) (bool, tea.Cmd) {
+	if m.Phase() != model.PhasePlaying || m.Game().State().CurrentTurn != m.PlayerID() {
+		return false, nil
+	}
+	m.Game().ClearPendingPlay()
+	hint, ok := m.Game().NextHint()
+	if !ok {
+		m.Input().SetValue("PASS")
+		return true, nil
+	}
+	m.Input().SetValue(gameClient.HintText(hint.Cards))
+	m.Input().CursorEnd()
+	return true, nil
+}
+
 func handleEnter(m model.Model) tea.Cmd {
 	input := strings.TrimSpace(m.Input().Value())
 	m.Input().Reset()
 
+	if isShowHandInput(input) && (m.Phase() == model.PhaseBidding || m.Phase() == model.PhaseDoubling || m.Phase() == model.PhasePlaying) {
+		_ = m.Client().ShowHand()
+		return nil
+	}
+
 	switch m.Phase() {
 	case model.PhaseLobby:
 		return handleLobbyEnter(m, input)
 	case model.PhaseRoomList:
 		return handleRoomListEnter(m, input)
+	case model.PhaseTournament:
+		return handleTournamentEnter(m)
 	case model.PhaseWaiting:
 		return handleWaitingEnter(m, input)
 	case model.PhaseBidding:
 		return handleBiddingEnter(m, input)
+	case model.PhaseDoubling:
+		return handleDoublingEnter(m, input)
 	case model.PhasePlaying:
 		return handlePlayingEnter(m, input)
 	case model.PhaseGameOver:
@@ -303,6 +375,11 @@ func handleEnter(m model.Model) tea.Cmd {
 	return nil
 }
 
+// isShowHandInput 输入 show 或“明牌”表示明牌
+func isShowHandInput(input string) bool {
+	return strings.EqualFold(input, "show") || input == "明牌"
+}
+
 // checkServerAvailability 检查服务器是否可用于游戏操作
 // 返回 true 和错误命令如果服务器不可用，返回 false 和 nil 如果可用
 func checkServerAvailability(m model.Model) (blocked bool, cmd tea.Cmd) {
@@ -330,11 +407,65 @@ func checkMaintenanceMode(m model.Model) (blocked bool, cmd tea.Cmd) {
 	return false, nil
 }
 
+// parseRoomOptions 解析建房参数：laizi 表示癞子玩法，four/two 为人数玩法模式，score 为叫分模式，
+// double 开启加倍阶段，数字为系列赛局数，其余视为房规名称
+func parseRoomOptions(args string) protocol.CreateRoomPayload {
+	var opts protocol.CreateRoomPayload
+	for _, field := range strings.Fields(args) {
+		if hands, err := strconv.Atoi(field); err == nil {
+			opts.Hands = hands
+			continue
+		}
+		switch field {
+		case "laizi":
+			opts.Laizi = true
+		case room.ModeFour, room.ModeTwo:
+			opts.Mode = field
+		case room.BidModeScore:
+			opts.BidMode = field
+		case "double":
+			opts.Doubling = true
+		default:
+			opts.RuleSet = field
+		}
+	}
+	return opts
+}
+
 func handleLobbyEnter(m model.Model, input string) tea.Cmd {
 	if input == "" {
 		input = fmt.Sprintf("%d", m.Lobby().SelectedIndex()+1)
 	}
 
+	// "1 <模式>" 按指定人数玩法快速匹配，如 "1 four"、"1 two"
+	if mode, ok := strings.CutPrefix(input, "1 "); ok {
+		if blocked, cmd := checkServerAvailability(m); blocked {
+			return cmd
+		}
+		m.SetPhase(model.PhaseMatching)
+		m.SetMatchingStartTime(time.Now())
+		_ = m.Client().QuickMatch(strings.TrimSpace(mode))
+		return nil
+	}
+
+	// "8 <房间号>" 观战进行中的对局
+	if code, ok := strings.CutPrefix(input, "8 "); ok {
+		if blocked, cmd := checkServerAvailability(m); blocked {
+			return cmd
+		}
+		_ = m.Client().SpectateRoom(strings.TrimSpace(code))
+		return nil
+	}
+
+	// "2 <房规> [laizi] [four|two] [score] [double] [局数]" 按指定玩法创建房间，如 "2 no_kickers"、"2 laizi"、"2 four"、"2 score double"、"2 5"
+	if args, ok := strings.CutPrefix(input, "2 "); ok {
+		if blocked, cmd := checkMaintenanceMode(m); blocked {
+			return cmd
+		}
+		_ = m.Client().CreateRoom(parseRoomOptions(args))
+		return nil
+	}
+
 	switch input {
 	case "1": // 快速匹配
 		if blocked, cmd := checkServerAvailability(m); blocked {
@@ -342,13 +473,13 @@ func handleLobbyEnter(m model.Model, input string) tea.Cmd {
 		}
 		m.SetPhase(model.PhaseMatching)
 		m.SetMatchingStartTime(time.Now())
-		_ = m.Client().SendMessage(codec.MustNewMessage(protocol.MsgQuickMatch, nil))
+		_ = m.Client().QuickMatch(room.ModeClassic)
 
 	case "2": // 创建房间
 		if blocked, cmd := checkMaintenanceMode(m); blocked {
 			return cmd
 		}
-		_ = m.Client().SendMessage(codec.MustNewMessage(protocol.MsgCreateRoom, nil))
+		_ = m.Client().CreateRoom(protocol.CreateRoomPayload{})
 
 	case "3": // 房间列表
 		if blocked, cmd := checkMaintenanceMode(m); blocked {
@@ -377,6 +508,14 @@ func handleLobbyEnter(m model.Model, input string) tea.Cmd {
 	case "7": // 游戏规则
 		m.SetPhase(model.PhaseRules)
 
+	case "8": // 观战对局：需要带上房间号
+		m.SetNotification(model.NotifyInfo, "👀 输入 8 <房间号> 观战进行中的对局", true)
+		return clearSystemNotification()
+
+	case "9": // 锦标赛
+		m.SetPhase(model.PhaseTournament)
+		_ = m.Client().GetTournaments()
+
 	default: // 加入房间
 		if blocked, cmd := checkMaintenanceMode(m); blocked {
 			return cmd
@@ -406,6 +545,27 @@ func handleRoomListEnter(m model.Model, input string) tea.Cmd {
 	return nil
 }
 
+// handleTournamentEnter 报名选中的锦标赛，已报名时退出；服务端回复最新的列表
+func handleTournamentEnter(m model.Model) tea.Cmd {
+	tournaments := m.Lobby().Tournaments()
+	idx := m.Lobby().SelectedTournamentIdx()
+	if idx >= len(tournaments) {
+		return nil
+	}
+	t := tournaments[idx]
+	if t.State != protocol.TournamentRegistering {
+		m.SetNotification(model.NotifyError, "⚠️ 锦标赛已开赛，不能再报名或退出", true)
+		return clearSystemNotification()
+	}
+	if !t.Registered {
+		if blocked, cmd := checkServerAvailability(m); blocked {
+			return cmd
+		}
+	}
+	_ = m.Client().RegisterTournament(t.ID, !t.Registered)
+	return nil
+}
+
 func handleWaitingEnter(m model.Model, input string) tea.Cmd {
 	if strings.EqualFold(input, "r") || strings.EqualFold(input, "ready") {
 		_ = m.Client().Ready()
@@ -415,6 +575,16 @@ func handleWaitingEnter(m model.Model, input string) tea.Cmd {
 
 func handleBiddingEnter(m model.Model, input string) tea.Cmd {
 	if m.Game().BidTurn() == m.PlayerID() {
+		if m.Game().State().ScoreBidding() {
+			if in := strings.ToLower(input); in == "n" || in == "no" {
+				input = "0"
+			}
+			score, err := strconv.Atoi(input)
+			if err == nil && (score == 0 || slices.Contains(m.Game().State().BidOptions, score)) {
+				_ = m.Client().BidScore(score)
+			}
+			return nil
+		}
 		switch strings.ToLower(input) {
 		case "y", "yes", "1":
 			_ = m.Client().Bid(true)
@@ -425,8 +595,32 @@ func handleBiddingEnter(m model.Model, input string) tea.Cmd {
 	return nil
 }
 
+func handleDoublingEnter(m model.Model, input string) tea.Cmd {
+	if !m.Game().State().DoublePending {
+		return nil
+	}
+	switch strings.ToLower(input) {
+	case "0", "n", "no":
+		_ = m.Client().Double(room.DoubleNone)
+	case "1", "y", "yes":
+		_ = m.Client().Double(room.DoubleTwice)
+	case "2":
+		_ = m.Client().Double(room.DoubleSuper)
+	}
+	return nil
+}
+
 func handlePlayingEnter(m model.Model, input string) tea.Cmd {
 	if m.Game().State().CurrentTurn == m.PlayerID() {
+		// 上一手牌有多种读法，输入序号选择牌型；输入其他内容则放弃这手，按新输入处理
+		if cards, choices := m.Game().PendingPlay(); cards != nil {
+			m.Game().ClearPendingPlay()
+			if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(choices) {
+				_ = m.Client().PlayCards(convert.CardsToInfos(cards), convert.ReadingToInfo(choices[n-1].Reading()))
+				return nil
+			}
+		}
+
 		upperInput := strings.ToUpper(input)
 		if upperInput == "PASS" || upperInput == "P" {
 			_ = m.Client().Pass()
@@ -438,13 +632,48 @@ func handlePlayingEnter(m model.Model, input string) tea.Cmd {
 					return model.ClearInputErrorMsg{}
 				})
 			}
-			_ = m.Client().PlayCards(convert.CardsToInfos(cards))
+			// 本地能确定出不了的牌直接说明原因，不再发往服务端
+			choices, err := m.Game().State().CheckPlay(cards, m.Game().MustPlay())
+			if err != nil {
+				m.Input().Placeholder = err.Error()
+				return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+					return model.ClearInputErrorMsg{}
+				})
+			}
+			if len(choices) > 1 {
+				m.Game().SetPendingPlay(cards, choices)
+				m.Input().Placeholder = playChoicePrompt(choices)
+				return nil
+			}
+			var reading protocol.HandReading
+			if len(choices) == 1 {
+				reading = convert.ReadingToInfo(choices[0].Reading())
+			}
+			_ = m.Client().PlayCards(convert.CardsToInfos(cards), reading)
 		}
 	}
 	return nil
 }
 
+// playChoicePrompt 一手牌有多种读法时提示玩家选择牌型
+func playChoicePrompt(choices []rule.ParsedHand) string {
+	options := gameClient.ChoiceLabels(choices)
+	for i, label := range options {
+		options[i] = fmt.Sprintf("%d.%s", i+1, label)
+	}
+	return "这手牌可以按多种牌型出: " + strings.Join(options, " ") + "，输入序号选择"
+}
+
 func handleGameOverEnter(m model.Model) tea.Cmd {
+	// 系列赛：回到房间准备下一局
+	if st := m.Game().State(); st.SeriesContinues() {
+		st.ResetForNextHand()
+		m.SetPhase(model.PhaseWaiting)
+		_ = m.Client().Ready()
+		return nil
+	}
+
+	declineRematch(m)
 	m.EnterLobby()
 	m.Game().State().Reset()
 
@@ -452,3 +681,10 @@ func handleGameOverEnter(m model.Model) tea.Cmd {
 
 	return nil
 }
+
+// declineRematch 离开结算页时拒绝尚在进行的再来一局投票，其他玩家不必等到超时
+func declineRematch(m model.Model) {
+	if st := m.Game().State(); st.RematchPending() {
+		_ = m.Client().Rematch(st.RoomCode, false)
+	}
+}
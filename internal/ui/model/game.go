@@ -8,6 +8,8 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/transport"
 )
 
@@ -33,10 +35,19 @@ type GameModel struct {
 	mustPlay bool
 	canBeat  bool
 
+	// Ambiguous play waiting for the player to pick a hand type
+	pendingPlay []card.Card
+	playChoices []rule.ParsedHand
+
+	// Play hints for the current turn, cycled with the hint key
+	hints   []rule.ParsedHand
+	hintIdx int
+
 	// UI helper state
 	bellPlayed     bool
 	timerDuration  time.Duration
 	timerStartTime time.Time
+	timeBank       time.Duration // 出牌玩家剩余的备用时间，回合时间用完后开始消耗
 
 	// Features
 	cardCounterEnabled bool
@@ -89,10 +100,35 @@ func (m *GameModel) SetMustPlay(must bool) { m.mustPlay = must }
 func (m *GameModel) CanBeat() bool         { return m.canBeat }
 func (m *GameModel) SetCanBeat(can bool)   { m.canBeat = can }
 
+func (m *GameModel) PendingPlay() ([]card.Card, []rule.ParsedHand) {
+	return m.pendingPlay, m.playChoices
+}
+func (m *GameModel) SetPendingPlay(cards []card.Card, choices []rule.ParsedHand) {
+	m.pendingPlay, m.playChoices = cards, choices
+}
+func (m *GameModel) ClearPendingPlay() { m.pendingPlay, m.playChoices = nil, nil }
+
+// NextHint returns the next suggested play for this turn, computing the hint list on first use.
+// ok is false when no play can beat the last hand.
+func (m *GameModel) NextHint() (hint rule.ParsedHand, ok bool) {
+	if m.hints == nil {
+		m.hints = m.state.Hints(m.mustPlay)
+		m.hintIdx = -1
+	}
+	if len(m.hints) == 0 {
+		return rule.ParsedHand{}, false
+	}
+	m.hintIdx = (m.hintIdx + 1) % len(m.hints)
+	return m.hints[m.hintIdx], true
+}
+func (m *GameModel) ClearHints() { m.hints, m.hintIdx = nil, 0 }
+
 func (m *GameModel) TimerDuration() time.Duration     { return m.timerDuration }
 func (m *GameModel) SetTimerDuration(d time.Duration) { m.timerDuration = d }
 func (m *GameModel) TimerStartTime() time.Time        { return m.timerStartTime }
 func (m *GameModel) SetTimerStartTime(t time.Time)    { m.timerStartTime = t }
+func (m *GameModel) TimeBank() time.Duration          { return m.timeBank }
+func (m *GameModel) SetTimeBank(d time.Duration)      { m.timeBank = d }
 
 func (m *GameModel) BellPlayed() bool          { return m.bellPlayed }
 func (m *GameModel) SetBellPlayed(played bool) { m.bellPlayed = played }
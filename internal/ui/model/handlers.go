@@ -64,12 +64,19 @@ func (m *OnlineModel) handleClearReconnect() {
 	}
 }
 
+// DoublePrompt 加倍阶段轮到自己选择时的输入提示
+const DoublePrompt = "加倍? (0 不加倍 / 1 加倍 / 2 超级加倍)"
+
 func (m *OnlineModel) handleClearInputError() {
 	// Restore input placeholder after displaying error
 	switch m.phase {
 	case PhaseBidding:
 		if m.game.BidTurn() == m.playerID {
-			m.input.Placeholder = "叫地主? (Y/N)"
+			m.input.Placeholder = m.game.State().BidPrompt()
+		}
+	case PhaseDoubling:
+		if m.game.State().DoublePending {
+			m.input.Placeholder = DoublePrompt
 		}
 	case PhasePlaying:
 		if m.game.State().CurrentTurn == m.playerID {
@@ -29,6 +29,9 @@ type LobbyModel struct {
 	onlineCount     int
 	availableRooms  []protocol.RoomListItem
 	selectedRoomIdx int
+	tournaments     []protocol.TournamentInfo
+	selectedTourIdx int
+	tourStandings   *protocol.TournamentStandingsPayload // 最近一次收到的锦标赛排名
 	leaderboard     []protocol.LeaderboardEntry
 	myStats         *protocol.StatsResultPayload
 
@@ -75,6 +78,18 @@ func (m *LobbyModel) SetAvailableRooms(rooms []protocol.RoomListItem) {
 	m.availableRooms = rooms
 	m.selectedRoomIdx = 0
 }
+func (m *LobbyModel) Tournaments() []protocol.TournamentInfo { return m.tournaments }
+func (m *LobbyModel) SetTournaments(tournaments []protocol.TournamentInfo) {
+	m.tournaments = tournaments
+	m.selectedTourIdx = min(m.selectedTourIdx, max(len(tournaments)-1, 0))
+}
+func (m *LobbyModel) SelectedTournamentIdx() int { return m.selectedTourIdx }
+func (m *LobbyModel) TournamentStandings() *protocol.TournamentStandingsPayload {
+	return m.tourStandings
+}
+func (m *LobbyModel) SetTournamentStandings(s *protocol.TournamentStandingsPayload) {
+	m.tourStandings = s
+}
 func (m *LobbyModel) SelectedRoomIdx() int                               { return m.selectedRoomIdx }
 func (m *LobbyModel) SetSelectedRoomIdx(idx int)                         { m.selectedRoomIdx = idx }
 func (m *LobbyModel) Leaderboard() []protocol.LeaderboardEntry           { return m.leaderboard }
@@ -104,11 +119,15 @@ func (m *LobbyModel) HandleNavigationKey(phase GamePhase, direction int) {
 				m.selectedRoomIdx = 0
 			}
 		}
+	case PhaseTournament:
+		if len(m.tournaments) > 0 {
+			m.selectedTourIdx = (m.selectedTourIdx + direction + len(m.tournaments)) % len(m.tournaments)
+		}
 	case PhaseLobby:
 		m.selectedIndex += direction
 		if m.selectedIndex < 0 {
-			m.selectedIndex = 6
-		} else if m.selectedIndex > 6 {
+			m.selectedIndex = 8
+		} else if m.selectedIndex > 8 {
 			m.selectedIndex = 0
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"charm.land/bubbles/v2/textinput"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 )
 
@@ -107,6 +108,29 @@ func TestGameModel_Timer(t *testing.T) {
 	}
 }
 
+func TestGameModel_NextHint(t *testing.T) {
+	t.Parallel()
+
+	input := textinput.New()
+	m := NewGameModel(nil, &input)
+	m.State().Hand = []card.Card{{Rank: card.Rank5}, {Rank: card.Rank3}}
+	m.SetMustPlay(true)
+
+	// 按从小到大循环提示
+	for _, want := range []card.Rank{card.Rank3, card.Rank5, card.Rank3} {
+		hint, ok := m.NextHint()
+		assert.True(t, ok)
+		assert.Equal(t, want, hint.KeyRank)
+	}
+
+	// 新回合重新计算：压不过上家时没有提示
+	m.ClearHints()
+	m.SetMustPlay(false)
+	m.State().LastPlayed = []card.Card{{Rank: card.Rank2}}
+	_, ok := m.NextHint()
+	assert.False(t, ok)
+}
+
 func TestGameModel_Features(t *testing.T) {
 	t.Parallel()
 
@@ -345,7 +369,7 @@ func TestLobbyModel_HandleUpKey(t *testing.T) {
 		rooms       []protocol.RoomListItem
 		expectedIdx int
 	}{
-		{"lobby wrap around from 0", PhaseLobby, 0, nil, 6},
+		{"lobby wrap around from 0", PhaseLobby, 0, nil, 8},
 		{"lobby normal decrement", PhaseLobby, 3, nil, 2},
 		{"room list wrap around", PhaseRoomList, 0, []protocol.RoomListItem{{}, {}, {}}, 2},
 		{"room list normal decrement", PhaseRoomList, 2, []protocol.RoomListItem{{}, {}, {}}, 1},
@@ -385,7 +409,7 @@ func TestLobbyModel_HandleDownKey(t *testing.T) {
 		rooms       []protocol.RoomListItem
 		expectedIdx int
 	}{
-		{"lobby wrap around from 6", PhaseLobby, 6, nil, 0},
+		{"lobby wrap around from 8", PhaseLobby, 8, nil, 0},
 		{"lobby normal increment", PhaseLobby, 3, nil, 4},
 		{"room list wrap around", PhaseRoomList, 2, []protocol.RoomListItem{{}, {}, {}}, 0},
 		{"room list normal increment", PhaseRoomList, 0, []protocol.RoomListItem{{}, {}, {}}, 1},
@@ -439,3 +463,23 @@ func TestLobbyModel_Size(t *testing.T) {
 		})
 	}
 }
+
+func TestLobbyModel_TournamentNavigation(t *testing.T) {
+	t.Parallel()
+
+	input := textinput.New()
+	m := NewLobbyModel(nil, &input)
+	m.HandleDownKey(PhaseTournament)
+	assert.Equal(t, 0, m.SelectedTournamentIdx(), "列表为空时不移动")
+
+	m.SetTournaments([]protocol.TournamentInfo{{ID: "1"}, {ID: "2"}, {ID: "3"}})
+	m.HandleUpKey(PhaseTournament)
+	assert.Equal(t, 2, m.SelectedTournamentIdx())
+	m.HandleDownKey(PhaseTournament)
+	assert.Equal(t, 0, m.SelectedTournamentIdx())
+
+	// 刷新列表后选中项不越界
+	m.HandleUpKey(PhaseTournament)
+	m.SetTournaments([]protocol.TournamentInfo{{ID: "1"}})
+	assert.Equal(t, 0, m.SelectedTournamentIdx())
+}
@@ -69,7 +69,7 @@ type OnlineModel struct {
 // NewOnlineModel creates a new OnlineModel.
 func NewOnlineModel(serverURL string) *OnlineModel {
 	ti := textinput.New()
-	ti.Placeholder = "输入选项 (1-7) 或房间号"
+	ti.Placeholder = "输入选项 (1-9) 或房间号"
 	ti.CharLimit = 20
 	ti.SetWidth(30)
 	ti.Focus()
@@ -201,7 +201,7 @@ func (m *OnlineModel) EnterLobby() {
 	// 大厅播放欢迎背景音乐（循环），覆盖上一局的对局 BGM
 	m.soundManager.PlayBGM("bgm_welcome")
 	m.input.Reset()
-	m.input.Placeholder = "输入选项 (1-7) 或房间号"
+	m.input.Placeholder = "输入选项 (1-9) 或房间号"
 	m.input.Focus()
 
 	// 清理游戏状态
@@ -292,6 +292,11 @@ func (m *OnlineModel) dispatchMessage(msg tea.Msg) (cmds []tea.Cmd, earlyReturn
 	case GameOverDelayMsg:
 		m.phase = PhaseGameOver
 		m.input.Placeholder = "按回车返回大厅"
+		if m.game.State().SeriesContinues() {
+			m.input.Placeholder = "按回车准备下一局"
+		} else if m.game.State().RematchPending() {
+			m.input.Placeholder = "按 R 再来一局，回车返回大厅"
+		}
 		m.input.Focus()
 
 	case ClearInputErrorMsg:
@@ -326,7 +331,7 @@ func (m *OnlineModel) checkPlayReminder() {
 	if start.IsZero() {
 		return
 	}
-	if m.game.TimerDuration()-time.Since(start) <= 10*time.Second {
+	if m.game.TimerDuration()+m.game.TimeBank()-time.Since(start) <= 10*time.Second {
 		m.PlaySound("turn")
 		m.game.SetBellPlayed(true)
 	}
@@ -9,6 +9,8 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/rule"
 	"github.com/palemoky/fight-the-landlord/internal/protocol"
 	"github.com/palemoky/fight-the-landlord/internal/transport"
 )
@@ -24,11 +26,14 @@ const (
 	PhaseMatching
 	PhaseWaiting
 	PhaseBidding
+	PhaseDoubling
 	PhasePlaying
 	PhaseGameOver
 	PhaseLeaderboard
 	PhaseStats
 	PhaseRules
+	PhaseSpectating
+	PhaseTournament
 )
 
 // NotificationType represents types of system notifications.
@@ -152,6 +157,11 @@ type LobbyAccessor interface {
 	SetAvailableRooms([]protocol.RoomListItem)
 	SelectedRoomIdx() int
 	SetSelectedRoomIdx(int)
+	Tournaments() []protocol.TournamentInfo
+	SetTournaments([]protocol.TournamentInfo)
+	SelectedTournamentIdx() int
+	TournamentStandings() *protocol.TournamentStandingsPayload
+	SetTournamentStandings(*protocol.TournamentStandingsPayload)
 	SelectedIndex() int
 	SetSelectedIndex(int)
 	Leaderboard() []protocol.LeaderboardEntry
@@ -182,15 +192,27 @@ type GameAccessor interface {
 	BidTurn() string
 	SetBidTurn(string)
 
-	// Turn indicators (setters only - getters unused)
+	// Turn indicators
+	MustPlay() bool
 	SetMustPlay(bool)
 	SetCanBeat(bool)
 
+	// Ambiguous play waiting for the player to pick a hand type
+	PendingPlay() ([]card.Card, []rule.ParsedHand)
+	SetPendingPlay([]card.Card, []rule.ParsedHand)
+	ClearPendingPlay()
+
+	// Play hints cycled with the hint key
+	NextHint() (rule.ParsedHand, bool)
+	ClearHints()
+
 	// Timer
 	TimerDuration() time.Duration
 	SetTimerDuration(time.Duration)
 	TimerStartTime() time.Time
 	SetTimerStartTime(time.Time)
+	TimeBank() time.Duration
+	SetTimeBank(time.Duration)
 
 	// Bell (setter only - getter unused)
 	SetBellPlayed(bool)
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/ui/common"
+	"github.com/palemoky/fight-the-landlord/internal/ui/view"
+)
+
+// ReplayModel 离线回放一局对局，用方向键逐步前进或后退
+type ReplayModel struct {
+	frames []client.ReplayFrame
+	step   int
+	width  int
+	height int
+}
+
+// NewReplayModel 由对局日志创建回放，日志中至少要有一条事件
+func NewReplayModel(l *gamelog.Log) *ReplayModel {
+	return &ReplayModel{frames: client.BuildReplay(l)}
+}
+
+func (m *ReplayModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *ReplayModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case tea.KeyMsg:
+		last := len(m.frames) - 1
+		switch msg.String() {
+		case "right", "l", "n", "space", "enter":
+			m.step = min(m.step+1, last)
+		case "left", "h", "p", "backspace":
+			m.step = max(m.step-1, 0)
+		case "home", "g":
+			m.step = 0
+		case "end", "G":
+			m.step = last
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m *ReplayModel) View() tea.View {
+	if m.width == 0 {
+		return tea.NewView("Loading...")
+	}
+	content := view.ReplayView(m.frames[m.step], m.step, len(m.frames), m.width, m.height)
+	return tea.View{
+		Content:     common.DocStyle.Render(content),
+		AltScreen:   true,
+		WindowTitle: "欢乐斗地主 · 回放",
+	}
+}
@@ -46,11 +46,18 @@ func RenderGameRules() string {
 	sb += "【叫抢地主规则】\n"
 	sb += "1. 发牌后每位玩家依次选择叫地主 (Y) 或不叫 (N)\n"
 	sb += "2. 有人叫地主后，其余玩家可抢地主，每抢一次倍数翻倍\n"
-	sb += "3. 连续两人放弃后，最后抢到的人成为地主\n"
-	sb += "4. 地主获得3张底牌共20张，农民各17张；无人叫则重新发牌\n\n"
+	sb += "3. 其余玩家都放弃后，最后抢到的人成为地主\n"
+	sb += "4. 地主获得3张底牌共20张，农民各17张；无人叫则重新发牌\n"
+	sb += "5. 叫分房间 (建房时加 score) 改为依次叫 1/2/3 分或不叫 (0)，只能叫比当前更高的分，\n"
+	sb += "   叫到3分立即成为地主，否则一轮后叫分最高者当地主，所叫分数即为底倍\n\n"
 
 	sb += "【倍数规则】\n"
 	sb += "• 抢地主：每次抢/反抢倍数翻倍\n"
+	sb += "• 加倍：加倍房间 (建房时加 double) 定地主后每人可选不加倍 (0)、加倍 (1, ×2) 或超级加倍 (2, ×4)，\n"
+	sb += "  地主与每名农民单独结算，倍数再乘以双方的加倍\n"
+	sb += "• 明牌：输入 show 或 \"明牌\" 向全桌公开手牌，叫地主阶段明牌 ×5，\n"
+	sb += "  地主拿到底牌后、首次出牌前明牌 ×2；多人明牌时取最高倍数\n"
+	sb += "• 底牌翻倍：三张底牌含王、成对、成顺或同花时按服务器配置翻倍，多种同时满足取最高\n"
 	sb += "• 炸弹 / 王炸：每出一个倍数翻倍\n"
 	sb += "• 春天：地主获胜且农民一张未出，倍数翻倍\n"
 	sb += "• 反春天：农民获胜且地主仅首攻出过一手，倍数翻倍\n\n"
@@ -59,11 +66,30 @@ func RenderGameRules() string {
 	sb += "1. 地主先出牌\n"
 	sb += "2. 后续玩家必须出相同牌型且更大的牌，或选择PASS\n"
 	sb += "3. 如果都PASS，则最后出牌的玩家可以出任意牌型\n"
-	sb += "4. 炸弹和王炸可以压任何牌型\n\n"
+	sb += "4. 炸弹和王炸可以压任何牌型\n"
+	sb += "5. 一手牌有多种读法时（如 33334444 可作四带两对或飞机带单），按提示输入序号选择牌型\n\n"
+
+	sb += "【房规】\n"
+	sb += "• 大厅输入 \"2 房规名\" 按房规建房，默认 classic（经典）\n"
+	sb += "• no_kickers：炸弹不能带牌，飞机不能带对\n"
+	sb += "• short：不许四带二，顺子最长 8 张\n"
+	sb += "• laizi：癞子玩法，可与房规名同用，如 \"2 short laizi\"\n"
+	sb += "  发牌后随机一个点数（3~2）作癞子，可当任意点数使用；\n"
+	sb += "  含癞子的炸弹为软炸弹（翻一倍），四张同点数为硬炸弹（翻两倍），硬炸弹大过软炸弹\n"
+	sb += "• four：四人两副牌，如 \"2 four\"，快速匹配输入 \"1 four\"\n"
+	sb += "  每人 25 张，地主另得 8 张底牌；炸弹 4~8 张，张数多者大，四王为王炸；不能与 laizi 同用\n"
+	sb += "• two：二人斗地主，如 \"2 two\"，快速匹配输入 \"1 two\"\n"
+	sb += "  去掉 3 和 4，每人 17 张，底牌 3 张，其余 9 张为暗牌不参与出牌\n"
+	sb += "• score：叫分模式（叫 1/2/3 分代替叫抢），可与其他选项同用，如 \"2 four score\"\n"
+	sb += "• 局数：系列赛，如 \"2 5\" 同一房间连打 5 局并累计得分，每局轮换首叫，\n"
+	sb += "  局间显示排名，按回车准备下一局；有人离开则系列赛提前结束\n\n"
 
 	sb += "【快捷键】\n"
 	sb += "• C：切换记牌器（游戏中）\n"
 	sb += "• T：切换快捷消息（游戏中）\n"
+	sb += "• G：开启/取消托管（游戏中），托管后亲自叫牌或出牌即自动取消\n"
+	sb += "• Tab：出牌提示（轮到自己出牌时），再按一次换下一种出法，从小到大、炸弹在最后\n"
+	sb += "• R：同意再来一局（结算页），全员同意则原班人马在原房间再开一局\n"
 	sb += "• H：显示/隐藏帮助（游戏中）\n"
 	sb += "• M：开启/关闭声音（默认静音）\n"
 	sb += "• ESC：返回上一级或退出\n"
@@ -103,6 +103,8 @@ func LobbyView(m model.Model) string {
 		"5. 排行榜",
 		"6. 我的战绩",
 		"7. 游戏规则",
+		"8. 观战对局",
+		"9. 锦标赛",
 	}
 
 	lobbyModel := m.Lobby()
@@ -131,10 +133,10 @@ func LobbyView(m model.Model) string {
 	if lobby.ChatInput().Focused() {
 		m.Input().Blur()
 		inputView = lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center,
-			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("> ↑↓ 选择 | 回车确认 | 或输入选项(1-7)/房间号"))
+			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("> ↑↓ 选择 | 回车确认 | 或输入选项(1-9)/房间号"))
 	} else {
 		m.Input().Focus()
-		m.Input().Placeholder = "↑↓ 选择 | 回车确认 | 或输入选项(1-7)/房间号"
+		m.Input().Placeholder = "↑↓ 选择 | 回车确认 | 或输入选项(1-9)/房间号"
 		inputView = lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center, m.Input().View())
 	}
 	sb.WriteString(inputView)
@@ -170,7 +172,7 @@ func RoomListView(m model.Model) string {
 			if i == lobby.SelectedRoomIdx() {
 				prefix = "▶ "
 			}
-			fmt.Fprintf(&roomList, "%s房间 %s  (%d/3)\n", prefix, room.RoomCode, room.PlayerCount)
+			fmt.Fprintf(&roomList, "%s房间 %s  (%d/%d)\n", prefix, room.RoomCode, room.PlayerCount, room.MaxPlayers)
 		}
 
 		roomList.WriteString("\n↑↓ 选择  回车加入  ESC 返回")
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+// renderRematchStatus 结算页的再来一局提示，投票未开启时为空
+func renderRematchStatus(state *client.GameState, myID string) string {
+	if state.Rematch == nil {
+		return ""
+	}
+	switch {
+	case state.Rematch.Result == protocol.RematchFailed:
+		return "再来一局未成行"
+	case !state.RematchPending():
+		return ""
+	case state.RematchAgreed(myID):
+		return fmt.Sprintf("🔁 已同意再来一局，等待其他玩家（%d/%d）", len(state.Rematch.Agreed), len(state.Players))
+	default:
+		return fmt.Sprintf("🔁 再来一局？按 R 同意（已同意 %d/%d）", len(state.Rematch.Agreed), len(state.Players))
+	}
+}
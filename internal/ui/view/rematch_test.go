@@ -0,0 +1,27 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+func TestRenderRematchStatus(t *testing.T) {
+	t.Parallel()
+
+	state := client.NewGameState()
+	state.Players = []protocol.PlayerInfo{{ID: "p1"}, {ID: "p2"}, {ID: "p3"}}
+	assert.Empty(t, renderRematchStatus(state, "p1"))
+
+	state.Rematch = &protocol.RematchStatusPayload{Agreed: []string{"p2"}, Result: protocol.RematchPending}
+	assert.Contains(t, renderRematchStatus(state, "p1"), "按 R 同意（已同意 1/3）")
+
+	state.Rematch.Agreed = append(state.Rematch.Agreed, "p1")
+	assert.Contains(t, renderRematchStatus(state, "p1"), "等待其他玩家（2/3）")
+
+	state.Rematch.Result = protocol.RematchFailed
+	assert.Equal(t, "再来一局未成行", renderRematchStatus(state, "p1"))
+}
@@ -26,7 +26,7 @@ func CreateViewRenderer() func(model.Model, model.GamePhase) string {
 			return RoomListView(m)
 		case model.PhaseWaiting:
 			return WaitingView(m)
-		case model.PhaseBidding, model.PhasePlaying:
+		case model.PhaseBidding, model.PhaseDoubling, model.PhasePlaying:
 			return GameView(m)
 		case model.PhaseGameOver:
 			return GameOverView(m)
@@ -36,6 +36,10 @@ func CreateViewRenderer() func(model.Model, model.GamePhase) string {
 			return StatsView(m)
 		case model.PhaseRules:
 			return RulesView(m.Width(), m.Height())
+		case model.PhaseSpectating:
+			return SpectatorView(m)
+		case model.PhaseTournament:
+			return TournamentView(m)
 		default:
 			return "Unknown phase"
 		}
@@ -50,7 +54,11 @@ func WaitingView(m model.Model) string {
 
 	var sb strings.Builder
 
-	title := common.TitleStyle(fmt.Sprintf("🏠 房间: %s", state.RoomCode))
+	titleText := fmt.Sprintf("🏠 房间: %s", state.RoomCode)
+	if state.Standings != nil {
+		titleText += fmt.Sprintf("  系列赛第 %d/%d 局", state.Standings.Hand+1, state.Standings.Hands)
+	}
+	title := common.TitleStyle(titleText)
 	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, title))
 	sb.WriteString("\n\n")
 
@@ -72,6 +80,10 @@ func WaitingView(m model.Model) string {
 	fmt.Fprintf(&playerList, "\n等待玩家: %d/3", len(state.Players))
 
 	playerBox := common.BoxStyle.Render(playerList.String())
+	if state.Standings != nil {
+		standingsBox := common.BoxStyle.Render(strings.TrimSuffix(renderSeriesStandings(state.Standings, m.PlayerID()), "\n"))
+		playerBox = lipgloss.JoinHorizontal(lipgloss.Top, playerBox, "  ", standingsBox)
+	}
 	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, playerBox))
 	sb.WriteString("\n\n")
 
@@ -109,7 +121,7 @@ func GameView(m model.Model) string {
 	sb.WriteString("\n")
 
 	// Player hand
-	myHand := renderPlayerHand(state.Hand, state.IsLandlord)
+	myHand := renderPlayerHand(state.Hand, state.IsLandlord, state.WildRank)
 	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, myHand))
 	sb.WriteString("\n")
 
@@ -171,6 +183,9 @@ func GameOverView(m model.Model) string {
 	fmt.Fprintf(&sb, "🎮 游戏结束!\n\n🏆 %s (%s) 获胜!\n", winnerName, winnerType)
 	if state.FinalMultiplier > 0 {
 		fmt.Fprintf(&sb, "\n💥 本局倍数: ×%d\n", state.FinalMultiplier)
+		if state.Breakdown != nil {
+			fmt.Fprintf(&sb, "(%s)\n", state.BreakdownText())
+		}
 	}
 	if len(state.Scores) > 0 {
 		sb.WriteString("\n── 本局得分 ──\n")
@@ -179,10 +194,23 @@ func GameOverView(m model.Model) string {
 			if s.IsLandlord {
 				role = "地主"
 			}
+			if label := state.DoubleLabel(s.PlayerID); label != "" {
+				role += " " + label
+			}
 			fmt.Fprintf(&sb, "%s (%s): %+d\n", s.PlayerName, role, s.Score)
 		}
 	}
-	sb.WriteString("\n按 ESC 返回大厅")
+	if state.Standings != nil {
+		sb.WriteString("\n" + renderSeriesStandings(state.Standings, m.PlayerID()))
+	}
+	if state.SeriesContinues() {
+		sb.WriteString("\n按回车准备下一局，ESC 离开房间")
+	} else {
+		if rematch := renderRematchStatus(state, m.PlayerID()); rematch != "" {
+			sb.WriteString("\n" + rematch + "\n")
+		}
+		sb.WriteString("\n按 ESC 返回大厅")
+	}
 
 	content := lipgloss.NewStyle().
 		Width(width).
@@ -195,7 +223,10 @@ func GameOverView(m model.Model) string {
 // --- Helper rendering functions ---
 
 func renderTopSection(state *gameClient.GameState, cardCounterEnabled bool) string {
-	bottomCardsView := renderBottomCards(state.BottomCards)
+	bottomCardsView := renderBottomCards(state.BottomCards, state.WildRank, state.BottomPattern, state.BottomBonus)
+	if multiplier := renderMultiplier(state); multiplier != "" {
+		bottomCardsView = lipgloss.JoinHorizontal(lipgloss.Top, bottomCardsView, "  ", multiplier)
+	}
 	if cardCounterEnabled && state.CardCounter != nil {
 		cardCounter := renderCardCounter(state.CardCounter)
 		return lipgloss.JoinHorizontal(lipgloss.Top, cardCounter, "  ", bottomCardsView)
@@ -203,23 +234,47 @@ func renderTopSection(state *gameClient.GameState, cardCounterEnabled bool) stri
 	return bottomCardsView
 }
 
-func renderBottomCards(bottomCards []card.Card) string {
+// renderMultiplier 渲染当前倍数及其构成，尚未收到倍数时为空
+func renderMultiplier(state *gameClient.GameState) string {
+	if state.Multiplier <= 0 {
+		return ""
+	}
+	content := fmt.Sprintf("💥 倍数 ×%d", state.Multiplier)
+	if text := state.BreakdownText(); text != "" {
+		content += "\n" + text
+	}
+	return common.BoxStyle.Render(content)
+}
+
+// cardStyle 按花色颜色取牌面样式，癞子用金底高亮
+func cardStyle(c card.Card, wild card.Rank) lipgloss.Style {
+	switch {
+	case c.IsWild(wild):
+		return common.WildStyle
+	case c.Color == card.Red:
+		return common.RedStyle
+	default:
+		return common.BlackStyle
+	}
+}
+
+func renderBottomCards(bottomCards []card.Card, wild card.Rank, pattern string, bonus int) string {
 	if len(bottomCards) == 0 {
 		return common.BoxStyle.Render("底牌: (待揭晓)")
 	}
 
 	var rankStr, suitStr strings.Builder
 	for _, c := range bottomCards {
-		style := common.BlackStyle
-		if c.Color == card.Red {
-			style = common.RedStyle
-		}
+		style := cardStyle(c, wild)
 		style = style.Align(lipgloss.Center).Margin(0, 1)
 		rankStr.WriteString(style.Render(fmt.Sprintf("%-2s", c.Rank.String())))
 		suitStr.WriteString(style.Render(fmt.Sprintf("%-2s", c.Suit.String())))
 	}
 
 	title := "底牌"
+	if bonus > 1 {
+		title = fmt.Sprintf("底牌 %s ×%d", pattern, bonus)
+	}
 	content := lipgloss.JoinVertical(lipgloss.Center, title, rankStr.String(), suitStr.String())
 	return common.BoxStyle.Render(content)
 }
@@ -283,6 +338,12 @@ func renderMiddleSection(state *gameClient.GameState, myPlayerID string) string
 		}
 
 		info := fmt.Sprintf("%s %s\n🃏 %d张", icon, nameStyle.Render(p.Name), p.CardsCount)
+		if label := state.DoubleLabel(p.ID); label != "" {
+			info += "\n" + label
+		}
+		if p.Trustee {
+			info += "\n🤖 托管中"
+		}
 		parts = append(parts, common.BoxStyle.Width(15).Render(info))
 	}
 
@@ -294,7 +355,32 @@ func renderMiddleSection(state *gameClient.GameState, myPlayerID string) string
 	boxWidth := min(max(25, lipgloss.Width(lastPlayView)), 62)
 	parts = append(parts, common.BoxStyle.Width(boxWidth).Render(lastPlayView))
 
-	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+	section := lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+	if revealed := renderRevealedHands(state, myPlayerID); revealed != "" {
+		section = lipgloss.JoinVertical(lipgloss.Center, section, revealed)
+	}
+	return section
+}
+
+// renderRevealedHands 渲染其他明牌玩家的当前手牌，每人一行
+func renderRevealedHands(state *gameClient.GameState, myPlayerID string) string {
+	var rows []string
+	for _, p := range state.Players {
+		cards := state.RevealedHand(p.ID)
+		if p.ID == myPlayerID || cards == nil {
+			continue
+		}
+		var sb strings.Builder
+		for _, c := range cards {
+			sb.WriteString(cardStyle(c, state.WildRank).Render(c.Rank.String()))
+			sb.WriteString(" ")
+		}
+		rows = append(rows, fmt.Sprintf("🃏 %s 明牌: %s", p.Name, strings.TrimSpace(sb.String())))
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+	return common.BoxStyle.Render(strings.Join(rows, "\n"))
 }
 
 // groupPlayedForDisplay 将一手牌按"主牌在前、附牌在后"重排，便于阅读。
@@ -346,12 +432,10 @@ func renderLastPlayed(state *gameClient.GameState) string {
 	// 带牌（三带、四带、飞机带牌）时主牌在前、附牌在后，更符合阅读习惯；
 	// 组内与组间均按点数从大到小，与手牌方向一致（大牌在左）
 	grouped := groupPlayedForDisplay(state.LastPlayed)
+	wild := state.WildRank
 	cardStrs := make([]string, 0, len(grouped))
 	for _, c := range grouped {
-		style := common.BlackStyle
-		if c.Color == card.Red {
-			style = common.RedStyle
-		}
+		style := cardStyle(c, wild)
 		cardStrs = append(cardStrs, style.Render(c.Rank.String()))
 	}
 
@@ -373,17 +457,18 @@ func renderLastPlayed(state *gameClient.GameState) string {
 	return fmt.Sprintf("%s\n%s", header, strings.Join(cardStrs, " "))
 }
 
-func renderPlayerHand(hand []card.Card, isLandlord bool) string {
+func renderPlayerHand(hand []card.Card, isLandlord bool, wild card.Rank) string {
 	if len(hand) == 0 {
 		return common.BoxStyle.Render("(无手牌)")
 	}
+	return renderHandBox("我的手牌", hand, isLandlord, wild)
+}
 
+// renderHandBox 渲染带标题（角色图标、张数、癞子）的两行牌面
+func renderHandBox(owner string, hand []card.Card, isLandlord bool, wild card.Rank) string {
 	var rankStr, suitStr strings.Builder
 	for _, c := range hand {
-		style := common.BlackStyle
-		if c.Color == card.Red {
-			style = common.RedStyle
-		}
+		style := cardStyle(c, wild)
 		style = style.Align(lipgloss.Center).Margin(0, 1)
 		rankStr.WriteString(style.Render(fmt.Sprintf("%-2s", c.Rank.String())))
 		suitStr.WriteString(style.Render(fmt.Sprintf("%-2s", c.Suit.String())))
@@ -393,7 +478,10 @@ func renderPlayerHand(hand []card.Card, isLandlord bool) string {
 	if isLandlord {
 		icon = common.LandlordIcon
 	}
-	title := fmt.Sprintf("我的手牌 %s (%d张)", icon, len(hand))
+	title := fmt.Sprintf("%s %s (%d张)", owner, icon, len(hand))
+	if wild != 0 {
+		title += " " + common.WildStyle.Render(fmt.Sprintf(" 癞子 %s ", wild))
+	}
 	content := lipgloss.JoinVertical(lipgloss.Center, title, rankStr.String(), suitStr.String())
 	return common.BoxStyle.Render(content)
 }
@@ -407,16 +495,22 @@ func renderPrompt(m model.Model, game model.GameAccessor, state *gameClient.Game
 	switch phase {
 	case model.PhaseBidding:
 		isMyTurn = game.BidTurn() == myPlayerID
+	case model.PhaseDoubling:
+		isMyTurn = state.DoublePending
 	case model.PhasePlaying:
 		isMyTurn = state.CurrentTurn == myPlayerID
 	}
 
-	// Calculate remaining time
-	timerView := renderTimer(game.TimerDuration(), game.TimerStartTime())
+	// Calculate remaining time (the time bank only applies while playing)
+	bank := time.Duration(0)
+	if phase == model.PhasePlaying {
+		bank = game.TimeBank()
+	}
+	timerView := renderTimer(game.TimerDuration(), bank, game.TimerStartTime())
 
 	switch phase {
 	case model.PhaseBidding:
-		action := "叫地主"
+		action := state.BidAction()
 		if state.IsGrabTurn {
 			action = fmt.Sprintf("抢地主 (当前倍数 ×%d)", state.Multiplier)
 		}
@@ -430,6 +524,12 @@ func renderPrompt(m model.Model, game model.GameAccessor, state *gameClient.Game
 				}
 			}
 		}
+	case model.PhaseDoubling:
+		if state.DoublePending {
+			fmt.Fprintf(&sb, "⏳ %s | 选择是否加倍 (当前倍数 ×%d)\n", timerView, state.Multiplier)
+		} else {
+			fmt.Fprintf(&sb, "⏳ %s | 等待其他玩家选择加倍...\n", timerView)
+		}
 	case model.PhasePlaying:
 		multInfo := ""
 		if state.Multiplier > 0 {
@@ -451,12 +551,16 @@ func renderPrompt(m model.Model, game model.GameAccessor, state *gameClient.Game
 		}
 	}
 
+	if state.IsTrustee(myPlayerID) {
+		sb.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Render("🤖 托管中，按 G 取消") + "\n")
+	}
+
 	// Show input or quick message hint
 	if isMyTurn {
 		sb.WriteString(m.Input().View())
 	} else {
 		// When waiting, show quick message hint
-		quickMsgHint := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("C 键记牌器, T 键快捷消息, H 键帮助, M 键声音")
+		quickMsgHint := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("C 键记牌器, T 键快捷消息, G 键托管, H 键帮助, M 键声音")
 		sb.WriteString(quickMsgHint)
 	}
 
@@ -469,15 +573,27 @@ func renderPrompt(m model.Model, game model.GameAccessor, state *gameClient.Game
 	return common.PromptStyle.Render(centeredContent)
 }
 
-func renderTimer(duration time.Duration, startTime time.Time) string {
+// renderTimer 渲染回合倒计时；有备用时间时在后面附上剩余的备用时间，
+// 回合时间用完后改为显示备用时间的倒计时
+func renderTimer(duration, bank time.Duration, startTime time.Time) string {
 	if startTime.IsZero() {
 		return "00:00"
 	}
 
-	elapsed := time.Since(startTime)
-	remaining := max(duration-elapsed, 0)
+	remaining := duration - time.Since(startTime)
+	switch {
+	case bank <= 0:
+		return formatClock(remaining)
+	case remaining > 0:
+		return fmt.Sprintf("%s +%s", formatClock(remaining), formatClock(bank))
+	default:
+		return "备用 " + formatClock(bank+remaining)
+	}
+}
 
-	secs := int(remaining.Seconds())
+// formatClock 按 分:秒 格式化时长，负数按 0 处理
+func formatClock(d time.Duration) string {
+	secs := int(max(d, 0).Seconds())
 	return fmt.Sprintf("%02d:%02d", secs/60, secs%60)
 }
 
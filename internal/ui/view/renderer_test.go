@@ -0,0 +1,34 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTimer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		duration time.Duration
+		bank     time.Duration
+		start    time.Time
+		want     string
+	}{
+		{"not started", 30 * time.Second, 0, time.Time{}, "00:00"},
+		{"counting down", 30 * time.Second, 0, now.Add(-10500 * time.Millisecond), "00:19"},
+		{"expired", 30 * time.Second, 0, now.Add(-time.Minute), "00:00"},
+		{"bank shown after turn time", 30 * time.Second, 90 * time.Second, now.Add(-10500 * time.Millisecond), "00:19 +01:30"},
+		{"draining bank", 30 * time.Second, 90 * time.Second, now.Add(-40500 * time.Millisecond), "备用 01:19"},
+		{"bank used up", 30 * time.Second, 90 * time.Second, now.Add(-3 * time.Minute), "备用 00:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, renderTimer(tt.duration, tt.bank, tt.start))
+		})
+	}
+}
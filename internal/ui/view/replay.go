@@ -0,0 +1,69 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/ui/common"
+)
+
+// ReplayView 渲染回放的一步：底牌与倍数、各家信息与上家出牌、所有人的手牌，以及本步说明
+func ReplayView(frame gameClient.ReplayFrame, step, total, width, height int) string {
+	state := frame.State
+
+	var sb strings.Builder
+
+	title := common.TitleStyle(fmt.Sprintf("📼 对局回放 房间 %s · 第 %d/%d 步", state.RoomCode, step+1, total))
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, title))
+	sb.WriteString("\n")
+
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderTopSection(state, false)))
+	sb.WriteString("\n")
+
+	// 不排除任何人：回放中所有玩家都显示在中间区域
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderMiddleSection(state, "")))
+	sb.WriteString("\n")
+
+	for i, p := range state.Players {
+		var hand string
+		if len(frame.Hands[i]) == 0 {
+			hand = common.BoxStyle.Render(p.Name + " (已出完)")
+		} else {
+			hand = renderHandBox(p.Name, frame.Hands[i], p.IsLandlord, state.WildRank)
+		}
+		sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, hand))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderReplayCaption(frame)))
+	sb.WriteString("\n")
+	help := "←/→ 上一步/下一步 · Home/End 开头/结尾 · Q/ESC 退出"
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, help))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, sb.String())
+}
+
+// renderReplayCaption 本步说明，对局结束时附上倍数与各家得分
+func renderReplayCaption(frame gameClient.ReplayFrame) string {
+	state := frame.State
+	content := "▶ " + frame.Caption
+	if len(state.Scores) > 0 {
+		var sb strings.Builder
+		sb.WriteString(content)
+		if text := state.BreakdownText(); text != "" {
+			fmt.Fprintf(&sb, "\n💥 ×%d (%s)", state.FinalMultiplier, text)
+		}
+		for _, s := range state.Scores {
+			role := "农民"
+			if s.IsLandlord {
+				role = "地主"
+			}
+			fmt.Fprintf(&sb, "\n%s (%s): %+d", s.PlayerName, role, s.Score)
+		}
+		content = sb.String()
+	}
+	return common.BoxStyle.Render(content)
+}
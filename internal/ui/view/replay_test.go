@@ -0,0 +1,43 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/game/card"
+	"github.com/palemoky/fight-the-landlord/internal/game/gamelog"
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/ui/common"
+)
+
+func TestReplayView(t *testing.T) {
+	t.Parallel()
+
+	l := &gamelog.Log{
+		RoomCode: "AB12",
+		Players:  []gamelog.Player{{ID: "p1", Name: "甲"}, {ID: "p2", Name: "乙", Seat: 1}},
+	}
+	l.Append(gamelog.Event{
+		Type:        gamelog.EventDeal,
+		Hands:       [][]card.Card{{{Suit: card.Spade, Rank: card.Rank3}}, {}},
+		BottomCards: []card.Card{{Suit: card.Heart, Rank: card.RankK, Color: card.Red}},
+	})
+	l.Append(gamelog.Event{
+		Type:     gamelog.EventGameOver,
+		PlayerID: "p2",
+		Scores:   []protocol.PlayerScore{{PlayerID: "p2", PlayerName: "乙", Score: 6}},
+	})
+	frames := gameClient.BuildReplay(l)
+
+	first := ReplayView(frames[0], 0, len(frames), 120, 40)
+	assert.Contains(t, first, "第 1/2 步")
+	assert.Contains(t, first, "甲 "+common.FarmerIcon+" (1张)")
+	assert.Contains(t, first, "乙 (已出完)")
+	assert.Contains(t, first, "▶ 发牌")
+
+	last := ReplayView(frames[1], 1, len(frames), 120, 40)
+	assert.Contains(t, last, "乙（农民）获胜")
+	assert.Contains(t, last, "乙 (农民): +6")
+}
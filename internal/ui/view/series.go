@@ -0,0 +1,26 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+// renderSeriesStandings 渲染系列赛排名：累计得分、最近一局得分与获胜局数
+func renderSeriesStandings(s *protocol.SeriesStandingsPayload, myID string) string {
+	var sb strings.Builder
+	if s.Final {
+		fmt.Fprintf(&sb, "── 系列赛最终排名（共 %d 局）──\n", s.Hand)
+	} else {
+		fmt.Fprintf(&sb, "── 系列赛 第 %d/%d 局后 ──\n", s.Hand, s.Hands)
+	}
+	for i, st := range s.Standings {
+		me := ""
+		if st.PlayerID == myID {
+			me = " (你)"
+		}
+		fmt.Fprintf(&sb, "%d. %s%s  累计 %+d（本局 %+d，胜 %d 局）\n", i+1, st.PlayerName, me, st.Total, st.Last, st.Wins)
+	}
+	return sb.String()
+}
@@ -0,0 +1,29 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+func TestRenderSeriesStandings(t *testing.T) {
+	t.Parallel()
+
+	s := &protocol.SeriesStandingsPayload{
+		Hand:  2,
+		Hands: 5,
+		Standings: []protocol.SeriesStanding{
+			{PlayerID: "p2", PlayerName: "乙", Total: 6, Last: 8, Wins: 1},
+			{PlayerID: "p1", PlayerName: "甲", Total: -2, Last: -4, Wins: 1},
+		},
+	}
+	out := renderSeriesStandings(s, "p1")
+	assert.Contains(t, out, "第 2/5 局后")
+	assert.Contains(t, out, "1. 乙  累计 +6（本局 +8，胜 1 局）")
+	assert.Contains(t, out, "2. 甲 (你)  累计 -2（本局 -4，胜 1 局）")
+
+	s.Final = true
+	assert.Contains(t, renderSeriesStandings(s, "p1"), "最终排名（共 2 局）")
+}
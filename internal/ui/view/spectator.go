@@ -0,0 +1,111 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	gameClient "github.com/palemoky/fight-the-landlord/internal/client"
+	"github.com/palemoky/fight-the-landlord/internal/ui/common"
+	"github.com/palemoky/fight-the-landlord/internal/ui/model"
+)
+
+// SpectatorView 渲染只读的观战界面：底牌与倍数、各家牌数与出牌，以及延迟公开的手牌
+func SpectatorView(m model.Model) string {
+	width := m.Width()
+	game := m.Game()
+	state := game.State()
+
+	var sb strings.Builder
+
+	title := common.TitleStyle(fmt.Sprintf("👀 观战 房间 %s", state.RoomCode))
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, title))
+	sb.WriteString("\n")
+
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderTopSection(state, false)))
+	sb.WriteString("\n")
+
+	// 观战者不是玩家，所有人都显示在中间区域
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderMiddleSection(state, "")))
+	sb.WriteString("\n")
+
+	for _, p := range state.Players {
+		cards, ok := state.SpectatorHands[p.ID]
+		if !ok {
+			continue
+		}
+		var hand string
+		if len(cards) == 0 {
+			hand = common.BoxStyle.Render(p.Name + " (已出完)")
+		} else {
+			hand = renderHandBox(p.Name, cards, p.IsLandlord, state.WildRank)
+		}
+		sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, hand))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, renderSpectatorStatus(game, state)))
+	sb.WriteString("\n")
+
+	help := "Q/ESC 退出观战 · M 键声音"
+	if state.HandDelay > 0 && state.Winner == "" {
+		help = fmt.Sprintf("手牌延迟 %d 秒公开 · %s", state.HandDelay, help)
+	}
+	sb.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center,
+		lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(help)))
+
+	return lipgloss.Place(width, m.Height(), lipgloss.Center, lipgloss.Center, sb.String())
+}
+
+// renderSpectatorStatus 当前轮到谁行动，对局结束后改为结算信息
+func renderSpectatorStatus(game model.GameAccessor, state *gameClient.GameState) string {
+	if state.Winner != "" {
+		var sb strings.Builder
+		role := "农民"
+		if state.WinnerIsLandlord {
+			role = "地主"
+		}
+		fmt.Fprintf(&sb, "🏆 %s (%s) 获胜!", state.Winner, role)
+		if state.FinalMultiplier > 0 {
+			fmt.Fprintf(&sb, "\n💥 ×%d", state.FinalMultiplier)
+			if text := state.BreakdownText(); text != "" {
+				fmt.Fprintf(&sb, " (%s)", text)
+			}
+		}
+		for _, s := range state.Scores {
+			role := "农民"
+			if s.IsLandlord {
+				role = "地主"
+			}
+			fmt.Fprintf(&sb, "\n%s (%s): %+d", s.PlayerName, role, s.Score)
+		}
+		return common.BoxStyle.Render(sb.String())
+	}
+
+	bank := time.Duration(0)
+	if state.SpectateStage == "playing" {
+		bank = game.TimeBank()
+	}
+	timerView := renderTimer(game.TimerDuration(), bank, game.TimerStartTime())
+	playerName := func(id string) string {
+		for _, p := range state.Players {
+			if p.ID == id {
+				return p.Name
+			}
+		}
+		return ""
+	}
+
+	var status string
+	switch state.SpectateStage {
+	case "bidding":
+		status = fmt.Sprintf("⏳ %s | 等待 %s %s...", timerView, playerName(game.BidTurn()), state.BidAction())
+	case "doubling":
+		status = fmt.Sprintf("⏳ %s | 等待玩家选择加倍...", timerView)
+	case "playing":
+		status = fmt.Sprintf("⏳ %s | 等待 %s 出牌...", timerView, playerName(state.CurrentTurn))
+	}
+	return common.PromptStyle.Render(status)
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/textinput"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/ui/model"
+)
+
+func TestRenderSpectatorStatus(t *testing.T) {
+	t.Parallel()
+
+	input := textinput.New()
+	game := model.NewGameModel(nil, &input)
+	state := game.State()
+	state.Players = []protocol.PlayerInfo{{ID: "p1", Name: "甲"}, {ID: "p2", Name: "乙"}}
+
+	state.SpectateStage = "bidding"
+	game.SetBidTurn("p2")
+	assert.Contains(t, renderSpectatorStatus(game, state), "等待 乙 叫地主")
+
+	state.SpectateStage = "playing"
+	state.CurrentTurn = "p1"
+	assert.Contains(t, renderSpectatorStatus(game, state), "等待 甲 出牌")
+
+	state.Winner = "甲"
+	state.WinnerIsLandlord = true
+	state.Scores = []protocol.PlayerScore{{PlayerID: "p1", PlayerName: "甲", IsLandlord: true, Score: 4}}
+	status := renderSpectatorStatus(game, state)
+	assert.Contains(t, status, "甲 (地主) 获胜")
+	assert.Contains(t, status, "甲 (地主): +4")
+}
@@ -0,0 +1,93 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+	"github.com/palemoky/fight-the-landlord/internal/ui/common"
+	"github.com/palemoky/fight-the-landlord/internal/ui/model"
+)
+
+// TournamentView renders the tournament list and the latest standings.
+func TournamentView(m model.Model) string {
+	lobby := m.Lobby()
+	var sb strings.Builder
+
+	title := common.TitleStyle("🏆 锦标赛")
+	sb.WriteString(lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center, title))
+	sb.WriteString("\n\n")
+
+	if notification := m.GetCurrentNotification(); notification != nil {
+		sb.WriteString(lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center,
+			getNotificationStyle(notification.Type).Render(notification.Message)))
+		sb.WriteString("\n\n")
+	}
+
+	content := common.BoxStyle.Render(renderTournamentList(lobby.Tournaments(), lobby.SelectedTournamentIdx()))
+	if s := lobby.TournamentStandings(); s != nil {
+		standingsBox := common.BoxStyle.Render(strings.TrimSuffix(renderTournamentStandings(s, m.PlayerID()), "\n"))
+		content = lipgloss.JoinHorizontal(lipgloss.Top, content, "  ", standingsBox)
+	}
+	sb.WriteString(lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center, content))
+	sb.WriteString("\n\n")
+
+	hint := "↑↓ 选择  回车报名/退出  ESC 返回"
+	sb.WriteString(lipgloss.PlaceHorizontal(m.Width(), lipgloss.Center, hint))
+
+	return sb.String()
+}
+
+// renderTournamentList 渲染锦标赛列表：开赛时间、人数与报名状态
+func renderTournamentList(tournaments []protocol.TournamentInfo, selected int) string {
+	if len(tournaments) == 0 {
+		return "暂无锦标赛"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("锦标赛列表:\n\n")
+	for i, t := range tournaments {
+		prefix := "  "
+		if i == selected {
+			prefix = "▶ "
+		}
+		status := fmt.Sprintf("%s 开赛，已报名 %d 人", time.Unix(t.StartAt, 0).Format("01-02 15:04"), t.Players)
+		if t.State == protocol.TournamentRunning {
+			status = fmt.Sprintf("第 %d 轮进行中，%d 人参赛", t.Round, t.Players)
+		}
+		mark := ""
+		if t.Registered {
+			mark = " ✅"
+		}
+		fmt.Fprintf(&sb, "%s%s  %s（每轮 %d 局）%s\n", prefix, t.Name, status, t.Hands, mark)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// renderTournamentStandings 渲染锦标赛排名：累计得分、打到的轮次与是否淘汰
+func renderTournamentStandings(s *protocol.TournamentStandingsPayload, myID string) string {
+	var sb strings.Builder
+	if s.Final {
+		fmt.Fprintf(&sb, "── %s 最终排名 ──\n", s.Name)
+	} else {
+		fmt.Fprintf(&sb, "── %s 第 %d 轮后 ──\n", s.Name, s.Round)
+	}
+	for i, st := range s.Standings {
+		me := ""
+		if st.PlayerID == myID {
+			me = " (你)"
+		}
+		status := "晋级"
+		switch {
+		case s.Final && i == 0:
+			status = "冠军"
+		case st.Eliminated:
+			status = fmt.Sprintf("第 %d 轮淘汰", st.Round)
+		}
+		fmt.Fprintf(&sb, "%d. %s%s  累计 %+d（%s）\n", i+1, st.PlayerName, me, st.Total, status)
+	}
+	return sb.String()
+}
@@ -0,0 +1,45 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/palemoky/fight-the-landlord/internal/protocol"
+)
+
+func TestRenderTournamentList(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "暂无锦标赛", renderTournamentList(nil, 0))
+
+	out := renderTournamentList([]protocol.TournamentInfo{
+		{ID: "1", Name: "每日淘汰赛", State: protocol.TournamentRegistering, Players: 5, Hands: 3, Registered: true},
+		{ID: "2", Name: "快速赛", State: protocol.TournamentRunning, Round: 2, Players: 9, Hands: 2},
+	}, 1)
+	assert.Contains(t, out, "  每日淘汰赛")
+	assert.Contains(t, out, "已报名 5 人（每轮 3 局） ✅")
+	assert.Contains(t, out, "▶ 快速赛  第 2 轮进行中，9 人参赛（每轮 2 局）")
+}
+
+func TestRenderTournamentStandings(t *testing.T) {
+	t.Parallel()
+
+	s := &protocol.TournamentStandingsPayload{
+		Name:  "每日淘汰赛",
+		Round: 1,
+		Standings: []protocol.TournamentStanding{
+			{PlayerID: "p2", PlayerName: "乙", Total: 12, Round: 1},
+			{PlayerID: "p1", PlayerName: "甲", Total: -4, Round: 1, Eliminated: true},
+		},
+	}
+	out := renderTournamentStandings(s, "p1")
+	assert.Contains(t, out, "每日淘汰赛 第 1 轮后")
+	assert.Contains(t, out, "1. 乙  累计 +12（晋级）")
+	assert.Contains(t, out, "2. 甲 (你)  累计 -4（第 1 轮淘汰）")
+
+	s.Final = true
+	out = renderTournamentStandings(s, "p1")
+	assert.Contains(t, out, "每日淘汰赛 最终排名")
+	assert.Contains(t, out, "1. 乙  累计 +12（冠军）")
+}